@@ -2,22 +2,86 @@ import { DataProvider } from "@refinedev/core";
 
 const API_URL = "http://localhost:8080"; // CMS APIのURL
 
+// ブログ記事のコンテンツタイプID（database-schema-mvp.sql の blog_post）
+const BLOG_POST_CONTENT_TYPE_ID = "550e8400-e29b-41d4-a716-446655440001";
+const AUTHOR_ID = "admin";
+
+// CMS APIへJSONリクエストを送信し、共通レスポンス形式のdataを返す
+const request = async (path: string, init: RequestInit) => {
+  const response = await fetch(`${API_URL}${path}`, {
+    ...init,
+    headers: { "Content-Type": "application/json", ...init.headers },
+  });
+  if (response.status === 204) {
+    return null;
+  }
+  const body = await response.json();
+  if (!body.success) {
+    throw new Error(body.error?.message ?? `Request failed: ${response.status}`);
+  }
+  return body.data;
+};
+
+// タイトルからスラッグを生成
+const toSlug = (title: string) =>
+  title
+    .toLowerCase()
+    .trim()
+    .replace(/[^a-z0-9\u3040-\u30ff\u4e00-\u9faf]+/g, "-")
+    .replace(/^-+|-+$/g, "") || Date.now().toString();
+
+// 本文として編集するテキストブロックかどうか
+const isTextBlock = (block: any) => block.block_type === "text";
+
+// 本文を最初のテキストブロックに反映し、残りのテキストブロックはまとめて削除する（テキスト以外のブロックはそのまま残す）
+const withContent = (blocks: any[], content: string) => {
+  const index = blocks.findIndex(isTextBlock);
+  if (index < 0) {
+    return [
+      ...blocks,
+      { block_type: "text", is_visible: true, data: { data_type: "text", content_text: content } },
+    ];
+  }
+  return blocks.flatMap((block, i) => {
+    if (!isTextBlock(block)) {
+      return [block];
+    }
+    return i === index ? [{ ...block, data: { ...block.data, content_text: content } }] : [];
+  });
+};
+
+// CMS APIのコンテンツをブログ記事のレコード形式に変換
+const toBlogPost = (content: any) => ({
+  id: content.id,
+  title: content.title,
+  content: (content.blocks ?? [])
+    .filter(isTextBlock)
+    .map((block: any) => block.data?.content_text)
+    .filter(Boolean)
+    .join("\n\n"),
+  category: { id: content.category_id },
+  status: content.status,
+  createdAt: content.created_at,
+  version: content.version,
+});
+
 export const dataProvider: DataProvider = {
   getList: async ({ resource, pagination, filters, sorters, meta }) => {
     if (resource === "blog_posts") {
-      const response = await fetch(`${API_URL}/`);
-      const data = await response.json();
-      
+      const params = new URLSearchParams();
+      if (pagination?.current && pagination?.pageSize) {
+        params.set("limit", String(pagination.pageSize));
+        params.set("offset", String((pagination.current - 1) * pagination.pageSize));
+      }
+      const response = await fetch(`${API_URL}/contents?${params}`);
+      const body = await response.json();
+      if (!body.success) {
+        throw new Error(body.error?.message ?? "Failed to fetch contents");
+      }
+
       return {
-        data: data.map((article: any) => ({
-          id: article.id,
-          title: article.title,
-          content: article.body,
-          category: { id: article.category_id },
-          status: article.status,
-          createdAt: article.created_at,
-        })),
-        total: data.length,
+        data: body.data.contents.map(toBlogPost),
+        total: body.data.pagination.totalCount,
       };
     }
     
@@ -38,24 +102,14 @@ export const dataProvider: DataProvider = {
 
   getOne: async ({ resource, id, meta }) => {
     if (resource === "blog_posts") {
-      // 現在のAPIには個別記事取得がないため、全体から検索
-      const response = await fetch(`${API_URL}/`);
-      const data = await response.json();
-      const article = data.find((item: any) => item.id === id);
-      
-      if (!article) {
-        throw new Error("Article not found");
+      const response = await fetch(`${API_URL}/contents/${id}`);
+      const body = await response.json();
+      if (!body.success) {
+        throw new Error(body.error?.message ?? "Article not found");
       }
-      
+
       return {
-        data: {
-          id: article.id,
-          title: article.title,
-          content: article.body,
-          category: { id: article.category_id },
-          status: article.status,
-          createdAt: article.created_at,
-        },
+        data: toBlogPost(body.data),
       };
     }
     
@@ -74,37 +128,67 @@ export const dataProvider: DataProvider = {
   },
 
   create: async ({ resource, variables, meta }) => {
-    // 現在のAPIには作成機能がないため、モックレスポンス
     if (resource === "blog_posts") {
-      return {
-        data: {
-          id: Date.now().toString(),
-          ...variables,
-        },
-      };
+      const post = variables as any;
+      const data = await request("/contents", {
+        method: "POST",
+        body: JSON.stringify({
+          content_type_id: BLOG_POST_CONTENT_TYPE_ID,
+          title: post.title,
+          slug: toSlug(post.title ?? ""),
+          status: post.status,
+          author_id: AUTHOR_ID,
+          blocks: [
+            {
+              block_type: "text",
+              is_visible: true,
+              data: { data_type: "text", content_text: post.content ?? "" },
+            },
+          ],
+        }),
+      });
+      return { data: toBlogPost(data) as any };
     }
     throw new Error("Create not implemented");
   },
 
   update: async ({ resource, id, variables, meta }) => {
-    // 現在のAPIには更新機能がないため、モックレスポンス
     if (resource === "blog_posts") {
-      return {
-        data: {
-          id,
-          ...variables,
-        },
-      };
+      const post = variables as any;
+      // 編集開始時のバージョン（フォームの隠し項目またはmeta）を送信し、他の更新との競合を検出する
+      const version = post.version ?? meta?.version;
+      if (!version) {
+        throw new Error("version is required to update a content");
+      }
+      // 本文以外のブロックを残すため、現在のブロックに本文を反映して送信する
+      // 読み込み後に他の更新があった場合は、バージョンが一致しないため409になる
+      const current = await request(`/contents/${id}`, { method: "GET" });
+      const data = await request(`/contents/${id}`, {
+        method: "PATCH",
+        headers: { "If-Match": `"${version}"` },
+        body: JSON.stringify({
+          title: post.title,
+          status: post.status,
+          blocks: withContent(current.blocks ?? [], post.content ?? ""),
+        }),
+      });
+      return { data: toBlogPost(data) as any };
     }
     throw new Error("Update not implemented");
   },
 
   deleteOne: async ({ resource, id, meta }) => {
-    // 現在のAPIには削除機能がないため、モックレスポンス
     if (resource === "blog_posts") {
-      return {
-        data: { id },
-      };
+      // 一覧に表示したときのバージョンを送信し、その後に他の更新があった場合は409にする
+      const version = meta?.version;
+      if (!version) {
+        throw new Error("version is required to delete a content");
+      }
+      await request(`/contents/${id}`, {
+        method: "DELETE",
+        headers: { "If-Match": `"${version}"` },
+      });
+      return { data: { id } as any };
     }
     throw new Error("Delete not implemented");
   },
@@ -21,12 +21,18 @@ export const BlogPostEdit = () => {
   });
 
   return (
-    <Edit isLoading={formLoading} saveButtonProps={saveButtonProps}>
+    <Edit
+      isLoading={formLoading}
+      saveButtonProps={saveButtonProps}
+      deleteButtonProps={{ meta: { version: blogPostsData?.version } }}
+    >
       <Box
         component="form"
         sx={{ display: "flex", flexDirection: "column" }}
         autoComplete="off"
       >
+        {/* 編集開始時のバージョン（更新時にIf-Matchとして送信する） */}
+        <input type="hidden" {...register("version")} />
         <TextField
           {...register("title", {
             required: "This field is required",
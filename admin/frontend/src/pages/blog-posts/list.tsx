@@ -107,7 +107,7 @@ export const BlogPostList = () => {
             <>
               <EditButton hideText recordItemId={row.id} />
               <ShowButton hideText recordItemId={row.id} />
-              <DeleteButton hideText recordItemId={row.id} />
+              <DeleteButton hideText recordItemId={row.id} meta={{ version: row.version }} />
             </>
           );
         },
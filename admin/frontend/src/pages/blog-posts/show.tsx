@@ -2,7 +2,11 @@ import { Stack, Typography } from "@mui/material";
 import { useOne, useShow } from "@refinedev/core";
 import {
   DateField,
+  DeleteButton,
+  EditButton,
+  ListButton,
   MarkdownField,
+  RefreshButton,
   Show,
   TextFieldComponent as TextField,
 } from "@refinedev/mui";
@@ -23,7 +27,27 @@ export const BlogPostShow = () => {
   });
 
   return (
-    <Show isLoading={isLoading}>
+    <Show
+      isLoading={isLoading}
+      headerButtons={({
+        listButtonProps,
+        editButtonProps,
+        deleteButtonProps,
+        refreshButtonProps,
+      }) => (
+        <>
+          {listButtonProps && <ListButton {...listButtonProps} />}
+          {editButtonProps && <EditButton {...editButtonProps} />}
+          {deleteButtonProps && (
+            <DeleteButton
+              {...deleteButtonProps}
+              meta={{ version: record?.version }}
+            />
+          )}
+          <RefreshButton {...refreshButtonProps} />
+        </>
+      )}
+    >
       <Stack gap={1}>
         <Typography variant="body1" fontWeight="bold">
           {"ID"}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"cms_api/internal/config"
+	route "cms_api/internal/di"
+	"cms_api/internal/infrastructure/cli"
+	"context"
+	"errors"
+	"log"
+	"os"
+)
+
+// コンテンツタイプの定義をファイルにエクスポート・ファイルからインポートします
+// 例: go run cmd/contenttypes/main.go import -f content-types.yaml -dry-run
+func main() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("設定の読み込みに失敗しました: %v", err)
+	}
+
+	command := route.ContentTypeCommandHandler(cfg)
+	if err := command.Run(context.Background(), os.Args[1:]); err != nil {
+		if errors.Is(err, cli.ErrUsage) {
+			log.Printf("%v", err)
+			os.Exit(2)
+		}
+		log.Fatalf("コンテンツタイプの定義の処理に失敗しました: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"cms_api/internal/config"
 	route "cms_api/internal/di"
+	"context"
 	"log"
 )
 
@@ -16,8 +17,13 @@ func main() {
 	log.Printf("スタンドアロンサーバーを初期化します: DB=%s:%d/%s",
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.DBName)
 
-	// Echo サーバーの初期化と起動
-	e := route.RouteHandler(cfg)
+	// Echo サーバーとスケジューラーの初期化（データベース接続は共有する）
+	e, contentScheduler := route.ServerHandler(cfg)
+
+	// 公開予約スケジューラーをバックグラウンドで起動
+	if contentScheduler != nil {
+		go contentScheduler.Start(context.Background())
+	}
 
 	// サーバーアドレスの設定
 	address := cfg.Server.Host + ":" + cfg.Server.Port
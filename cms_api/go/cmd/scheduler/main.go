@@ -0,0 +1,39 @@
+package main
+
+import (
+	"cms_api/internal/config"
+	route "cms_api/internal/di"
+	"cms_api/internal/infrastructure/scheduler"
+	"context"
+	"log"
+
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+var contentScheduler *scheduler.Scheduler
+
+func init() {
+	// Lambda用の初期化
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Lambda設定の読み込みに失敗しました: %v", err)
+	}
+
+	log.Printf("公開予約Lambda関数を初期化します: DB=%s:%d/%s",
+		cfg.Database.Host, cfg.Database.Port, cfg.Database.DBName)
+
+	contentScheduler = route.SchedulerHandler(cfg)
+
+	log.Printf("公開予約Lambda関数の初期化が完了しました")
+}
+
+func main() {
+	lambda.Start(Handler)
+}
+
+// Handler はEventBridgeのスケジュールから定期的に呼び出され、予約日時を過ぎたコンテンツを公開・アーカイブし、
+// 保持期間を過ぎたゴミ箱のコンテンツを完全に削除します
+func Handler(ctx context.Context, event events.CloudWatchEvent) (*scheduler.Result, error) {
+	return contentScheduler.RunOnce(ctx)
+}
@@ -23,11 +23,44 @@ CREATE TABLE content_types (
     description TEXT,
     icon VARCHAR(50),
     is_active BOOLEAN NOT NULL DEFAULT true,
+    -- フィールド（ブロック）の定義: {"fields": [{"block_type", "label", "required", "max_count", "max_length"}], "ordered": bool}
+    field_schema JSONB NOT NULL DEFAULT '{"fields": [], "ordered": false}',
+    -- フィールドの定義の版（定義を変更するたびに1つ進める）
+    schema_version INTEGER NOT NULL DEFAULT 1,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     created_by VARCHAR(100) NOT NULL
 );
 
+-- =============================================================================
+-- カテゴリ・タグ管理テーブル
+-- =============================================================================
+
+/**
+ * カテゴリテーブル
+ * コンテンツは1つのカテゴリに属する（カテゴリ削除時はカテゴリなしになる）
+ */
+CREATE TABLE categories (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(200) NOT NULL UNIQUE,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+/**
+ * タグテーブル
+ * コンテンツとは content_tags で多対多に関連付ける
+ */
+CREATE TABLE tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(200) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
 -- =============================================================================
 -- コンテンツマスターテーブル（MVP版）
 -- =============================================================================
@@ -40,16 +73,26 @@ CREATE TABLE contents (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     content_type_id UUID NOT NULL REFERENCES content_types(id),
     title VARCHAR(500) NOT NULL,
-    slug VARCHAR(200),
+    slug VARCHAR(200) NOT NULL,
     status VARCHAR(20) NOT NULL DEFAULT 'draft',
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     published_at TIMESTAMP WITH TIME ZONE,
     author_id VARCHAR(100) NOT NULL,
     version INTEGER NOT NULL DEFAULT 1,
-    UNIQUE(content_type_id, slug),
+    scheduled_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    -- 一覧用の抜粋とカバー画像（ブロックの保存時にアプリケーションで作成する）
+    excerpt TEXT NOT NULL DEFAULT '',
+    cover_image_url VARCHAR(1000) NOT NULL DEFAULT '',
+    -- スラッグはコンテンツタイプごとに一意（空の場合はアプリケーションでタイトルから作成する）
+    CONSTRAINT uq_contents_content_type_slug UNIQUE (content_type_id, slug),
     CONSTRAINT chk_contents_status 
-        CHECK (status IN ('draft', 'published', 'archived', 'trash'))
+        CHECK (status IN ('draft', 'published', 'archived', 'trash')),
+    CONSTRAINT chk_contents_deleted_at 
+        CHECK ((status = 'trash') = (deleted_at IS NOT NULL))
 );
 
 -- =============================================================================
@@ -85,17 +128,71 @@ CREATE TABLE content_block_data (
     content_number DECIMAL,
     content_url VARCHAR(1000),
     content_json JSONB,
-    referenced_content_id UUID REFERENCES contents(id),
+    -- 参照先を完全に削除した場合は参照先を外す（参照元の参照ブロックは残る。アプリケーションは削除前に参照先を外して非表示にする）
+    referenced_content_id UUID REFERENCES contents(id) ON DELETE SET NULL,
     settings JSONB DEFAULT '{}',
+    -- 全文検索用の本文（NFKC正規化・小文字化済み。リッチテキストはテキストを抽出して保存）
+    search_text TEXT,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     CONSTRAINT chk_data_type 
         CHECK (data_type IN ('text', 'richtext', 'number', 'url', 'json', 'reference')),
+    -- 参照先を外した参照ブロックはreferenced_content_idがNULLになる
     CONSTRAINT chk_reference_consistency
-        CHECK (
-            (data_type = 'reference' AND referenced_content_id IS NOT NULL) OR
-            (data_type != 'reference' AND referenced_content_id IS NULL)
-        )
+        CHECK (data_type = 'reference' OR referenced_content_id IS NULL)
+);
+
+/**
+ * コンテンツ版テーブル
+ * コンテンツの作成・更新ごとにブロックとブロックデータを含むスナップショットを保存
+ */
+CREATE TABLE content_versions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    content_id UUID NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    title VARCHAR(500) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    author_id VARCHAR(255) NOT NULL,
+    snapshot JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_content_versions_content_version UNIQUE (content_id, version)
+);
+
+/**
+ * コンテンツタグ関連テーブル
+ * コンテンツとタグの多対多の関連付け
+ */
+CREATE TABLE content_tags (
+    content_id UUID NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (content_id, tag_id)
+);
+
+/**
+ * スラッグのリダイレクトテーブル
+ * 公開中のコンテンツのスラッグが変更された場合に、変更前のスラッグから現在のコンテンツへの対応を保存
+ */
+CREATE TABLE content_slug_redirects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    content_type_id UUID NOT NULL REFERENCES content_types(id),
+    slug VARCHAR(200) NOT NULL,
+    content_id UUID NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_content_slug_redirects_type_slug UNIQUE (content_type_id, slug)
+);
+
+/**
+ * コンテンツタイプのフィールドの定義の版テーブル
+ * コンテンツタイプの作成とフィールドの定義の変更・移行ごとに定義を保存
+ */
+CREATE TABLE content_type_schema_versions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    content_type_id UUID NOT NULL REFERENCES content_types(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    field_schema JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_content_type_schema_versions_type_version UNIQUE (content_type_id, version)
 );
 
 -- =============================================================================
@@ -106,19 +203,38 @@ CREATE TABLE content_block_data (
 CREATE INDEX idx_contents_content_type_id ON contents(content_type_id);
 CREATE INDEX idx_contents_status ON contents(status);
 CREATE INDEX idx_contents_author_id ON contents(author_id);
-CREATE INDEX idx_contents_created_at ON contents(created_at DESC);
-CREATE INDEX idx_contents_updated_at ON contents(updated_at DESC);
+-- 一覧の並び順（ソート対象の後にIDの昇順。NULLは末尾）と揃え、カーソルによるページングで使えるようにする
+CREATE INDEX idx_contents_created_at ON contents(created_at DESC, id);
+CREATE INDEX idx_contents_updated_at ON contents(updated_at DESC, id);
 CREATE INDEX idx_contents_published_at ON contents(published_at DESC) 
     WHERE published_at IS NOT NULL;
 CREATE INDEX idx_contents_slug ON contents(content_type_id, slug);
 
-CREATE INDEX idx_contents_status_published_at ON contents(status, published_at DESC) 
+CREATE INDEX idx_contents_status_published_at ON contents(status, published_at DESC NULLS LAST, id) 
     WHERE status = 'published';
-CREATE INDEX idx_contents_status_created_at ON contents(status, created_at DESC);
+CREATE INDEX idx_contents_status_created_at ON contents(status, created_at DESC, id);
 CREATE INDEX idx_contents_author_status ON contents(author_id, status);
+CREATE INDEX idx_contents_scheduled_at ON contents(scheduled_at) 
+    WHERE status = 'draft' AND scheduled_at IS NOT NULL;
+CREATE INDEX idx_contents_expires_at ON contents(expires_at) 
+    WHERE status = 'published' AND expires_at IS NOT NULL;
+CREATE INDEX idx_contents_deleted_at ON contents(deleted_at) 
+    WHERE status = 'trash';
 CREATE INDEX idx_contents_type_status ON contents(content_type_id, status);
 
-CREATE INDEX idx_contents_title_gin ON contents USING GIN (title gin_trgm_ops);
+-- 検索はタイトル・スラッグをNFKC正規化して比較するため、正規化した式に索引を張る
+CREATE INDEX idx_contents_title_gin ON contents USING GIN ((normalize(title, NFKC)) gin_trgm_ops);
+CREATE INDEX idx_contents_slug_gin ON contents USING GIN ((normalize(slug, NFKC)) gin_trgm_ops);
+CREATE INDEX idx_contents_category_id ON contents(category_id) WHERE category_id IS NOT NULL;
+
+-- カテゴリ・タグ関連のインデックス
+CREATE INDEX idx_categories_name ON categories(name);
+CREATE INDEX idx_tags_name ON tags(name);
+CREATE INDEX idx_content_tags_tag_id ON content_tags(tag_id, content_id);
+CREATE INDEX idx_content_slug_redirects_content_id ON content_slug_redirects(content_id);
+-- カテゴリ別件数の集計用（公開中のコンテンツのみ）
+CREATE INDEX idx_contents_category_published ON contents(category_id, published_at) 
+    WHERE status = 'published' AND category_id IS NOT NULL;
 
 -- コンテンツタイプ関連のインデックス
 CREATE INDEX idx_content_types_name ON content_types(name);
@@ -136,6 +252,8 @@ CREATE INDEX idx_content_block_data_text_gin ON content_block_data USING GIN (co
 CREATE INDEX idx_content_block_data_richtext_gin ON content_block_data USING GIN (content_richtext);
 CREATE INDEX idx_content_block_data_referenced_content ON content_block_data(referenced_content_id) WHERE referenced_content_id IS NOT NULL;
 CREATE INDEX idx_content_block_data_json_gin ON content_block_data USING GIN (content_json);
+-- 日本語を含む部分一致検索用（トライグラムは単語分割が不要なため日本語にも使える。3文字未満の語は索引を使わない）
+CREATE INDEX idx_content_block_data_search_text_gin ON content_block_data USING GIN (search_text gin_trgm_ops);
 
 -- =============================================================================
 -- ビュー定義（MVP版）
@@ -158,7 +276,11 @@ SELECT
     c.updated_at,
     c.published_at,
     c.author_id,
-    c.version
+    c.version,
+    c.expires_at,
+    c.deleted_at,
+    c.excerpt,
+    c.cover_image_url
 FROM contents c
 JOIN content_types ct ON c.content_type_id = ct.id
 WHERE ct.is_active = true;
@@ -173,7 +295,8 @@ SELECT
 FROM content_details cd
 WHERE cd.status = 'published' 
     AND cd.published_at IS NOT NULL 
-    AND cd.published_at <= CURRENT_TIMESTAMP;
+    AND cd.published_at <= CURRENT_TIMESTAMP
+    AND (cd.expires_at IS NULL OR cd.expires_at > CURRENT_TIMESTAMP);
 
 /**
  * コンテンツブロック詳細ビュー
@@ -258,12 +381,87 @@ INSERT INTO content_blocks (id, content_id, block_type, block_order) VALUES
 ('550e8400-e29b-41d4-a716-446655440303', '550e8400-e29b-41d4-a716-446655440202', 'richtext', 1);
 
 -- ブロックデータの作成
-INSERT INTO content_block_data (block_id, data_type, content_richtext, content_text) VALUES 
+INSERT INTO content_block_data (block_id, data_type, content_richtext, content_text, search_text) VALUES 
 ('550e8400-e29b-41d4-a716-446655440301', 'richtext', 
 '{"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"このCMS APIシステムは、AWS Lambda と Aurora Serverless v2を使用したサーバーレスアーキテクチャを採用しています。"}]},{"type":"paragraph","content":[{"type":"text","marks":[{"type":"bold"}],"text":"主な特徴:"},{"type":"hard_break"},{"type":"text","text":"• 高い可用性と拡張性"},{"type":"hard_break"},{"type":"text","text":"• 効率的なコンテンツ管理"},{"type":"hard_break"},{"type":"text","text":"• 柔軟なブロックベースエディタ"}]}]}'::jsonb, 
-NULL),
+NULL,
+E'このcms apiシステムは、aws lambda と aurora serverless v2を使用したサーバーレスアーキテクチャを採用しています。\n主な特徴:\n• 高い可用性と拡張性\n• 効率的なコンテンツ管理\n• 柔軟なブロックベースエディタ'),
 ('550e8400-e29b-41d4-a716-446655440302', 'text', NULL, 
-'技術スタック: AWS Lambda, Aurora Serverless v2, PostgreSQL 15'),
+'技術スタック: AWS Lambda, Aurora Serverless v2, PostgreSQL 15',
+'技術スタック: aws lambda, aurora serverless v2, postgresql 15'),
 ('550e8400-e29b-41d4-a716-446655440303', 'richtext',
 '{"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"このガイドでは、CMS APIのパフォーマンスを最適化するための具体的な手法について説明します。"}]}]}'::jsonb,
-NULL);
\ No newline at end of file
+NULL,
+'このガイドでは、cms apiのパフォーマンスを最適化するための具体的な手法について説明します。');
+
+-- 一覧用の抜粋の作成
+UPDATE contents SET excerpt = 'このCMS APIシステムは、AWS Lambda と Aurora Serverless v2を使用したサーバーレスアーキテクチャを採用しています。 主な特徴: • 高い可用性と拡張性 • 効率的なコンテンツ管理 • 柔軟なブロックベース…'
+WHERE id = '550e8400-e29b-41d4-a716-446655440201';
+UPDATE contents SET excerpt = 'このガイドでは、CMS APIのパフォーマンスを最適化するための具体的な手法について説明します。'
+WHERE id = '550e8400-e29b-41d4-a716-446655440202';
+
+-- 初版（version 1）のスナップショットの作成
+-- アプリケーションが保存時に作成するスナップショット（コンテンツのJSON表現）と同じ形式にする
+INSERT INTO content_versions (content_id, version, title, status, author_id, snapshot)
+SELECT
+    c.id,
+    c.version,
+    c.title,
+    c.status,
+    c.author_id,
+    jsonb_build_object(
+        'id', c.id,
+        'content_type_id', c.content_type_id,
+        'title', c.title,
+        'slug', c.slug,
+        'status', c.status,
+        'created_at', c.created_at,
+        'updated_at', c.updated_at,
+        'published_at', c.published_at,
+        'author_id', c.author_id,
+        'version', c.version,
+        'scheduled_at', c.scheduled_at,
+        'expires_at', c.expires_at,
+        'deleted_at', c.deleted_at,
+        'category_id', c.category_id,
+        'tag_ids', '[]'::jsonb,
+        'excerpt', c.excerpt,
+        'cover_image_url', c.cover_image_url
+    ) || COALESCE((
+        SELECT jsonb_build_object('blocks', jsonb_agg(
+            jsonb_build_object(
+                'id', cb.id,
+                'content_id', cb.content_id,
+                'block_type', cb.block_type,
+                'block_order', cb.block_order,
+                'is_visible', cb.is_visible,
+                'created_at', cb.created_at,
+                'updated_at', cb.updated_at,
+                'data', jsonb_build_object(
+                    'id', cbd.id,
+                    'block_id', cbd.block_id,
+                    'data_type', cbd.data_type,
+                    'content_text', COALESCE(cbd.content_text, ''),
+                    'content_richtext', cbd.content_richtext,
+                    'content_number', cbd.content_number,
+                    'content_url', COALESCE(cbd.content_url, ''),
+                    'content_json', cbd.content_json,
+                    'referenced_content_id', cbd.referenced_content_id,
+                    'settings', cbd.settings,
+                    'created_at', cbd.created_at,
+                    'updated_at', cbd.updated_at
+                )
+            ) ORDER BY cb.block_order, cb.created_at
+        ))
+        FROM content_blocks cb
+        JOIN content_block_data cbd ON cbd.block_id = cb.id
+        WHERE cb.content_id = c.id
+        -- ブロックがない場合はblocksを含めない（アプリケーションのスナップショットと同じ）
+        HAVING COUNT(*) > 0
+    ), '{}'::jsonb)
+FROM contents c
+WHERE c.id IN (
+    '550e8400-e29b-41d4-a716-446655440201',
+    '550e8400-e29b-41d4-a716-446655440202',
+    '550e8400-e29b-41d4-a716-446655440203'
+);
@@ -199,7 +199,9 @@ CREATE INDEX idx_contents_status_created_at ON contents(status, created_at DESC)
 CREATE INDEX idx_contents_author_status ON contents(author_id, status);
 CREATE INDEX idx_contents_type_status ON contents(content_type_id, status);
 
-CREATE INDEX idx_contents_title_gin ON contents USING GIN (title gin_trgm_ops);
+-- 検索はタイトル・スラッグをNFKC正規化して比較するため、正規化した式に索引を張る
+CREATE INDEX idx_contents_title_gin ON contents USING GIN ((normalize(title, NFKC)) gin_trgm_ops);
+CREATE INDEX idx_contents_slug_gin ON contents USING GIN ((normalize(slug, NFKC)) gin_trgm_ops);
 
 -- コンテンツタイプ関連のインデックス
 CREATE INDEX idx_content_types_name ON content_types(name);
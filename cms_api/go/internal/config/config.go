@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/knadh/koanf/providers/env"
 	"github.com/knadh/koanf/v2"
@@ -11,9 +12,10 @@ import (
 
 // Config はアプリケーション設定を管理する構造体です
 type Config struct {
-	Server   ServerConfig   `koanf:"server"`
-	Database DatabaseConfig `koanf:"database"`
-	AWS      AWSConfig      `koanf:"aws"`
+	Server    ServerConfig    `koanf:"server"`
+	Database  DatabaseConfig  `koanf:"database"`
+	AWS       AWSConfig       `koanf:"aws"`
+	Scheduler SchedulerConfig `koanf:"scheduler"`
 }
 
 // ServerConfig はサーバー関連の設定を管理します
@@ -37,6 +39,15 @@ type AWSConfig struct {
 	Region string `koanf:"region"`
 }
 
+// SchedulerConfig は公開予約スケジューラーの設定を管理します
+type SchedulerConfig struct {
+	// Enabledがtrueの場合、スタンドアロンサーバーでスケジューラーを起動する（既定は起動しない）
+	Enabled  bool          `koanf:"enabled"`
+	Interval time.Duration `koanf:"interval"`
+	// TrashRetentionを過ぎたゴミ箱のコンテンツを完全に削除する（0の場合は自動削除しない）
+	TrashRetention time.Duration `koanf:"trashretention"`
+}
+
 // DefaultConfig はデフォルト設定を返します
 func DefaultConfig() *Config {
 	return &Config{
@@ -54,6 +65,11 @@ func DefaultConfig() *Config {
 		AWS: AWSConfig{
 			Region: "ap-northeast-1",
 		},
+		Scheduler: SchedulerConfig{
+			Enabled:        false,
+			Interval:       time.Minute,
+			TrashRetention: 30 * 24 * time.Hour,
+		},
 	}
 }
 
@@ -69,7 +85,7 @@ func LoadConfig() (*Config, error) {
 	// 環境変数プレフィックスは "CMS_API_" を使用
 	if err := k.Load(env.Provider("CMS_API_", ".", func(s string) string {
 		// CMS_API_SERVER_PORT -> server.port のように変換
-		return strings.ToLower(strings.Replace(s, "_", ".", -1))
+		return strings.ToLower(strings.Replace(strings.TrimPrefix(s, "CMS_API_"), "_", ".", -1))
 	}), nil); err != nil {
 		log.Printf("環境変数の読み込みでエラーが発生しました: %v", err)
 		return cfg, nil // エラーがあってもデフォルト設定で続行
@@ -110,6 +126,14 @@ func validateConfig(cfg *Config) error {
 		return fmt.Errorf("データベース名が設定されていません")
 	}
 
+	if cfg.Scheduler.Enabled && cfg.Scheduler.Interval <= 0 {
+		return fmt.Errorf("スケジューラーの実行間隔が不正です: %s", cfg.Scheduler.Interval)
+	}
+
+	if cfg.Scheduler.TrashRetention < 0 {
+		return fmt.Errorf("ゴミ箱の保持期間が不正です: %s", cfg.Scheduler.TrashRetention)
+	}
+
 	return nil
 }
 
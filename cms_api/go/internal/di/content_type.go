@@ -0,0 +1,26 @@
+package route
+
+import (
+	"cms_api/internal/config"
+	"cms_api/internal/infrastructure/cli"
+	"cms_api/internal/infrastructure/database"
+	"cms_api/internal/infrastructure/repository"
+	usecase "cms_api/internal/usecase/content"
+	"log"
+	"os"
+)
+
+// ContentTypeCommandHandler は設定を受け取ってコンテンツタイプの定義をエクスポート・インポートするコマンドを構築します
+func ContentTypeCommandHandler(cfg *config.Config) *cli.ContentTypeCommand {
+	// PostgreSQLデータベース接続の初期化
+	postgresDB, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		log.Fatalf("PostgreSQL接続の初期化に失敗しました: %v", err)
+	}
+
+	// リポジトリ・ユースケースの初期化
+	contentRepository := repository.NewContentRepository(postgresDB.GetDB())
+	contentUsecase := usecase.NewContentUsecase(contentRepository)
+
+	return cli.NewContentTypeCommand(contentUsecase, os.Stdout, os.Stderr)
+}
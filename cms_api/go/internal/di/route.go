@@ -15,24 +15,23 @@ import (
 
 // RouteHandler は設定を受け取ってEchoインスタンスを構築します
 func RouteHandler(cfg *config.Config) *echo.Echo {
-	e := echo.New()
-	e.Use(middleware.Recover())
-	e.Use(middleware.Logger())
-
 	// PostgreSQLデータベース接続の初期化
 	postgresDB, err := database.NewPostgresDB(cfg)
 	if err != nil {
 		log.Fatalf("PostgreSQL接続の初期化に失敗しました: %v", err)
 	}
 
-	// DynamoDB接続も保持（段階的移行のため）
-	dynamoClient, err := repository.NewDynamoDBClient()
-	if err != nil {
-		log.Printf("DynamoDB接続の初期化に失敗しました（PostgreSQLで続行）: %v", err)
-	}
+	return newRouter(postgresDB)
+}
+
+// newRouter はデータベース接続を受け取ってEchoインスタンスを構築します
+func newRouter(postgresDB *database.PostgresDB) *echo.Echo {
+	e := echo.New()
+	e.Use(middleware.Recover())
+	e.Use(middleware.Logger())
 
-	// リポジトリの初期化（現在はDynamoDBを使用、将来的にPostgreSQLに移行予定）
-	contentRepository := repository.NewContentRepository(dynamoClient)
+	// リポジトリの初期化
+	contentRepository := repository.NewContentRepository(postgresDB.GetDB())
 
 	// ユースケースの初期化
 	contentUsecase := usecase.NewContentUsecase(contentRepository)
@@ -41,7 +40,60 @@ func RouteHandler(cfg *config.Config) *echo.Echo {
 	contentController := controller.NewContentController(contentUsecase)
 
 	// ルーティング設定
-	e.GET("/", contentController.GetContent)
+	e.GET("/contents", contentController.GetContents)
+	e.GET("/contents/:id", contentController.GetContentByID)
+	e.POST("/contents", contentController.CreateContent)
+	e.PUT("/contents/:id", contentController.UpdateContent)
+	e.PATCH("/contents/:id", contentController.PatchContent)
+	e.DELETE("/contents/:id", contentController.DeleteContent)
+	e.POST("/contents/:id/publish", contentController.PublishContent)
+	e.POST("/contents/:id/unpublish", contentController.UnpublishContent)
+	e.POST("/contents/:id/archive", contentController.ArchiveContent)
+	e.POST("/contents/:id/trash", contentController.TrashContent)
+	e.POST("/contents/:id/restore", contentController.RestoreContent)
+	e.PUT("/contents/:id/schedule", contentController.ScheduleContent)
+	e.DELETE("/contents/:id/schedule", contentController.UnscheduleContent)
+	e.POST("/contents/:id/blocks", contentController.InsertBlock)
+	e.PUT("/contents/:id/blocks/:blockId/position", contentController.MoveBlock)
+	e.PUT("/contents/:id/blocks/:blockId/visibility", contentController.SetBlockVisibility)
+	e.DELETE("/contents/:id/blocks/:blockId", contentController.DeleteBlock)
+	e.GET("/contents/:id/versions", contentController.GetContentVersions)
+	e.GET("/contents/:id/versions/:version", contentController.GetContentVersion)
+	e.POST("/contents/:id/versions/:version/restore", contentController.RestoreContentVersion)
+	e.GET("/contents/:id/versions/:a/diff/:b", contentController.DiffContentVersions)
+	e.GET("/contents/:id/redirects", contentController.GetSlugRedirects)
+	e.GET("/contents/:id/referrers", contentController.GetReferrers)
+	e.DELETE("/contents/:id/redirects/:redirectId", contentController.DeleteSlugRedirect)
+	e.GET("/trash", contentController.GetTrashedContents)
+	e.DELETE("/trash", contentController.PurgeTrash)
+	e.POST("/trash/:id/restore", contentController.RestoreContent)
+	e.DELETE("/trash/:id", contentController.PurgeContent)
+	e.GET("/content-types", contentController.GetContentTypes)
+	e.GET("/content-types/export", contentController.ExportContentTypes)
+	e.POST("/content-types/import", contentController.ImportContentTypes)
+	e.GET("/content-types/:id", contentController.GetContentTypeByID)
+	e.POST("/content-types", contentController.CreateContentType)
+	e.PUT("/content-types/:id", contentController.UpdateContentType)
+	e.DELETE("/content-types/:id", contentController.DeactivateContentType)
+	e.POST("/content-types/:id/activate", contentController.ActivateContentType)
+	e.GET("/content-types/:id/schema-versions", contentController.GetContentTypeSchemaVersions)
+	e.POST("/content-types/:id/migrations", contentController.MigrateContentType)
+	e.GET("/categories", contentController.GetCategories)
+	e.GET("/categories/counts", contentController.GetCategoryCounts)
+	e.GET("/categories/:id", contentController.GetCategoryByID)
+	e.POST("/categories", contentController.CreateCategory)
+	e.PUT("/categories/:id", contentController.UpdateCategory)
+	e.DELETE("/categories/:id", contentController.DeleteCategory)
+	e.GET("/tags", contentController.GetTags)
+	e.GET("/tags/counts", contentController.GetTagCounts)
+	e.GET("/tags/:id", contentController.GetTagByID)
+	e.POST("/tags", contentController.CreateTag)
+	e.PUT("/tags/:id", contentController.UpdateTag)
+	e.DELETE("/tags/:id", contentController.DeleteTag)
+	e.GET("/archives", contentController.GetArchiveCounts)
+	e.GET("/archives/:year/:month", contentController.GetArchiveContents)
+	e.GET("/search", contentController.SearchContents)
+	e.GET("/content-types/:name/contents/:slug", contentController.GetContentBySlug)
 	e.GET("/healthcheck", func(c echo.Context) error {
 		return healthcheck.HealthcheckWithDB(c, postgresDB)
 	})
@@ -0,0 +1,30 @@
+package route
+
+import (
+	"cms_api/internal/config"
+	"cms_api/internal/infrastructure/database"
+	"cms_api/internal/infrastructure/repository"
+	"cms_api/internal/infrastructure/scheduler"
+	usecase "cms_api/internal/usecase/content"
+	"log"
+)
+
+// SchedulerHandler は設定を受け取って公開予約・ゴミ箱の自動削除を行うスケジューラーを構築します
+func SchedulerHandler(cfg *config.Config) *scheduler.Scheduler {
+	// PostgreSQLデータベース接続の初期化
+	postgresDB, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		log.Fatalf("PostgreSQL接続の初期化に失敗しました: %v", err)
+	}
+
+	return newScheduler(cfg, postgresDB)
+}
+
+// newScheduler は設定とデータベース接続を受け取ってスケジューラーを構築します
+func newScheduler(cfg *config.Config, postgresDB *database.PostgresDB) *scheduler.Scheduler {
+	// リポジトリ・ユースケースの初期化
+	contentRepository := repository.NewContentRepository(postgresDB.GetDB())
+	contentUsecase := usecase.NewContentUsecase(contentRepository)
+
+	return scheduler.NewScheduler(contentUsecase, cfg.Scheduler.Interval, cfg.Scheduler.TrashRetention)
+}
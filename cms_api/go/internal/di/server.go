@@ -0,0 +1,26 @@
+package route
+
+import (
+	"cms_api/internal/config"
+	"cms_api/internal/infrastructure/database"
+	"cms_api/internal/infrastructure/scheduler"
+	"log"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ServerHandler は設定を受け取ってEchoインスタンスとスケジューラーを1つのデータベース接続で構築します
+// スケジューラーが無効な場合はnilを返します
+func ServerHandler(cfg *config.Config) (*echo.Echo, *scheduler.Scheduler) {
+	// PostgreSQLデータベース接続の初期化
+	postgresDB, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		log.Fatalf("PostgreSQL接続の初期化に失敗しました: %v", err)
+	}
+
+	var contentScheduler *scheduler.Scheduler
+	if cfg.Scheduler.Enabled {
+		contentScheduler = newScheduler(cfg, postgresDB)
+	}
+	return newRouter(postgresDB), contentScheduler
+}
@@ -16,6 +16,7 @@ const (
 	ContentStatusDraft     ContentStatus = "draft"
 	ContentStatusPublished ContentStatus = "published"
 	ContentStatusArchived  ContentStatus = "archived"
+	ContentStatusTrash     ContentStatus = "trash"
 )
 
 // BlockType はブロックの種類を表す列挙型
@@ -54,9 +55,22 @@ type Content struct {
 	PublishedAt   *time.Time    `json:"published_at"`
 	AuthorID      string        `json:"author_id"`
 	Version       int           `json:"version"`
+	// ScheduledAtは公開予約日時、ExpiresAtは公開終了日時（いずれも公開操作で管理する）
+	ScheduledAt *time.Time `json:"scheduled_at"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	// DeletedAtはゴミ箱へ移動した日時（ゴミ箱のコンテンツのみ設定される）
+	DeletedAt *time.Time `json:"deleted_at"`
+	// CategoryIDとTagIDsは保存時に関連付けるカテゴリとタグ（取得時はCategory・Tagsと同じ内容）
+	CategoryID *uuid.UUID  `json:"category_id"`
+	TagIDs     []uuid.UUID `json:"tag_ids"`
+	// ExcerptとCoverImageURLは一覧用の抜粋とカバー画像（保存時にブロックから作成する）
+	Excerpt       string `json:"excerpt"`
+	CoverImageURL string `json:"cover_image_url"`
 	
 	// リレーション
 	ContentType *ContentType   `json:"content_type,omitempty"`
+	Category    *Category      `json:"category,omitempty"`
+	Tags        []Tag          `json:"tags,omitempty"`
 	Blocks      []ContentBlock `json:"blocks,omitempty"`
 }
 
@@ -71,6 +85,10 @@ type ContentType struct {
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 	CreatedBy   string    `json:"created_by"`
+	// FieldSchemaは使えるブロックの種類・必須かどうか・並び順・上限の定義
+	FieldSchema FieldSchema `json:"field_schema"`
+	// SchemaVersionはフィールドの定義の版（作成時は1で、定義を変更するたびに1つ進む）
+	SchemaVersion int `json:"schema_version"`
 }
 
 // ContentBlock はコンテンツブロックのドメインエンティティ
@@ -106,6 +124,40 @@ type ContentBlockData struct {
 	// リレーション
 	Block             *ContentBlock `json:"block,omitempty"`
 	ReferencedContent *Content      `json:"referenced_content,omitempty"`
+	// ReferenceCycleは参照を展開した際に参照先が展開元（祖先）のコンテンツだったため展開しなかったことを表す
+	ReferenceCycle bool `json:"reference_cycle,omitempty"`
+}
+
+// ContentVersion はコンテンツの版（更新ごとのスナップショット）のドメインエンティティ
+type ContentVersion struct {
+	ID        uuid.UUID     `json:"id"`
+	ContentID uuid.UUID     `json:"content_id"`
+	Version   int           `json:"version"`
+	Title     string        `json:"title"`
+	Status    ContentStatus `json:"status"`
+	AuthorID  string        `json:"author_id"`
+	CreatedAt time.Time     `json:"created_at"`
+	
+	// Snapshotはブロックとブロックデータを含むその版のコンテンツ全体（一覧取得時は含まない）
+	Snapshot *Content `json:"snapshot,omitempty"`
+}
+
+// IsValid はステータスが定義済みの値かを確認
+func (s ContentStatus) IsValid() bool {
+	switch s {
+	case ContentStatusDraft, ContentStatusPublished, ContentStatusArchived, ContentStatusTrash:
+		return true
+	}
+	return false
+}
+
+// IsValid はブロックの種類が定義済みの値かを確認
+func (t BlockType) IsValid() bool {
+	switch t {
+	case BlockTypeText, BlockTypeRichText, BlockTypeImage, BlockTypeVideo, BlockTypeEmbed, BlockTypeReference:
+		return true
+	}
+	return false
 }
 
 // IsPublished はコンテンツが公開されているかを確認
@@ -115,7 +167,7 @@ func (c *Content) IsPublished() bool {
 
 // IsActive はコンテンツが有効かを確認
 func (c *Content) IsActive() bool {
-	return c.Status != ContentStatusArchived
+	return c.Status != ContentStatusArchived && c.Status != ContentStatusTrash
 }
 
 // Validate はContentの基本的なバリデーション
@@ -146,5 +198,5 @@ func (ct *ContentType) Validate() error {
 	if ct.CreatedBy == "" {
 		return fmt.Errorf("作成者は必須です")
 	}
-	return nil
+	return ct.FieldSchema.Validate()
 }
\ No newline at end of file
@@ -0,0 +1,186 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefinitionFormat はコンテンツタイプの定義ファイルの形式
+type DefinitionFormat string
+
+const (
+	DefinitionFormatYAML DefinitionFormat = "yaml"
+	DefinitionFormatJSON DefinitionFormat = "json"
+)
+
+// ParseDefinitionFormat は定義ファイルの形式の名前を解釈します（空の場合はYAML）
+func ParseDefinitionFormat(name string) (DefinitionFormat, error) {
+	switch strings.ToLower(name) {
+	case "", "yaml", "yml":
+		return DefinitionFormatYAML, nil
+	case "json":
+		return DefinitionFormatJSON, nil
+	}
+	return "", fmt.Errorf("不明な定義ファイルの形式です: %s", name)
+}
+
+// DefinitionFormatFromPath はファイルの拡張子から定義ファイルの形式を判定します（.json以外はYAML）
+func DefinitionFormatFromPath(path string) DefinitionFormat {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return DefinitionFormatJSON
+	}
+	return DefinitionFormatYAML
+}
+
+// ContentTypeDefinitions はファイルで管理するコンテンツタイプの定義の一覧
+type ContentTypeDefinitions struct {
+	ContentTypes []ContentTypeDefinition `json:"content_types"`
+}
+
+// ContentTypeDefinition はファイルで管理するコンテンツタイプの定義
+// IDや作成者・フィールドの定義の版など、環境ごとに異なる項目は含みません（コンテンツタイプは名前で対応付ける）
+type ContentTypeDefinition struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	// IsActiveは有効かどうか（省略時は有効）
+	IsActive    *bool       `json:"is_active,omitempty"`
+	FieldSchema FieldSchema `json:"field_schema"`
+}
+
+// NewContentTypeDefinition はコンテンツタイプから定義を作成します
+func NewContentTypeDefinition(contentType *ContentType) ContentTypeDefinition {
+	active := contentType.IsActive
+	return ContentTypeDefinition{
+		Name:        contentType.Name,
+		DisplayName: contentType.DisplayName,
+		Description: contentType.Description,
+		Icon:        contentType.Icon,
+		IsActive:    &active,
+		FieldSchema: normalizeFieldSchema(contentType.FieldSchema),
+	}
+}
+
+// Active は定義が有効なコンテンツタイプかを返します
+func (d *ContentTypeDefinition) Active() bool {
+	return d.IsActive == nil || *d.IsActive
+}
+
+// ContentType は定義からコンテンツタイプを作成します（ID・作成者は呼び出し元で設定する）
+func (d *ContentTypeDefinition) ContentType() *ContentType {
+	return &ContentType{
+		Name:        d.Name,
+		DisplayName: d.DisplayName,
+		Description: d.Description,
+		Icon:        d.Icon,
+		IsActive:    d.Active(),
+		FieldSchema: d.FieldSchema,
+	}
+}
+
+// SameAttributes は表示名・説明・アイコン・フィールドの定義がコンテンツタイプと一致するかを確認します（有効かどうかは比較しない）
+func (d *ContentTypeDefinition) SameAttributes(contentType *ContentType) bool {
+	if d.DisplayName != contentType.DisplayName || d.Description != contentType.Description || d.Icon != contentType.Icon {
+		return false
+	}
+	return d.FieldSchema.Equal(contentType.FieldSchema)
+}
+
+// Validate は定義の一覧の妥当性を検証します（名前の重複を含む）
+func (d *ContentTypeDefinitions) Validate() error {
+	seen := make(map[string]bool, len(d.ContentTypes))
+	for i := range d.ContentTypes {
+		definition := &d.ContentTypes[i]
+		contentType := definition.ContentType()
+		if err := contentType.ValidateName(); err != nil {
+			return fmt.Errorf("content_types[%d]: %v", i, err)
+		}
+		if seen[definition.Name] {
+			return fmt.Errorf("content_types[%d]: 名前が重複しています: %s", i, definition.Name)
+		}
+		seen[definition.Name] = true
+
+		if definition.DisplayName == "" {
+			return fmt.Errorf("content_types[%d]: 表示名は必須です", i)
+		}
+		if err := contentType.FieldSchema.Validate(); err != nil {
+			return fmt.Errorf("content_types[%d]: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Marshal は定義の一覧を指定した形式で出力します
+// YAMLは項目の順序をJSONと揃えるため、一度JSONにしてから変換します
+func (d *ContentTypeDefinitions) Marshal(format DefinitionFormat) ([]byte, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("定義の出力に失敗しました: %w", err)
+	}
+	if format == DefinitionFormatJSON {
+		return append(data, '\n'), nil
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("定義の出力に失敗しました: %w", err)
+	}
+	resetStyle(&node)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, fmt.Errorf("定義の出力に失敗しました: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("定義の出力に失敗しました: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalContentTypeDefinitions は指定した形式の定義ファイルを読み込みます
+// 項目名の誤りに気付けるよう、不明な項目はエラーにします
+func UnmarshalContentTypeDefinitions(data []byte, format DefinitionFormat) (*ContentTypeDefinitions, error) {
+	if format == DefinitionFormatYAML {
+		var v interface{}
+		if err := yaml.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("YAMLの形式が不正です: %v", err)
+		}
+		converted, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("YAMLの形式が不正です: %v", err)
+		}
+		data = converted
+	}
+
+	var definitions ContentTypeDefinitions
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&definitions); err != nil {
+		return nil, fmt.Errorf("定義ファイルの形式が不正です: %v", err)
+	}
+	return &definitions, nil
+}
+
+// normalizeFieldSchema はフィールドの定義がない場合に空の一覧にします
+func normalizeFieldSchema(schema FieldSchema) FieldSchema {
+	if schema.Fields == nil {
+		schema.Fields = []FieldDefinition{}
+	}
+	return schema
+}
+
+// resetStyle はJSONから読み込んだノードの書式（フロー形式や引用符）を解除し、YAMLの標準の書式で出力させます
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
@@ -0,0 +1,182 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentTypeDefinitionsMarshal(t *testing.T) {
+	inactive := false
+	definitions := &ContentTypeDefinitions{ContentTypes: []ContentTypeDefinition{
+		{
+			Name:        "blog_post",
+			DisplayName: "ブログ記事",
+			Icon:        "article",
+			FieldSchema: FieldSchema{Fields: []FieldDefinition{
+				{BlockType: BlockTypeRichText, Label: "本文", Required: true, MaxLength: 20000},
+				{BlockType: BlockTypeEmbed, MinValue: decimalPtr(1), MaxValue: decimalPtr(10)},
+			}, Ordered: true},
+		},
+		{Name: "event", DisplayName: "イベント", IsActive: &inactive, FieldSchema: FieldSchema{Fields: []FieldDefinition{}}},
+	}}
+
+	t.Run("正常系：YAMLは項目をJSONと同じ順に出力し、読み込むと同じ定義になる場合", func(t *testing.T) {
+		data, err := definitions.Marshal(DefinitionFormatYAML)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "content_types:\n  - name: blog_post\n    display_name: ブログ記事\n    icon: article\n")
+		assert.Contains(t, string(data), `min_value: "1"`)
+
+		parsed, err := UnmarshalContentTypeDefinitions(data, DefinitionFormatYAML)
+		require.NoError(t, err)
+		assert.Equal(t, definitions, parsed)
+	})
+
+	t.Run("正常系：JSONで出力し、読み込むと同じ定義になる場合", func(t *testing.T) {
+		data, err := definitions.Marshal(DefinitionFormatJSON)
+		require.NoError(t, err)
+
+		parsed, err := UnmarshalContentTypeDefinitions(data, DefinitionFormatJSON)
+		require.NoError(t, err)
+		assert.Equal(t, definitions, parsed)
+	})
+}
+
+func TestUnmarshalContentTypeDefinitions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      string
+		format    DefinitionFormat
+		expected  *ContentTypeDefinitions
+		expectErr bool
+	}{
+		{
+			name: "正常系：手書きのYAMLで有効かどうかと数値の範囲を省略・数値で指定する場合",
+			data: `
+content_types:
+  - name: news
+    display_name: お知らせ
+    field_schema:
+      fields:
+        - block_type: embed
+          max_value: 100
+`,
+			format: DefinitionFormatYAML,
+			expected: &ContentTypeDefinitions{ContentTypes: []ContentTypeDefinition{{
+				Name:        "news",
+				DisplayName: "お知らせ",
+				FieldSchema: FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeEmbed, MaxValue: decimalPtr(100)}}},
+			}}},
+		},
+		{
+			name:     "正常系：空のファイルの場合",
+			format:   DefinitionFormatYAML,
+			expected: &ContentTypeDefinitions{},
+		},
+		{
+			name:      "異常系：不明な項目がある場合",
+			data:      `{"content_types": [{"name": "news", "displayName": "お知らせ"}]}`,
+			format:    DefinitionFormatJSON,
+			expectErr: true,
+		},
+		{
+			name:      "異常系：YAMLの形式が不正な場合",
+			data:      "content_types: [",
+			format:    DefinitionFormatYAML,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := UnmarshalContentTypeDefinitions([]byte(tc.data), tc.format)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestContentTypeDefinitionsValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		definitions []ContentTypeDefinition
+		expectErr   bool
+	}{
+		{
+			name: "正常系：名前が重複しない場合",
+			definitions: []ContentTypeDefinition{
+				{Name: "blog_post", DisplayName: "ブログ記事"},
+				{Name: "page", DisplayName: "固定ページ"},
+			},
+		},
+		{
+			name: "異常系：名前が重複する場合",
+			definitions: []ContentTypeDefinition{
+				{Name: "page", DisplayName: "固定ページ"},
+				{Name: "page", DisplayName: "ページ"},
+			},
+			expectErr: true,
+		},
+		{
+			name:        "異常系：名前の形式が不正な場合",
+			definitions: []ContentTypeDefinition{{Name: "Blog Post", DisplayName: "ブログ記事"}},
+			expectErr:   true,
+		},
+		{
+			name:        "異常系：表示名がない場合",
+			definitions: []ContentTypeDefinition{{Name: "page"}},
+			expectErr:   true,
+		},
+		{
+			name: "異常系：フィールドの定義が不正な場合",
+			definitions: []ContentTypeDefinition{{Name: "page", DisplayName: "固定ページ", FieldSchema: FieldSchema{
+				Fields: []FieldDefinition{{BlockType: BlockTypeImage, MaxLength: 100}},
+			}}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			definitions := &ContentTypeDefinitions{ContentTypes: tc.definitions}
+			err := definitions.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContentTypeDefinitionSameAttributes(t *testing.T) {
+	contentType := &ContentType{
+		Name:        "blog_post",
+		DisplayName: "ブログ記事",
+		IsActive:    false,
+		CreatedBy:   "admin",
+		FieldSchema: FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeEmbed, MaxValue: decimalPtr(10)}}},
+	}
+	definition := NewContentTypeDefinition(contentType)
+	assert.False(t, definition.Active())
+	assert.True(t, definition.SameAttributes(contentType))
+
+	// 有効かどうかは比較せず、フィールドの定義は値で比較する
+	definition.IsActive = nil
+	assert.True(t, definition.Active())
+	definition.FieldSchema = FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeEmbed, MaxValue: decimalPtr(10)}}}
+	assert.True(t, definition.SameAttributes(contentType))
+
+	definition.FieldSchema.Fields[0].MaxValue = decimalPtr(20)
+	assert.False(t, definition.SameAttributes(contentType))
+
+	// フィールドの定義がない場合は空の一覧と同じとして扱う
+	assert.True(t, (&ContentTypeDefinition{DisplayName: "固定ページ"}).SameAttributes(&ContentType{
+		DisplayName: "固定ページ", FieldSchema: FieldSchema{Fields: []FieldDefinition{}},
+	}))
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "errors"
+
+// ドメイン共通のエラー
+// 各層はこれらをラップして返し、コントローラーがHTTPステータスへ変換する
+var (
+	// ErrInvalidParameter はパラメータの値が不正であることを表す
+	ErrInvalidParameter = errors.New("不正なパラメータです")
+	// ErrContentNotFound はコンテンツが存在しないことを表す
+	ErrContentNotFound = errors.New("コンテンツが見つかりません")
+	// ErrBlockNotFound はコンテンツブロックが存在しないことを表す
+	ErrBlockNotFound = errors.New("コンテンツブロックが見つかりません")
+	// ErrContentVersionNotFound はコンテンツの指定された版が存在しないことを表す
+	ErrContentVersionNotFound = errors.New("コンテンツのバージョンが見つかりません")
+	// ErrVersionConflict は更新時に指定されたバージョンが最新ではないことを表す
+	ErrVersionConflict = errors.New("コンテンツが他の更新と競合しました")
+	// ErrInvalidStatusTransition は現在のステータスから許可されていない遷移であることを表す
+	ErrInvalidStatusTransition = errors.New("許可されていないステータスの変更です")
+	// ErrContentTypeNotFound はコンテンツタイプが存在しないことを表す
+	ErrContentTypeNotFound = errors.New("コンテンツタイプが見つかりません")
+	// ErrCategoryNotFound はカテゴリが存在しないことを表す
+	ErrCategoryNotFound = errors.New("カテゴリが見つかりません")
+	// ErrTagNotFound はタグが存在しないことを表す
+	ErrTagNotFound = errors.New("タグが見つかりません")
+	// ErrSlugRedirectNotFound はスラッグのリダイレクトが存在しないことを表す
+	ErrSlugRedirectNotFound = errors.New("スラッグのリダイレクトが見つかりません")
+	// ErrAlreadyExists は一意であるべき値（スラッグなど）が既に使われていることを表す
+	ErrAlreadyExists = errors.New("既に存在します")
+	// ErrContentReferenced は他のコンテンツの参照ブロックから参照されているため操作できないことを表す
+	ErrContentReferenced = errors.New("他のコンテンツから参照されています")
+)
@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxFieldLabelLength はフィールドの表示名の最大文字数
+const MaxFieldLabelLength = 100
+
+// contentTypeNamePattern はコンテンツタイプ名の形式（URLに使うため英小文字で始まる英小文字・数字・「_」）
+var contentTypeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,99}$`)
+
+// FieldSchema はコンテンツタイプごとのフィールド（ブロック）の定義
+// Fieldsが空の場合は、すべての種類のブロックを制限なく使えます
+type FieldSchema struct {
+	// Fieldsは使えるブロックの種類ごとの定義（エディタではこの順に表示する）
+	Fields []FieldDefinition `json:"fields"`
+	// Orderedがtrueの場合、ブロックはFieldsの順に並べる必要がある（同じ種類のブロックは連続させる）
+	Ordered bool `json:"ordered"`
+}
+
+// FieldDefinition はブロックの種類ごとのフィールドの定義
+type FieldDefinition struct {
+	BlockType BlockType `json:"block_type"`
+	Label     string    `json:"label"`
+	// Requiredがtrueの場合は表示中のブロックが1つ以上必要
+	Required bool `json:"required"`
+	// MaxCountはブロック数の上限、MaxLengthはテキスト・リッチテキストの本文の最大文字数（いずれも0は無制限）
+	MaxCount  int `json:"max_count"`
+	MaxLength int `json:"max_length"`
+	// MinValue・MaxValueは数値のブロックデータの範囲（nilは無制限）
+	MinValue *decimal.Decimal `json:"min_value,omitempty"`
+	MaxValue *decimal.Decimal `json:"max_value,omitempty"`
+	// ReferenceTypesは参照ブロックで参照できるコンテンツタイプ名（空の場合はすべてのコンテンツタイプ）
+	ReferenceTypes []string `json:"reference_types,omitempty"`
+}
+
+// Validate はフィールドの定義の妥当性を検証します
+func (s *FieldSchema) Validate() error {
+	seen := make(map[BlockType]bool)
+	for i, field := range s.Fields {
+		if !field.BlockType.IsValid() {
+			return fmt.Errorf("fields[%d]: 不明なブロックの種類です: %s", i, field.BlockType)
+		}
+		if seen[field.BlockType] {
+			return fmt.Errorf("fields[%d]: ブロックの種類が重複しています: %s", i, field.BlockType)
+		}
+		seen[field.BlockType] = true
+
+		if len([]rune(field.Label)) > MaxFieldLabelLength {
+			return fmt.Errorf("fields[%d]: 表示名は%d文字以内で指定してください", i, MaxFieldLabelLength)
+		}
+		if field.MaxCount < 0 || field.MaxLength < 0 {
+			return fmt.Errorf("fields[%d]: 上限には0以上の値を指定してください", i)
+		}
+		if field.MaxLength > 0 && field.BlockType != BlockTypeText && field.BlockType != BlockTypeRichText {
+			return fmt.Errorf("fields[%d]: 最大文字数はテキスト・リッチテキストのブロックにのみ指定できます", i)
+		}
+		if field.MinValue != nil && field.MaxValue != nil && field.MinValue.GreaterThan(*field.MaxValue) {
+			return fmt.Errorf("fields[%d]: 最小値は最大値以下で指定してください", i)
+		}
+		if len(field.ReferenceTypes) > 0 && field.BlockType != BlockTypeReference {
+			return fmt.Errorf("fields[%d]: 参照できるコンテンツタイプは参照ブロックにのみ指定できます", i)
+		}
+		for _, name := range field.ReferenceTypes {
+			if !contentTypeNamePattern.MatchString(name) {
+				return fmt.Errorf("fields[%d]: 参照できるコンテンツタイプ名が不正です: %s", i, name)
+			}
+		}
+	}
+	return nil
+}
+
+// Equal はフィールドの定義が同じ内容かを確認します
+// 空の一覧とnullの違いや数値の表記の違いを無視するため、JSONにしてから比較する
+func (s *FieldSchema) Equal(other FieldSchema) bool {
+	a, errA := json.Marshal(normalizeFieldSchema(*s))
+	b, errB := json.Marshal(normalizeFieldSchema(other))
+	return errA == nil && errB == nil && bytes.Equal(a, b)
+}
+
+// Field はブロックの種類のフィールドの定義を返します（定義がない場合はfalse）
+func (s *FieldSchema) Field(blockType BlockType) (FieldDefinition, bool) {
+	if i := s.fieldIndex(blockType); i >= 0 {
+		return s.Fields[i], true
+	}
+	return FieldDefinition{}, false
+}
+
+// fieldIndex はブロックの種類のフィールドの定義の位置を返します（定義がない場合は-1）
+func (s *FieldSchema) fieldIndex(blockType BlockType) int {
+	for i, field := range s.Fields {
+		if field.BlockType == blockType {
+			return i
+		}
+	}
+	return -1
+}
+
+// ValidateName はコンテンツタイプ名の形式を検証します
+func (ct *ContentType) ValidateName() error {
+	if !contentTypeNamePattern.MatchString(ct.Name) {
+		return fmt.Errorf("名前は英小文字で始まる100文字以内の英小文字・数字・「_」で指定してください: %s", ct.Name)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldSchemaValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		schema    FieldSchema
+		expectErr bool
+	}{
+		{
+			name: "正常系：定義がない場合",
+		},
+		{
+			name: "正常系：ブロックの種類ごとに必須・上限を定義する場合",
+			schema: FieldSchema{Fields: []FieldDefinition{
+				{BlockType: BlockTypeRichText, Label: "本文", Required: true, MaxLength: 10000},
+				{BlockType: BlockTypeImage, Label: "画像", MaxCount: 10},
+			}, Ordered: true},
+		},
+		{
+			name:      "異常系：不明なブロックの種類",
+			schema:    FieldSchema{Fields: []FieldDefinition{{BlockType: "table"}}},
+			expectErr: true,
+		},
+		{
+			name: "異常系：ブロックの種類が重複する場合",
+			schema: FieldSchema{Fields: []FieldDefinition{
+				{BlockType: BlockTypeText}, {BlockType: BlockTypeText},
+			}},
+			expectErr: true,
+		},
+		{
+			name:      "異常系：上限が負の場合",
+			schema:    FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeImage, MaxCount: -1}}},
+			expectErr: true,
+		},
+		{
+			name:      "異常系：テキスト以外に最大文字数を指定した場合",
+			schema:    FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeImage, MaxLength: 100}}},
+			expectErr: true,
+		},
+		{
+			name:      "異常系：最小値が最大値より大きい場合",
+			schema:    FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeEmbed, MinValue: decimalPtr(10), MaxValue: decimalPtr(1)}}},
+			expectErr: true,
+		},
+		{
+			name:      "異常系：参照ブロック以外に参照できるコンテンツタイプを指定した場合",
+			schema:    FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeText, ReferenceTypes: []string{"blog_post"}}}},
+			expectErr: true,
+		},
+		{
+			name:      "異常系：参照できるコンテンツタイプ名が不正な場合",
+			schema:    FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeReference, ReferenceTypes: []string{"Blog Post"}}}},
+			expectErr: true,
+		},
+		{
+			name:      "異常系：表示名が長すぎる場合",
+			schema:    FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeText, Label: strings.Repeat("あ", MaxFieldLabelLength+1)}}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schema.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContentTypeValidateName(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"blog_post":              true,
+		"page2":                  true,
+		"":                       false,
+		"Blog":                   false,
+		"2page":                  false,
+		"blog-post":              false,
+		strings.Repeat("a", 101): false,
+	} {
+		err := (&ContentType{Name: name}).ValidateName()
+		assert.Equal(t, valid, err == nil, name)
+	}
+}
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// ReferrerPolicy は参照されているコンテンツをアーカイブ・ゴミ箱へ移動・完全に削除するときの参照元の扱い
+type ReferrerPolicy string
+
+const (
+	// ReferrerPolicyRestrict は参照元がある場合に操作を中止する（既定）
+	ReferrerPolicyRestrict ReferrerPolicy = ""
+	// ReferrerPolicyDetach は参照元の参照ブロックから参照先を外して非表示にする
+	ReferrerPolicyDetach ReferrerPolicy = "detach"
+	// ReferrerPolicyRemove は参照元の参照ブロックを削除する
+	ReferrerPolicyRemove ReferrerPolicy = "remove"
+)
+
+// IsValid は参照元の扱いが定義済みの値かどうかを判定します
+func (p ReferrerPolicy) IsValid() bool {
+	switch p {
+	case ReferrerPolicyRestrict, ReferrerPolicyDetach, ReferrerPolicyRemove:
+		return true
+	}
+	return false
+}
+
+// Referrer は参照ブロックでコンテンツを参照しているコンテンツ（参照元）
+type Referrer struct {
+	ContentID       uuid.UUID     `json:"content_id"`
+	Title           string        `json:"title"`
+	Slug            string        `json:"slug"`
+	Status          ContentStatus `json:"status"`
+	ContentTypeName string        `json:"content_type"`
+	Version         int           `json:"version"`
+	// BlockIDsは参照している参照ブロックのID（並び順）
+	BlockIDs []uuid.UUID `json:"block_ids"`
+}
+
+// ReferencedError は参照元があるためコンテンツを操作できないことを表すエラー
+// errors.IsでErrContentReferencedとして判定できます
+type ReferencedError struct {
+	Referrers []Referrer
+}
+
+// Error は参照元の件数を含むメッセージを返します
+func (e *ReferencedError) Error() string {
+	return fmt.Sprintf("%s: %d件のコンテンツから参照されています（forceで参照元の参照ブロックを外すか削除できます）",
+		ErrContentReferenced.Error(), len(e.Referrers))
+}
+
+// Unwrap はErrContentReferencedを返します
+func (e *ReferencedError) Unwrap() error {
+	return ErrContentReferenced
+}
+
+// ReleaseReferences はtargetIDを参照している参照ブロックをpolicyに従って処理し、処理したブロックがあるかどうかを返します
+// ReferrerPolicyDetachの場合は参照先を外して非表示にし、ReferrerPolicyRemoveの場合はブロックを削除します
+func (c *Content) ReleaseReferences(targetID uuid.UUID, policy ReferrerPolicy) bool {
+	references := func(block ContentBlock) bool {
+		return block.BlockType == BlockTypeReference && block.Data != nil &&
+			block.Data.ReferencedContentID != nil && *block.Data.ReferencedContentID == targetID
+	}
+
+	switch policy {
+	case ReferrerPolicyDetach:
+		released := false
+		for i := range c.Blocks {
+			if references(c.Blocks[i]) {
+				c.Blocks[i].Data.ReferencedContentID = nil
+				c.Blocks[i].IsVisible = false
+				released = true
+			}
+		}
+		return released
+	case ReferrerPolicyRemove:
+		count := len(c.Blocks)
+		c.Blocks = slices.DeleteFunc(c.Blocks, references)
+		for i := range c.Blocks {
+			c.Blocks[i].BlockOrder = i + 1
+		}
+		return len(c.Blocks) < count
+	}
+	return false
+}
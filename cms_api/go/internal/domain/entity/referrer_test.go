@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentReleaseReferences(t *testing.T) {
+	targetID, otherID := uuid.New(), uuid.New()
+	content := func() *Content {
+		reference := func(id uuid.UUID) ContentBlock {
+			return ContentBlock{
+				ID:        uuid.New(),
+				BlockType: BlockTypeReference,
+				IsVisible: true,
+				Data:      &ContentBlockData{DataType: DataTypeReference, ReferencedContentID: &id},
+			}
+		}
+		return &Content{Blocks: []ContentBlock{
+			{ID: uuid.New(), BlockType: BlockTypeText, IsVisible: true, BlockOrder: 1, Data: &ContentBlockData{DataType: DataTypeText}},
+			reference(targetID),
+			reference(otherID),
+			reference(targetID),
+		}}
+	}
+
+	t.Run("正常系：detachの場合は参照先を外して非表示にする場合", func(t *testing.T) {
+		c := content()
+		assert.True(t, c.ReleaseReferences(targetID, ReferrerPolicyDetach))
+		if assert.Len(t, c.Blocks, 4) {
+			for _, i := range []int{1, 3} {
+				assert.Nil(t, c.Blocks[i].Data.ReferencedContentID)
+				assert.False(t, c.Blocks[i].IsVisible)
+			}
+			assert.Equal(t, otherID, *c.Blocks[2].Data.ReferencedContentID)
+			assert.True(t, c.Blocks[2].IsVisible)
+		}
+	})
+
+	t.Run("正常系：removeの場合はブロックを削除して並び順を振り直す場合", func(t *testing.T) {
+		c := content()
+		assert.True(t, c.ReleaseReferences(targetID, ReferrerPolicyRemove))
+		if assert.Len(t, c.Blocks, 2) {
+			assert.Equal(t, BlockTypeText, c.Blocks[0].BlockType)
+			assert.Equal(t, otherID, *c.Blocks[1].Data.ReferencedContentID)
+			assert.Equal(t, 2, c.Blocks[1].BlockOrder)
+		}
+	})
+
+	t.Run("正常系：参照していない場合とrestrictの場合は変更しない場合", func(t *testing.T) {
+		c := content()
+		assert.False(t, c.ReleaseReferences(uuid.New(), ReferrerPolicyRemove))
+		assert.False(t, c.ReleaseReferences(targetID, ReferrerPolicyRestrict))
+		assert.Len(t, c.Blocks, 4)
+		assert.True(t, c.Blocks[1].IsVisible)
+	})
+}
+
+func TestReferencedError(t *testing.T) {
+	err := error(&ReferencedError{Referrers: []Referrer{{ContentID: uuid.New()}}})
+	assert.ErrorIs(t, err, ErrContentReferenced)
+	assert.Contains(t, err.Error(), "1件")
+
+	var referenced *ReferencedError
+	assert.True(t, errors.As(err, &referenced))
+	assert.Len(t, referenced.Referrers, 1)
+}
+
+func TestReferrerPolicyIsValid(t *testing.T) {
+	for _, policy := range []ReferrerPolicy{ReferrerPolicyRestrict, ReferrerPolicyDetach, ReferrerPolicyRemove} {
+		assert.True(t, policy.IsValid())
+	}
+	assert.False(t, ReferrerPolicy("cascade").IsValid())
+}
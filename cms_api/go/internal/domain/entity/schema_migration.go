@@ -0,0 +1,158 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SchemaMigrationOp はスキーマの移行で既存のブロックに行う操作の種類
+// フィールドはブロックの種類で識別するため、名前の変更（表示名の変更）はブロックを書き換えず、移行の操作はありません
+type SchemaMigrationOp string
+
+const (
+	// SchemaMigrationChangeType はブロックの種類を変更する（データは変更後の種類に合わせて変換する）
+	SchemaMigrationChangeType SchemaMigrationOp = "change_type"
+	// SchemaMigrationRemove はブロックをブロックデータと共に削除する
+	SchemaMigrationRemove SchemaMigrationOp = "remove"
+)
+
+// blockTypeGroups は種類を相互に変更できるブロックの組（本文のブロックとURLのブロック）
+var blockTypeGroups = [][]BlockType{
+	{BlockTypeText, BlockTypeRichText},
+	{BlockTypeImage, BlockTypeVideo, BlockTypeEmbed},
+}
+
+// ContentTypeSchemaVersion はコンテンツタイプのフィールドの定義の版
+type ContentTypeSchemaVersion struct {
+	ContentTypeID uuid.UUID   `json:"content_type_id"`
+	Version       int         `json:"version"`
+	FieldSchema   FieldSchema `json:"field_schema"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// SchemaMigration はフィールドの定義の変更と、既存のコンテンツのブロックの書き換え手順
+type SchemaMigration struct {
+	// SchemaVersionは移行元のフィールドの定義の版（最新の版と一致する場合のみ移行する）
+	SchemaVersion int `json:"schema_version"`
+	// FieldSchemaは移行後のフィールドの定義
+	FieldSchema FieldSchema `json:"field_schema"`
+	// Stepsはブロックの書き換え手順（記載順に適用する）
+	Steps []SchemaMigrationStep `json:"steps"`
+}
+
+// SchemaMigrationStep はブロックの書き換え手順1件
+type SchemaMigrationStep struct {
+	Op SchemaMigrationOp `json:"op"`
+	// BlockTypeは対象のブロックの種類、Toは種類の変更後の種類（change_typeのみ）
+	BlockType BlockType `json:"block_type"`
+	To        BlockType `json:"to,omitempty"`
+}
+
+// Validate は移行後のフィールドの定義と書き換え手順の妥当性を検証します
+func (m *SchemaMigration) Validate() error {
+	if m.SchemaVersion <= 0 {
+		return fmt.Errorf("schema_versionは必須です")
+	}
+	for i, step := range m.Steps {
+		if !step.BlockType.IsValid() {
+			return fmt.Errorf("steps[%d]: 不明なブロックの種類です: %s", i, step.BlockType)
+		}
+		switch step.Op {
+		case SchemaMigrationChangeType:
+			if !step.To.IsValid() || step.To == step.BlockType {
+				return fmt.Errorf("steps[%d]: 変更後のブロックの種類が不正です: %s", i, step.To)
+			}
+			if !convertible(step.BlockType, step.To) {
+				return fmt.Errorf("steps[%d]: %sのブロックは%sに変更できません", i, step.BlockType, step.To)
+			}
+		case SchemaMigrationRemove:
+			if step.To != "" {
+				return fmt.Errorf("steps[%d]: 削除では変更後のブロックの種類を指定できません", i)
+			}
+		default:
+			return fmt.Errorf("steps[%d]: 不明な操作です: %s", i, step.Op)
+		}
+	}
+	return m.FieldSchema.Validate()
+}
+
+// MigrateBlocks は書き換え手順を適用したブロックと、種類を変更したブロック数・削除したブロック数を返します
+// 元のブロックとブロックデータは変更しません
+func (m *SchemaMigration) MigrateBlocks(blocks []ContentBlock) (migrated []ContentBlock, changed, removed int) {
+	migrated = make([]ContentBlock, 0, len(blocks))
+	for _, block := range blocks {
+		from, keep := block.BlockType, true
+		for _, step := range m.Steps {
+			if step.BlockType != block.BlockType {
+				continue
+			}
+			if step.Op == SchemaMigrationRemove {
+				keep = false
+				break
+			}
+			block = convertBlock(block, step.To)
+		}
+
+		if !keep {
+			removed++
+			continue
+		}
+		if block.BlockType != from {
+			changed++
+		}
+		migrated = append(migrated, block)
+	}
+	return migrated, changed, removed
+}
+
+// convertible はブロックの種類を変更できるか（同じ組の種類か）を確認します
+func convertible(from, to BlockType) bool {
+	for _, group := range blockTypeGroups {
+		if slices.Contains(group, from) && slices.Contains(group, to) {
+			return true
+		}
+	}
+	return false
+}
+
+// convertBlock はブロックの種類を変更し、本文をテキストとリッチテキストの間で変換します（URLのブロックのデータはそのまま）
+func convertBlock(block ContentBlock, to BlockType) ContentBlock {
+	block.BlockType = to
+	if block.Data == nil {
+		return block
+	}
+
+	data := *block.Data
+	switch {
+	case to == BlockTypeRichText && data.DataType == DataTypeText:
+		data.ContentRichtext = richtextFromText(data.ContentText)
+		data.ContentText = ""
+		data.DataType = DataTypeRichText
+	case to == BlockTypeText && data.DataType == DataTypeRichText:
+		data.ContentText = data.PlainText()
+		data.ContentRichtext = nil
+		data.DataType = DataTypeText
+	}
+	block.Data = &data
+	return block
+}
+
+// richtextFromText はテキストを行ごとの段落にしたリッチテキスト（ProseMirror形式のJSON）を返します
+func richtextFromText(text string) json.RawMessage {
+	type node map[string]interface{}
+	paragraphs := []node{}
+	for _, line := range strings.Split(text, "\n") {
+		paragraph := node{"type": "paragraph"}
+		if line != "" {
+			paragraph["content"] = []node{{"type": "text", "text": line}}
+		}
+		paragraphs = append(paragraphs, paragraph)
+	}
+	data, _ := json.Marshal(node{"type": "doc", "content": paragraphs})
+	return data
+}
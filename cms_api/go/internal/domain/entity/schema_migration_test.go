@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaMigrationValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		migration SchemaMigration
+		expectErr bool
+	}{
+		{
+			name: "正常系：種類の変更と削除を行う場合",
+			migration: SchemaMigration{SchemaVersion: 2, Steps: []SchemaMigrationStep{
+				{Op: SchemaMigrationChangeType, BlockType: BlockTypeText, To: BlockTypeRichText},
+				{Op: SchemaMigrationRemove, BlockType: BlockTypeVideo},
+			}},
+		},
+		{
+			name:      "異常系：移行元の版が指定されていない場合",
+			migration: SchemaMigration{},
+			expectErr: true,
+		},
+		{
+			name: "異常系：変更できない種類の組み合わせの場合",
+			migration: SchemaMigration{SchemaVersion: 1, Steps: []SchemaMigrationStep{
+				{Op: SchemaMigrationChangeType, BlockType: BlockTypeText, To: BlockTypeImage},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "異常系：変更後の種類が同じ場合",
+			migration: SchemaMigration{SchemaVersion: 1, Steps: []SchemaMigrationStep{
+				{Op: SchemaMigrationChangeType, BlockType: BlockTypeImage, To: BlockTypeImage},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "異常系：不明な操作の場合",
+			migration: SchemaMigration{SchemaVersion: 1, Steps: []SchemaMigrationStep{
+				{Op: "rename", BlockType: BlockTypeText},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "異常系：移行後のフィールドの定義が不正な場合",
+			migration: SchemaMigration{SchemaVersion: 1, FieldSchema: FieldSchema{Fields: []FieldDefinition{
+				{BlockType: BlockTypeText}, {BlockType: BlockTypeText},
+			}}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.migration.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSchemaMigrationMigrateBlocks(t *testing.T) {
+	textID, videoID, imageID, richtextID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	blocks := []ContentBlock{
+		{ID: textID, BlockType: BlockTypeText, Data: &ContentBlockData{DataType: DataTypeText, ContentText: "1行目\n2行目"}},
+		{ID: videoID, BlockType: BlockTypeVideo, Data: &ContentBlockData{DataType: DataTypeURL, ContentURL: "https://example.com/a.mp4"}},
+		{ID: imageID, BlockType: BlockTypeImage, Data: &ContentBlockData{DataType: DataTypeURL, ContentURL: "https://example.com/a.png"}},
+		{ID: richtextID, BlockType: BlockTypeRichText, Data: &ContentBlockData{DataType: DataTypeRichText,
+			ContentRichtext: []byte(`{"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"概要"}]}]}`)}},
+	}
+	migration := SchemaMigration{SchemaVersion: 1, Steps: []SchemaMigrationStep{
+		{Op: SchemaMigrationChangeType, BlockType: BlockTypeRichText, To: BlockTypeText},
+		{Op: SchemaMigrationChangeType, BlockType: BlockTypeImage, To: BlockTypeEmbed},
+		{Op: SchemaMigrationRemove, BlockType: BlockTypeVideo},
+	}}
+
+	migrated, changed, removed := migration.MigrateBlocks(blocks)
+
+	assert.Equal(t, 2, changed)
+	assert.Equal(t, 1, removed)
+	assert.Len(t, migrated, 3)
+
+	// 対象外のブロックはそのまま
+	assert.Equal(t, textID, migrated[0].ID)
+	assert.Equal(t, BlockTypeText, migrated[0].BlockType)
+
+	// URLのブロックはデータをそのままにして種類のみ変更する
+	assert.Equal(t, imageID, migrated[1].ID)
+	assert.Equal(t, BlockTypeEmbed, migrated[1].BlockType)
+	assert.Equal(t, "https://example.com/a.png", migrated[1].Data.ContentURL)
+
+	// リッチテキストはプレーンテキストに変換する
+	assert.Equal(t, richtextID, migrated[2].ID)
+	assert.Equal(t, DataTypeText, migrated[2].Data.DataType)
+	assert.Equal(t, "概要", migrated[2].Data.ContentText)
+	assert.Nil(t, migrated[2].Data.ContentRichtext)
+
+	// 元のブロックとブロックデータは変更しない
+	assert.Equal(t, BlockTypeRichText, blocks[3].BlockType)
+	assert.Equal(t, DataTypeRichText, blocks[3].Data.DataType)
+}
+
+func TestSchemaMigrationTextToRichText(t *testing.T) {
+	migration := SchemaMigration{SchemaVersion: 1, Steps: []SchemaMigrationStep{
+		{Op: SchemaMigrationChangeType, BlockType: BlockTypeText, To: BlockTypeRichText},
+	}}
+
+	migrated, changed, _ := migration.MigrateBlocks([]ContentBlock{
+		{BlockType: BlockTypeText, Data: &ContentBlockData{DataType: DataTypeText, ContentText: "1行目\n\n3行目"}},
+	})
+
+	assert.Equal(t, 1, changed)
+	data := migrated[0].Data
+	assert.Equal(t, DataTypeRichText, data.DataType)
+	assert.Empty(t, data.ContentText)
+	assert.JSONEq(t, `{"type":"doc","content":[
+		{"type":"paragraph","content":[{"type":"text","text":"1行目"}]},
+		{"type":"paragraph"},
+		{"type":"paragraph","content":[{"type":"text","text":"3行目"}]}
+	]}`, string(data.ContentRichtext))
+	// 変換後も本文として読み取れること（空の段落は読み飛ばす）
+	assert.Equal(t, "1行目\n3行目", data.PlainText())
+}
@@ -0,0 +1,221 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxSearchTerms は1回の検索で指定できる語の最大数
+	MaxSearchTerms = 5
+	// MaxSearchTermLength は検索語1つあたりの最大文字数
+	MaxSearchTermLength = 100
+
+	// snippetContext は抜粋で最初に一致した語より前に含める文字数
+	snippetContext = 30
+	// highlightOpen・highlightCloseは抜粋中の一致箇所を囲むタグ
+	highlightOpen  = "<mark>"
+	highlightClose = "</mark>"
+)
+
+// SearchHit は全文検索で一致したコンテンツと関連度
+type SearchHit struct {
+	Content *Content `json:"content"`
+	Score   float64  `json:"score"`
+	// TitleHighlightとSnippetは一致箇所を<mark>で囲んだHTML（一致箇所以外はエスケープ済み）
+	TitleHighlight string `json:"title_highlight"`
+	Snippet        string `json:"snippet"`
+}
+
+// NormalizeSearchText は検索用にテキストを正規化します
+// 全角英数字・半角カナなどの表記ゆれをNFKCで統一し、小文字に揃えます
+func NormalizeSearchText(s string) string {
+	return strings.ToLower(norm.NFKC.String(s))
+}
+
+// ParseSearchTerms は検索キーワードを正規化し、空白（全角空白を含む）で区切った語の一覧を返します
+// 重複した語は除き、語が多すぎる・長すぎる場合はErrInvalidParameterを返します
+func ParseSearchTerms(q string) ([]string, error) {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, term := range strings.Fields(NormalizeSearchText(q)) {
+		if seen[term] {
+			continue
+		}
+		if utf8.RuneCountInString(term) > MaxSearchTermLength {
+			return nil, fmt.Errorf("%w: 検索語は%d文字以内で指定してください", ErrInvalidParameter, MaxSearchTermLength)
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	if len(terms) > MaxSearchTerms {
+		return nil, fmt.Errorf("%w: 検索語は%d個以内で指定してください", ErrInvalidParameter, MaxSearchTerms)
+	}
+	return terms, nil
+}
+
+// PlainText はブロックデータの本文をプレーンテキストで返します
+// リッチテキストの場合はJSONからテキストノードを抽出し、段落ごとに改行で区切ります
+func (d *ContentBlockData) PlainText() string {
+	switch d.DataType {
+	case DataTypeText:
+		return d.ContentText
+	case DataTypeRichText:
+		if len(d.ContentRichtext) == 0 {
+			return d.ContentText
+		}
+		var node richtextNode
+		if err := json.Unmarshal(d.ContentRichtext, &node); err != nil {
+			return d.ContentText
+		}
+		var b strings.Builder
+		node.writeText(&b)
+		return strings.TrimSpace(b.String())
+	}
+	return ""
+}
+
+// PlainText は表示中のブロックの本文をブロックの並び順に改行で連結して返します
+func (c *Content) PlainText() string {
+	var texts []string
+	for _, block := range c.Blocks {
+		if !block.IsVisible || block.Data == nil {
+			continue
+		}
+		if text := block.Data.PlainText(); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// richtextNode はリッチテキスト（ProseMirror形式のJSON）のノード
+type richtextNode struct {
+	Type    string         `json:"type"`
+	Text    string         `json:"text"`
+	Content []richtextNode `json:"content"`
+}
+
+// writeText はノードとその子孫のテキストを書き出します（改行ノードと段落の終わりは改行にする）
+func (n *richtextNode) writeText(b *strings.Builder) {
+	if n.Type == "hard_break" {
+		b.WriteString("\n")
+		return
+	}
+	b.WriteString(n.Text)
+	for i := range n.Content {
+		n.Content[i].writeText(b)
+	}
+	if n.Text == "" && len(n.Content) > 0 && n.Type != "doc" && !strings.HasSuffix(b.String(), "\n") {
+		b.WriteString("\n")
+	}
+}
+
+// Highlight はtext中で検索語（ParseSearchTermsで正規化済み）に一致する箇所を<mark>で囲んだHTMLを返します
+// widthが正の場合は最初に一致した箇所を中心にwidth文字程度の抜粋にし、省略した側に「…」を付けます
+// 一致箇所がない場合は先頭からの抜粋を返します
+func Highlight(text string, terms []string, width int) string {
+	normalized, offsets := normalizeWithOffsets(text)
+
+	// 正規化後のテキストで一致した範囲を元のテキストのバイト位置に変換する
+	var matches [][2]int
+	for _, term := range terms {
+		for i := 0; i < len(normalized); {
+			j := strings.Index(normalized[i:], term)
+			if j < 0 {
+				break
+			}
+			start, end := i+j, i+j+len(term)
+			matches = append(matches, [2]int{offsets[start], offsets[end]})
+			i = end
+		}
+	}
+	matches = mergeRanges(matches)
+
+	from, to := 0, len(text)
+	if width > 0 && utf8.RuneCountInString(text) > width {
+		if len(matches) > 0 {
+			from = moveRunes(text, matches[0][0], -snippetContext)
+		}
+		to = moveRunes(text, from, width)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := from
+	for _, m := range matches {
+		if m[1] <= from || m[0] >= to {
+			continue
+		}
+		start, end := max(m[0], from), min(m[1], to)
+		b.WriteString(html.EscapeString(text[pos:start]))
+		b.WriteString(highlightOpen + html.EscapeString(text[start:end]) + highlightClose)
+		pos = end
+	}
+	b.WriteString(html.EscapeString(text[pos:to]))
+	if to < len(text) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// normalizeWithOffsets はNormalizeSearchTextと同じ正規化を行い、
+// 正規化後の各バイト位置に対応する元のテキストのバイト位置（末尾を含む）を返します
+// 正規化の単位（濁点付きの半角カナなど）の途中の位置は、その単位の先頭または末尾に丸めます
+func normalizeWithOffsets(text string) (string, []int) {
+	var b strings.Builder
+	offsets := make([]int, 0, len(text)+1)
+
+	var it norm.Iter
+	it.InitString(norm.NFKC, text)
+	for !it.Done() {
+		start := it.Pos()
+		segment := strings.ToLower(string(it.Next()))
+		end := it.Pos()
+		for i := 0; i < len(segment); i++ {
+			if i == 0 {
+				offsets = append(offsets, start)
+			} else {
+				offsets = append(offsets, end)
+			}
+		}
+		b.WriteString(segment)
+	}
+	offsets = append(offsets, len(text))
+	return b.String(), offsets
+}
+
+// mergeRanges は範囲を開始位置順に並べ、重なる範囲を結合します
+func mergeRanges(ranges [][2]int) [][2]int {
+	slices.SortFunc(ranges, func(a, b [2]int) int { return a[0] - b[0] })
+	var merged [][2]int
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r[0] <= merged[n-1][1] {
+			merged[n-1][1] = max(merged[n-1][1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// moveRunes はバイト位置posから文字数nだけ前後に移動した位置を返します（テキストの範囲内に収める）
+func moveRunes(text string, pos, n int) int {
+	for ; n > 0 && pos < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[pos:])
+		pos += size
+	}
+	for ; n < 0 && pos > 0; n++ {
+		_, size := utf8.DecodeLastRuneInString(text[:pos])
+		pos -= size
+	}
+	return pos
+}
@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSearchTerms(t *testing.T) {
+	testCases := []struct {
+		name          string
+		q             string
+		expected      []string
+		expectedError error
+	}{
+		{
+			name:     "正常系：全角英数字と全角空白を正規化して分割する場合",
+			q:        "ＡＷＳ　Lambda  aws",
+			expected: []string{"aws", "lambda"},
+		},
+		{
+			name:     "正常系：半角カナを全角に揃える場合",
+			q:        "ｻｰﾊﾞｰﾚｽ",
+			expected: []string{"サーバーレス"},
+		},
+		{
+			name: "正常系：空白のみの場合は語がない",
+			q:    " 　",
+		},
+		{
+			name:          "異常系：語が多すぎる場合",
+			q:             "a b c d e f",
+			expectedError: ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：語が長すぎる場合",
+			q:             strings.Repeat("あ", MaxSearchTermLength+1),
+			expectedError: ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			terms, err := ParseSearchTerms(tc.q)
+			if tc.expectedError != nil {
+				assert.True(t, errors.Is(err, tc.expectedError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, terms)
+		})
+	}
+}
+
+func TestContentPlainText(t *testing.T) {
+	richtext := json.RawMessage(`{"type":"doc","content":[
+		{"type":"paragraph","content":[{"type":"text","text":"AWS Lambdaを"},{"type":"text","marks":[{"type":"bold"}],"text":"採用"}]},
+		{"type":"paragraph","content":[{"type":"text","text":"主な特徴:"},{"type":"hard_break"},{"type":"text","text":"高い可用性"}]}]}`)
+	content := &Content{Blocks: []ContentBlock{
+		{IsVisible: true, Data: &ContentBlockData{DataType: DataTypeRichText, ContentRichtext: richtext}},
+		{IsVisible: false, Data: &ContentBlockData{DataType: DataTypeText, ContentText: "非表示"}},
+		{IsVisible: true, Data: &ContentBlockData{DataType: DataTypeURL, ContentURL: "https://example.com"}},
+		{IsVisible: true, Data: &ContentBlockData{DataType: DataTypeText, ContentText: "技術スタック"}},
+	}}
+
+	assert.Equal(t, "AWS Lambdaを採用\n主な特徴:\n高い可用性\n技術スタック", content.PlainText())
+}
+
+func TestHighlight(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		terms    []string
+		width    int
+		expected string
+	}{
+		{
+			name:     "正常系：大文字小文字や全角半角の違いを無視して一致箇所を囲む場合",
+			text:     "ＡＷＳ Lambda と aws",
+			terms:    []string{"aws", "lambda"},
+			expected: "<mark>ＡＷＳ</mark> <mark>Lambda</mark> と <mark>aws</mark>",
+		},
+		{
+			name:     "正常系：半角カナの濁点を含む一致箇所を囲む場合",
+			text:     "ｻｰﾊﾞｰﾚｽ構成",
+			terms:    []string{"サーバー"},
+			expected: "<mark>ｻｰﾊﾞｰ</mark>ﾚｽ構成",
+		},
+		{
+			name:     "正常系：一致箇所以外はHTMLエスケープする場合",
+			text:     "<script>検索</script>",
+			terms:    []string{"検索"},
+			expected: "&lt;script&gt;<mark>検索</mark>&lt;/script&gt;",
+		},
+		{
+			name:     "正常系：最初の一致箇所の周辺を抜粋する場合",
+			text:     strings.Repeat("あ", 50) + "検索" + strings.Repeat("い", 50),
+			terms:    []string{"検索"},
+			width:    40,
+			expected: "…" + strings.Repeat("あ", snippetContext) + "<mark>検索</mark>" + strings.Repeat("い", 8) + "…",
+		},
+		{
+			name:     "正常系：一致箇所がない場合は先頭から抜粋する場合",
+			text:     strings.Repeat("あ", 50),
+			terms:    []string{"検索"},
+			width:    10,
+			expected: strings.Repeat("あ", 10) + "…",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Highlight(tc.text, tc.terms, tc.width))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxSlugLength はスラッグの最大文字数
+const MaxSlugLength = 200
+
+// SlugRedirect は公開中に変更される前のスラッグから現在のコンテンツへのリダイレクト
+// 変更先のスラッグではなくコンテンツを指すため、何度変更しても現在のスラッグへ1回で転送される
+type SlugRedirect struct {
+	ID            uuid.UUID `json:"id"`
+	ContentID     uuid.UUID `json:"content_id"`
+	ContentTypeID uuid.UUID `json:"content_type_id"`
+	Slug          string    `json:"slug"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GenerateSlug はタイトルからスラッグを作成します
+// NFKCで正規化して小文字にし、文字（日本語を含む）と数字以外の連続を「-」1つに置き換えます
+// 日本語のタイトルはローマ字に変換せず、かな・漢字をそのまま残します（URLではパーセントエンコードされる）
+// 使える文字がない場合は空文字を返します
+func GenerateSlug(title string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(norm.NFKC.String(title)) {
+		if !isSlugRune(r) {
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteRune('-')
+			pendingHyphen = false
+		}
+		b.WriteRune(r)
+	}
+	return TruncateSlug(b.String(), MaxSlugLength)
+}
+
+// TruncateSlug はスラッグを最大n文字に切り詰めます（末尾の「-」は取り除く）
+func TruncateSlug(slug string, n int) string {
+	if utf8.RuneCountInString(slug) > n {
+		slug = string([]rune(slug)[:n])
+	}
+	return strings.TrimRight(slug, "-")
+}
+
+// ValidateSlug はスラッグの形式を検証します
+// 小文字の文字（日本語を含む）・数字と、単語の区切りの「-」のみを使えます
+func ValidateSlug(slug string) error {
+	if slug == "" {
+		return fmt.Errorf("スラッグは必須です")
+	}
+	if utf8.RuneCountInString(slug) > MaxSlugLength {
+		return fmt.Errorf("スラッグは%d文字以内で指定してください", MaxSlugLength)
+	}
+	if slug != GenerateSlug(slug) {
+		return fmt.Errorf("スラッグには小文字の英字・数字・日本語と区切りの「-」のみを使えます: %s", slug)
+	}
+	return nil
+}
+
+// isSlugRune はスラッグにそのまま使える文字かを確認
+func isSlugRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
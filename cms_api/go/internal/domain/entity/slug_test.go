@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSlug(t *testing.T) {
+	testCases := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{
+			name:     "正常系：英語のタイトルを小文字にして空白と記号を区切りにする場合",
+			title:    "Hello, World! Go 1.22",
+			expected: "hello-world-go-1-22",
+		},
+		{
+			name:     "正常系：日本語のタイトルはかな・漢字をそのまま残す場合",
+			title:    "CMS APIシステムの概要",
+			expected: "cms-apiシステムの概要",
+		},
+		{
+			name:     "正常系：全角英数字・全角空白・半角カナを正規化する場合",
+			title:    "ＡＷＳ　ﾗﾑﾀﾞ入門",
+			expected: "aws-ラムダ入門",
+		},
+		{
+			name:     "正常系：日本語の句読点や括弧を区切りにする場合",
+			title:    "【入門】サーバーレス、はじめました。",
+			expected: "入門-サーバーレス-はじめました",
+		},
+		{
+			name:  "正常系：使える文字がない場合は空文字",
+			title: "!!! ？？？",
+		},
+		{
+			name:     "正常系：長すぎる場合は最大文字数で切り詰める場合",
+			title:    strings.Repeat("あ", MaxSlugLength+10),
+			expected: strings.Repeat("あ", MaxSlugLength),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GenerateSlug(tc.title))
+		})
+	}
+}
+
+func TestValidateSlug(t *testing.T) {
+	testCases := []struct {
+		name      string
+		slug      string
+		expectErr bool
+	}{
+		{name: "正常系：英小文字・数字と区切り", slug: "aws-lambda-2024"},
+		{name: "正常系：日本語", slug: "サーバーレス入門"},
+		{name: "異常系：空", slug: "", expectErr: true},
+		{name: "異常系：大文字を含む", slug: "AWS", expectErr: true},
+		{name: "異常系：空白を含む", slug: "aws lambda", expectErr: true},
+		{name: "異常系：区切りが連続する", slug: "aws--lambda", expectErr: true},
+		{name: "異常系：先頭が区切り", slug: "-aws", expectErr: true},
+		{name: "異常系：長すぎる", slug: strings.Repeat("a", MaxSlugLength+1), expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSlug(tc.slug)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ExcerptLength は一覧に表示する抜粋の最大文字数
+const ExcerptLength = 120
+
+// Summarize は表示中のブロックから一覧用の抜粋とカバー画像を設定します
+// 抜粋は本文の改行・連続する空白を1つの空白にまとめ、長い場合は末尾を「…」で省略します
+// カバー画像は表示中の最初の画像ブロックのURLとします
+func (c *Content) Summarize() {
+	excerpt := strings.Join(strings.Fields(c.PlainText()), " ")
+	if utf8.RuneCountInString(excerpt) > ExcerptLength {
+		excerpt = string([]rune(excerpt)[:ExcerptLength]) + "…"
+	}
+	c.Excerpt = excerpt
+
+	c.CoverImageURL = ""
+	for _, block := range c.Blocks {
+		if block.IsVisible && block.BlockType == BlockTypeImage && block.Data != nil && block.Data.ContentURL != "" {
+			c.CoverImageURL = block.Data.ContentURL
+			break
+		}
+	}
+}
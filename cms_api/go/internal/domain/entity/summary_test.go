@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentSummarize(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		blocks                []ContentBlock
+		expectedExcerpt       string
+		expectedCoverImageURL string
+	}{
+		{
+			name: "正常系：表示中のブロックから抜粋と最初の画像を設定する場合",
+			blocks: []ContentBlock{
+				{BlockType: BlockTypeImage, IsVisible: false, Data: &ContentBlockData{DataType: DataTypeURL, ContentURL: "https://example.com/hidden.png"}},
+				{BlockType: BlockTypeText, IsVisible: true, Data: &ContentBlockData{DataType: DataTypeText, ContentText: "一行目\n\n二行目  です"}},
+				{BlockType: BlockTypeImage, IsVisible: true, Data: &ContentBlockData{DataType: DataTypeURL, ContentURL: "https://example.com/cover.png"}},
+				{BlockType: BlockTypeImage, IsVisible: true, Data: &ContentBlockData{DataType: DataTypeURL, ContentURL: "https://example.com/second.png"}},
+			},
+			expectedExcerpt:       "一行目 二行目 です",
+			expectedCoverImageURL: "https://example.com/cover.png",
+		},
+		{
+			name: "正常系：長い本文は省略する場合",
+			blocks: []ContentBlock{
+				{BlockType: BlockTypeText, IsVisible: true, Data: &ContentBlockData{DataType: DataTypeText, ContentText: strings.Repeat("あ", ExcerptLength+1)}},
+			},
+			expectedExcerpt: strings.Repeat("あ", ExcerptLength) + "…",
+		},
+		{
+			name:            "正常系：ブロックがない場合は空になる",
+			expectedExcerpt: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content := &Content{Blocks: tc.blocks, Excerpt: "古い抜粋", CoverImageURL: "https://example.com/old.png"}
+			content.Summarize()
+			assert.Equal(t, tc.expectedExcerpt, content.Excerpt)
+			assert.Equal(t, tc.expectedCoverImageURL, content.CoverImageURL)
+		})
+	}
+}
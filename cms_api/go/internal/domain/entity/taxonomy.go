@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category はコンテンツのカテゴリのドメインエンティティ（コンテンツは1つのカテゴリに属する）
+type Category struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Tag はコンテンツのタグのドメインエンティティ（コンテンツには複数のタグを付けられる）
+type Tag struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate はCategoryの基本的なバリデーション
+func (c *Category) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("名前は必須です")
+	}
+	if c.Slug == "" {
+		return fmt.Errorf("スラッグは必須です")
+	}
+	return nil
+}
+
+// Validate はTagの基本的なバリデーション
+func (t *Tag) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("名前は必須です")
+	}
+	if t.Slug == "" {
+		return fmt.Errorf("スラッグは必須です")
+	}
+	return nil
+}
+
+// CategoryCount はカテゴリと、そのカテゴリに属する公開中のコンテンツ数
+type CategoryCount struct {
+	Category
+	Count int64 `json:"count"`
+}
+
+// TagCount はタグと、そのタグが付いた公開中のコンテンツ数
+type TagCount struct {
+	Tag
+	Count int64 `json:"count"`
+}
+
+// ArchiveCount は公開日時の年月と、その月に公開されたコンテンツ数
+type ArchiveCount struct {
+	Year  int   `json:"year"`
+	Month int   `json:"month"`
+	Count int64 `json:"count"`
+}
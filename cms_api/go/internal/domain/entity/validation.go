@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// 違反の種類（エラーレスポンスのdetailsのcodeに対応）
+const (
+	ViolationRequired         = "required"
+	ViolationNotAllowed       = "not_allowed"
+	ViolationTooMany          = "too_many"
+	ViolationTooLong          = "too_long"
+	ViolationOutOfRange       = "out_of_range"
+	ViolationInvalidURL       = "invalid_url"
+	ViolationInvalidReference = "invalid_reference"
+	ViolationInvalidOrder     = "invalid_order"
+)
+
+// ValidationError はバリデーションの違反1件
+type ValidationError struct {
+	// Fieldは違反した項目（例: blocks[2].data.content_url）
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors はバリデーションの違反の一覧
+// ErrInvalidParameterをラップしたエラーとして扱えます
+type ValidationErrors []ValidationError
+
+// Error は違反を「項目: 内容」の形式で連結して返します
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, v := range e {
+		messages[i] = v.Field + ": " + v.Message
+	}
+	return ErrInvalidParameter.Error() + ": " + strings.Join(messages, "; ")
+}
+
+// Unwrap はErrInvalidParameterを返します
+func (e ValidationErrors) Unwrap() error {
+	return ErrInvalidParameter
+}
+
+// add は違反を追加します
+func (e *ValidationErrors) add(field, code, format string, args ...interface{}) {
+	*e = append(*e, ValidationError{Field: field, Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+// ValidateBlocks はブロックをフィールドの定義で検証し、すべての違反を返します（違反がない場合はnil）
+// referencedTypesは参照先のコンテンツIDとコンテンツタイプ名の対応で、含まれない参照先は存在しないものとして扱います
+// フィールドの定義がない場合も、参照ブロックの参照先とURLの形式は検証します
+func (s *FieldSchema) ValidateBlocks(blocks []ContentBlock, referencedTypes map[uuid.UUID]string) ValidationErrors {
+	var errs ValidationErrors
+	counts := make(map[BlockType]int)
+	visible := make(map[BlockType]int)
+	lastIndex := -1
+
+	for i, block := range blocks {
+		path := fmt.Sprintf("blocks[%d]", i)
+		if !block.BlockType.IsValid() {
+			errs.add(path+".block_type", ViolationNotAllowed, "不明なブロックの種類です: %s", block.BlockType)
+			continue
+		}
+
+		field, defined := s.Field(block.BlockType)
+		if len(s.Fields) > 0 && !defined {
+			errs.add(path+".block_type", ViolationNotAllowed, "このコンテンツタイプでは使えないブロックの種類です: %s", block.BlockType)
+			continue
+		}
+		counts[block.BlockType]++
+		if block.IsVisible {
+			visible[block.BlockType]++
+		}
+
+		if s.Ordered {
+			index := s.fieldIndex(block.BlockType)
+			if index < lastIndex {
+				errs.add(path+".block_type", ViolationInvalidOrder, "%sのブロックは%sのブロックより前に並べてください", block.BlockType, s.Fields[lastIndex].BlockType)
+			} else {
+				lastIndex = index
+			}
+		}
+
+		validateBlockData(&errs, path+".data", block, field, referencedTypes)
+	}
+
+	for _, field := range s.Fields {
+		if field.Required && visible[field.BlockType] == 0 {
+			errs.add("blocks", ViolationRequired, "%sのブロックが必要です", fieldName(field))
+		}
+		if field.MaxCount > 0 && counts[field.BlockType] > field.MaxCount {
+			errs.add("blocks", ViolationTooMany, "%sのブロックは%d個以内にしてください", fieldName(field), field.MaxCount)
+		}
+	}
+	return errs
+}
+
+// validateBlockData はブロックデータを検証して違反を追加します
+func validateBlockData(errs *ValidationErrors, path string, block ContentBlock, field FieldDefinition, referencedTypes map[uuid.UUID]string) {
+	data := block.Data
+	if block.BlockType == BlockTypeReference {
+		if data == nil || data.ReferencedContentID == nil {
+			// 参照先を外した（非表示の）参照ブロックは、参照先を選び直すまでそのまま保存できる
+			if block.IsVisible {
+				errs.add(path+".referenced_content_id", ViolationRequired, "参照先のコンテンツは必須です")
+			}
+			return
+		}
+		typeName, ok := referencedTypes[*data.ReferencedContentID]
+		switch {
+		case !ok:
+			errs.add(path+".referenced_content_id", ViolationInvalidReference, "参照先のコンテンツが存在しません: %s", data.ReferencedContentID)
+		case len(field.ReferenceTypes) > 0 && !slices.Contains(field.ReferenceTypes, typeName):
+			errs.add(path+".referenced_content_id", ViolationInvalidReference, "参照できるコンテンツタイプは%sです: %s",
+				strings.Join(field.ReferenceTypes, ", "), typeName)
+		}
+	}
+	if data == nil {
+		return
+	}
+
+	if data.ContentURL != "" && !isHTTPURL(data.ContentURL) {
+		errs.add(path+".content_url", ViolationInvalidURL, "URLはhttpまたはhttpsの絶対URLで指定してください")
+	}
+	if field.MaxLength > 0 {
+		if n := utf8.RuneCountInString(data.PlainText()); n > field.MaxLength {
+			errs.add(path, ViolationTooLong, "本文は%d文字以内にしてください（%d文字）", field.MaxLength, n)
+		}
+	}
+	if data.ContentNumber != nil {
+		if field.MinValue != nil && data.ContentNumber.LessThan(*field.MinValue) {
+			errs.add(path+".content_number", ViolationOutOfRange, "%s以上の値を指定してください", field.MinValue)
+		}
+		if field.MaxValue != nil && data.ContentNumber.GreaterThan(*field.MaxValue) {
+			errs.add(path+".content_number", ViolationOutOfRange, "%s以下の値を指定してください", field.MaxValue)
+		}
+	}
+}
+
+// fieldName はメッセージに使うフィールドの名前を返します（表示名がない場合はブロックの種類）
+func fieldName(field FieldDefinition) string {
+	if field.Label != "" {
+		return field.Label
+	}
+	return string(field.BlockType)
+}
+
+// isHTTPURL はhttpまたはhttpsの絶対URLかを確認します
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
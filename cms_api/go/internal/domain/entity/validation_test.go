@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func decimalPtr(v int64) *decimal.Decimal {
+	d := decimal.NewFromInt(v)
+	return &d
+}
+
+func TestFieldSchemaValidateBlocks(t *testing.T) {
+	referencedID := uuid.New()
+	textBlock := func(text string, visible bool) ContentBlock {
+		return ContentBlock{BlockType: BlockTypeText, IsVisible: visible, Data: &ContentBlockData{DataType: DataTypeText, ContentText: text}}
+	}
+	testCases := []struct {
+		name     string
+		schema   FieldSchema
+		blocks   []ContentBlock
+		expected []string
+	}{
+		{
+			name:   "正常系：定義がない場合はすべての種類のブロックを使える",
+			blocks: []ContentBlock{textBlock("本文", true), {BlockType: BlockTypeVideo}},
+		},
+		{
+			name: "正常系：定義に沿ったブロックの場合",
+			schema: FieldSchema{Fields: []FieldDefinition{
+				{BlockType: BlockTypeText, Required: true, MaxCount: 2, MaxLength: 10},
+				{BlockType: BlockTypeReference, ReferenceTypes: []string{"blog_post"}},
+			}, Ordered: true},
+			blocks: []ContentBlock{
+				textBlock("本文", true),
+				{BlockType: BlockTypeReference, Data: &ContentBlockData{DataType: DataTypeReference, ReferencedContentID: &referencedID}},
+			},
+		},
+		{
+			name:     "異常系：定義がなくても参照先のない参照ブロックは使えない",
+			blocks:   []ContentBlock{{BlockType: BlockTypeReference, IsVisible: true}},
+			expected: []string{"blocks[0].data.referenced_content_id:" + ViolationRequired},
+		},
+		{
+			name:   "正常系：参照先を外した非表示の参照ブロックの場合",
+			blocks: []ContentBlock{{BlockType: BlockTypeReference, Data: &ContentBlockData{DataType: DataTypeReference}}},
+		},
+		{
+			name:     "異常系：URLの形式が不正な場合",
+			blocks:   []ContentBlock{{BlockType: BlockTypeImage, Data: &ContentBlockData{DataType: DataTypeURL, ContentURL: "javascript:alert(1)"}}},
+			expected: []string{"blocks[0].data.content_url:" + ViolationInvalidURL},
+		},
+		{
+			name:     "異常系：必須のブロックが非表示のみの場合",
+			schema:   FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeText, Required: true}}},
+			blocks:   []ContentBlock{textBlock("本文", false)},
+			expected: []string{"blocks:" + ViolationRequired},
+		},
+		{
+			name:     "異常系：ブロック数が上限を超える場合",
+			schema:   FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeText, MaxCount: 1}}},
+			blocks:   []ContentBlock{textBlock("1", true), textBlock("2", false)},
+			expected: []string{"blocks:" + ViolationTooMany},
+		},
+		{
+			name:   "異常系：数値が範囲外の場合",
+			schema: FieldSchema{Fields: []FieldDefinition{{BlockType: BlockTypeEmbed, MinValue: decimalPtr(1), MaxValue: decimalPtr(5)}}},
+			blocks: []ContentBlock{
+				{BlockType: BlockTypeEmbed, Data: &ContentBlockData{DataType: DataTypeNumber, ContentNumber: decimalPtr(0)}},
+				{BlockType: BlockTypeEmbed, Data: &ContentBlockData{DataType: DataTypeNumber, ContentNumber: decimalPtr(6)}},
+			},
+			expected: []string{"blocks[0].data.content_number:" + ViolationOutOfRange, "blocks[1].data.content_number:" + ViolationOutOfRange},
+		},
+		{
+			name: "異常系：並び順が定義と異なる場合",
+			schema: FieldSchema{Fields: []FieldDefinition{
+				{BlockType: BlockTypeImage}, {BlockType: BlockTypeText},
+			}, Ordered: true},
+			blocks:   []ContentBlock{{BlockType: BlockTypeImage}, textBlock("本文", true), {BlockType: BlockTypeImage}},
+			expected: []string{"blocks[2].block_type:" + ViolationInvalidOrder},
+		},
+		{
+			name:     "異常系：不明なブロックの種類の場合",
+			blocks:   []ContentBlock{{BlockType: "table"}},
+			expected: []string{"blocks[0].block_type:" + ViolationNotAllowed},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := tc.schema.ValidateBlocks(tc.blocks, map[uuid.UUID]string{referencedID: "blog_post"})
+			var actual []string
+			for _, v := range errs {
+				actual = append(actual, v.Field+":"+v.Code)
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	var err error = ValidationErrors{
+		{Field: "blocks", Code: ViolationRequired, Message: "本文のブロックが必要です"},
+		{Field: "blocks[0].data.content_url", Code: ViolationInvalidURL, Message: "URLが不正です"},
+	}
+	assert.True(t, errors.Is(err, ErrInvalidParameter))
+	assert.Equal(t, "不正なパラメータです: blocks: 本文のブロックが必要です; blocks[0].data.content_url: URLが不正です", err.Error())
+}
@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ContentAction はコンテンツの公開状態を変更する操作
+type ContentAction string
+
+const (
+	ContentActionPublish   ContentAction = "publish"
+	ContentActionUnpublish ContentAction = "unpublish"
+	ContentActionArchive   ContentAction = "archive"
+	ContentActionTrash     ContentAction = "trash"
+	ContentActionRestore   ContentAction = "restore"
+)
+
+// statusTransition は操作の遷移元として許可されるステータスと遷移先のステータス
+type statusTransition struct {
+	from []ContentStatus
+	to   ContentStatus
+}
+
+// contentActions はステータスから操作を探すときの優先順
+var contentActions = []ContentAction{
+	ContentActionPublish,
+	ContentActionUnpublish,
+	ContentActionArchive,
+	ContentActionTrash,
+	ContentActionRestore,
+}
+
+// statusTransitions は操作ごとの状態遷移の定義
+var statusTransitions = map[ContentAction]statusTransition{
+	ContentActionPublish: {
+		from: []ContentStatus{ContentStatusDraft},
+		to:   ContentStatusPublished,
+	},
+	ContentActionUnpublish: {
+		from: []ContentStatus{ContentStatusPublished, ContentStatusArchived},
+		to:   ContentStatusDraft,
+	},
+	ContentActionArchive: {
+		from: []ContentStatus{ContentStatusPublished},
+		to:   ContentStatusArchived,
+	},
+	ContentActionTrash: {
+		from: []ContentStatus{ContentStatusDraft, ContentStatusPublished, ContentStatusArchived},
+		to:   ContentStatusTrash,
+	},
+	ContentActionRestore: {
+		from: []ContentStatus{ContentStatusTrash},
+		to:   ContentStatusDraft,
+	},
+}
+
+// IsValid は操作が定義済みの値かを確認
+func (a ContentAction) IsValid() bool {
+	_, ok := statusTransitions[a]
+	return ok
+}
+
+// Apply は操作に従ってステータスを遷移させ、公開日時を設定・解除します
+// 公開時は公開日時をnow（予約日時を過ぎている場合は予約日時）に設定し、下書きに戻す場合は公開日時を解除します
+// 公開予約はいずれの操作でも解除され、公開終了日時は公開時のみ維持されます
+// ゴミ箱へ移動した場合は削除日時をnowに設定し、それ以外の操作では解除します
+func (c *Content) Apply(action ContentAction, now time.Time) error {
+	transition, ok := statusTransitions[action]
+	if !ok {
+		return fmt.Errorf("%w: action=%s", ErrInvalidParameter, action)
+	}
+	if !slices.Contains(transition.from, c.Status) {
+		return fmt.Errorf("%w: %sのコンテンツに%sは実行できません", ErrInvalidStatusTransition, c.Status, action)
+	}
+
+	c.Status = transition.to
+	switch action {
+	case ContentActionPublish:
+		publishedAt := now
+		if c.ScheduledAt != nil && !c.ScheduledAt.After(now) {
+			publishedAt = *c.ScheduledAt
+		}
+		c.PublishedAt = &publishedAt
+	case ContentActionUnpublish, ContentActionRestore:
+		c.PublishedAt = nil
+	}
+
+	c.ScheduledAt = nil
+	if action != ContentActionPublish {
+		c.ExpiresAt = nil
+	}
+
+	c.DeletedAt = nil
+	if action == ContentActionTrash {
+		deletedAt := now
+		c.DeletedAt = &deletedAt
+	}
+	return nil
+}
+
+// Schedule は公開予約日時と公開終了日時を設定します（nilの項目は変更しません）
+// 公開予約は下書きのみ、公開終了日時は下書きと公開中のコンテンツのみに設定でき、いずれもnowより後である必要があります
+func (c *Content) Schedule(publishAt, expiresAt *time.Time, now time.Time) error {
+	if publishAt == nil && expiresAt == nil {
+		return fmt.Errorf("%w: publish_atまたはexpires_atは必須です", ErrInvalidParameter)
+	}
+
+	if publishAt != nil {
+		if c.Status != ContentStatusDraft {
+			return fmt.Errorf("%w: %sのコンテンツは公開予約できません", ErrInvalidStatusTransition, c.Status)
+		}
+		if !publishAt.After(now) {
+			return fmt.Errorf("%w: publish_atは現在より後の日時を指定してください", ErrInvalidParameter)
+		}
+		c.ScheduledAt = publishAt
+	}
+
+	if expiresAt != nil {
+		if c.Status != ContentStatusDraft && c.Status != ContentStatusPublished {
+			return fmt.Errorf("%w: %sのコンテンツには公開終了日時を設定できません", ErrInvalidStatusTransition, c.Status)
+		}
+		if !expiresAt.After(now) {
+			return fmt.Errorf("%w: expires_atは現在より後の日時を指定してください", ErrInvalidParameter)
+		}
+		if c.ScheduledAt != nil && !expiresAt.After(*c.ScheduledAt) {
+			return fmt.Errorf("%w: expires_atは公開予約日時より後の日時を指定してください", ErrInvalidParameter)
+		}
+		c.ExpiresAt = expiresAt
+	}
+	return nil
+}
+
+// Unschedule は公開予約日時と公開終了日時を解除します
+func (c *Content) Unschedule() {
+	c.ScheduledAt = nil
+	c.ExpiresAt = nil
+}
+
+// DueAction はnowの時点で実行すべき予約済みの操作を返します
+// 公開予約日時を過ぎた下書きは公開、公開終了日時を過ぎた公開中のコンテンツはアーカイブします
+func (c *Content) DueAction(now time.Time) (ContentAction, bool) {
+	switch {
+	case c.Status == ContentStatusDraft && c.ScheduledAt != nil && !c.ScheduledAt.After(now):
+		return ContentActionPublish, true
+	case c.Status == ContentStatusPublished && c.ExpiresAt != nil && !c.ExpiresAt.After(now):
+		return ContentActionArchive, true
+	}
+	return "", false
+}
+
+// IsVisibleAt はnowの時点で公開中（公開日時を過ぎ、公開終了日時の前）かを確認
+func (c *Content) IsVisibleAt(now time.Time) bool {
+	return c.IsPublished() &&
+		!c.PublishedAt.After(now) &&
+		(c.ExpiresAt == nil || c.ExpiresAt.After(now))
+}
+
+// ChangeStatus は現在のステータスから指定されたステータスへ遷移する操作を探して適用します
+// 同じステータスの場合は何もしません
+func (c *Content) ChangeStatus(status ContentStatus, now time.Time) error {
+	if status == c.Status {
+		return nil
+	}
+	if !status.IsValid() {
+		return fmt.Errorf("%w: status=%s", ErrInvalidParameter, status)
+	}
+
+	for _, action := range contentActions {
+		transition := statusTransitions[action]
+		if transition.to == status && slices.Contains(transition.from, c.Status) {
+			return c.Apply(action, now)
+		}
+	}
+	return fmt.Errorf("%w: %sから%sへは変更できません", ErrInvalidStatusTransition, c.Status, status)
+}
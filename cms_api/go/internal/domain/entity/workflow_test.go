@@ -0,0 +1,278 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentApply(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-24 * time.Hour)
+
+	testCases := []struct {
+		name                string
+		status              ContentStatus
+		publishedAt         *time.Time
+		action              ContentAction
+		expectedStatus      ContentStatus
+		expectedPublishedAt *time.Time
+		expectedError       error
+	}{
+		{
+			name:                "正常系：下書きを公開する場合",
+			status:              ContentStatusDraft,
+			action:              ContentActionPublish,
+			expectedStatus:      ContentStatusPublished,
+			expectedPublishedAt: &now,
+		},
+		{
+			name:           "正常系：公開を停止する場合",
+			status:         ContentStatusPublished,
+			publishedAt:    &past,
+			action:         ContentActionUnpublish,
+			expectedStatus: ContentStatusDraft,
+		},
+		{
+			name:                "正常系：公開中のコンテンツをアーカイブする場合",
+			status:              ContentStatusPublished,
+			publishedAt:         &past,
+			action:              ContentActionArchive,
+			expectedStatus:      ContentStatusArchived,
+			expectedPublishedAt: &past,
+		},
+		{
+			name:                "正常系：アーカイブ済みのコンテンツをゴミ箱へ移動する場合",
+			status:              ContentStatusArchived,
+			publishedAt:         &past,
+			action:              ContentActionTrash,
+			expectedStatus:      ContentStatusTrash,
+			expectedPublishedAt: &past,
+		},
+		{
+			name:           "正常系：ゴミ箱から復元する場合",
+			status:         ContentStatusTrash,
+			publishedAt:    &past,
+			action:         ContentActionRestore,
+			expectedStatus: ContentStatusDraft,
+		},
+		{
+			name:          "異常系：下書きをアーカイブする場合",
+			status:        ContentStatusDraft,
+			action:        ContentActionArchive,
+			expectedError: ErrInvalidStatusTransition,
+		},
+		{
+			name:          "異常系：公開中のコンテンツを再度公開する場合",
+			status:        ContentStatusPublished,
+			action:        ContentActionPublish,
+			expectedError: ErrInvalidStatusTransition,
+		},
+		{
+			name:          "異常系：ゴミ箱のコンテンツをゴミ箱へ移動する場合",
+			status:        ContentStatusTrash,
+			action:        ContentActionTrash,
+			expectedError: ErrInvalidStatusTransition,
+		},
+		{
+			name:          "異常系：未定義の操作の場合",
+			status:        ContentStatusDraft,
+			action:        "delete",
+			expectedError: ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content := &Content{Status: tc.status, PublishedAt: tc.publishedAt}
+			err := content.Apply(tc.action, now)
+			if tc.expectedError != nil {
+				assert.True(t, errors.Is(err, tc.expectedError))
+				assert.Equal(t, tc.status, content.Status)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, content.Status)
+			assert.Equal(t, tc.expectedPublishedAt, content.PublishedAt)
+		})
+	}
+}
+
+func TestContentChangeStatus(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		from          ContentStatus
+		to            ContentStatus
+		expectedError error
+	}{
+		{name: "正常系：同じステータスの場合", from: ContentStatusArchived, to: ContentStatusArchived},
+		{name: "正常系：下書きから公開へ変更する場合", from: ContentStatusDraft, to: ContentStatusPublished},
+		{name: "正常系：ゴミ箱から下書きへ変更する場合", from: ContentStatusTrash, to: ContentStatusDraft},
+		{name: "異常系：ゴミ箱から公開へ変更する場合", from: ContentStatusTrash, to: ContentStatusPublished, expectedError: ErrInvalidStatusTransition},
+		{name: "異常系：未定義のステータスの場合", from: ContentStatusDraft, to: "deleted", expectedError: ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content := &Content{Status: tc.from}
+			err := content.ChangeStatus(tc.to, now)
+			if tc.expectedError != nil {
+				assert.True(t, errors.Is(err, tc.expectedError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.to, content.Status)
+		})
+	}
+}
+
+func TestContentSchedule(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	later := now.Add(48 * time.Hour)
+
+	testCases := []struct {
+		name                string
+		status              ContentStatus
+		publishAt           *time.Time
+		expiresAt           *time.Time
+		expectedScheduledAt *time.Time
+		expectedExpiresAt   *time.Time
+		expectedError       error
+	}{
+		{
+			name:                "正常系：下書きに公開予約と公開終了日時を設定する場合",
+			status:              ContentStatusDraft,
+			publishAt:           &future,
+			expiresAt:           &later,
+			expectedScheduledAt: &future,
+			expectedExpiresAt:   &later,
+		},
+		{
+			name:              "正常系：公開中のコンテンツに公開終了日時を設定する場合",
+			status:            ContentStatusPublished,
+			expiresAt:         &later,
+			expectedExpiresAt: &later,
+		},
+		{
+			name:          "異常系：公開中のコンテンツを公開予約する場合",
+			status:        ContentStatusPublished,
+			publishAt:     &future,
+			expectedError: ErrInvalidStatusTransition,
+		},
+		{
+			name:          "異常系：アーカイブ済みのコンテンツに公開終了日時を設定する場合",
+			status:        ContentStatusArchived,
+			expiresAt:     &later,
+			expectedError: ErrInvalidStatusTransition,
+		},
+		{
+			name:          "異常系：過去の公開予約日時の場合",
+			status:        ContentStatusDraft,
+			publishAt:     &past,
+			expectedError: ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：公開終了日時が公開予約日時より前の場合",
+			status:        ContentStatusDraft,
+			publishAt:     &later,
+			expiresAt:     &future,
+			expectedError: ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：日時が指定されていない場合",
+			status:        ContentStatusDraft,
+			expectedError: ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content := &Content{Status: tc.status}
+			err := content.Schedule(tc.publishAt, tc.expiresAt, now)
+			if tc.expectedError != nil {
+				assert.True(t, errors.Is(err, tc.expectedError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedScheduledAt, content.ScheduledAt)
+			assert.Equal(t, tc.expectedExpiresAt, content.ExpiresAt)
+		})
+	}
+}
+
+func TestContentDueAction(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	testCases := []struct {
+		name           string
+		content        Content
+		expectedAction ContentAction
+		expectedDue    bool
+	}{
+		{
+			name:           "正常系：公開予約日時を過ぎた下書きの場合",
+			content:        Content{Status: ContentStatusDraft, ScheduledAt: &past},
+			expectedAction: ContentActionPublish,
+			expectedDue:    true,
+		},
+		{
+			name:           "正常系：公開終了日時を過ぎた公開中のコンテンツの場合",
+			content:        Content{Status: ContentStatusPublished, PublishedAt: &past, ExpiresAt: &past},
+			expectedAction: ContentActionArchive,
+			expectedDue:    true,
+		},
+		{
+			name:    "正常系：公開予約日時より前の場合",
+			content: Content{Status: ContentStatusDraft, ScheduledAt: &future},
+		},
+		{
+			name:    "正常系：予約がない場合",
+			content: Content{Status: ContentStatusPublished, PublishedAt: &past},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			action, due := tc.content.DueAction(now)
+			assert.Equal(t, tc.expectedAction, action)
+			assert.Equal(t, tc.expectedDue, due)
+		})
+	}
+}
+
+func TestContentApplyScheduled(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	scheduledAt := now.Add(-5 * time.Minute)
+	expiresAt := now.Add(24 * time.Hour)
+
+	content := &Content{Status: ContentStatusDraft, ScheduledAt: &scheduledAt, ExpiresAt: &expiresAt}
+	err := content.Apply(ContentActionPublish, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ContentStatusPublished, content.Status)
+	assert.Equal(t, &scheduledAt, content.PublishedAt)
+	assert.Nil(t, content.ScheduledAt)
+	assert.Equal(t, &expiresAt, content.ExpiresAt)
+	assert.True(t, content.IsVisibleAt(now))
+}
+
+func TestContentApplyTrash(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	content := &Content{Status: ContentStatusDraft}
+	assert.NoError(t, content.Apply(ContentActionTrash, now))
+	assert.Equal(t, ContentStatusTrash, content.Status)
+	assert.Equal(t, &now, content.DeletedAt)
+
+	assert.NoError(t, content.Apply(ContentActionRestore, now.Add(time.Hour)))
+	assert.Equal(t, ContentStatusDraft, content.Status)
+	assert.Nil(t, content.DeletedAt)
+}
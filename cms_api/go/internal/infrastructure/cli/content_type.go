@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// contentTypeDefinitions はコンテンツタイプの定義のエクスポート・インポートを行うユースケース
+type contentTypeDefinitions interface {
+	ExportContentTypes(ctx context.Context) (*entity.ContentTypeDefinitions, error)
+	ImportContentTypes(ctx context.Context, definitions *entity.ContentTypeDefinitions, dryRun bool) (*usecase.ContentTypeImportReport, error)
+}
+
+// ErrUsage はコマンドの使い方が誤っていることを表す
+var ErrUsage = errors.New("使い方が誤っています")
+
+// contentTypeUsage はコマンドの使い方
+const contentTypeUsage = `使い方:
+  contenttypes export [-o ファイル] [-format yaml|json]
+  contenttypes import -f ファイル [-format yaml|json] [-dry-run]`
+
+// ContentTypeCommand はコンテンツタイプの定義をファイルにエクスポート・ファイルからインポートするコマンド
+type ContentTypeCommand struct {
+	definitions contentTypeDefinitions
+	stdout      io.Writer
+	stderr      io.Writer
+}
+
+// NewContentTypeCommand はコマンドを作成します（結果はstdout、使い方とフラグのエラーはstderrに出力する）
+func NewContentTypeCommand(definitions contentTypeDefinitions, stdout, stderr io.Writer) *ContentTypeCommand {
+	return &ContentTypeCommand{definitions: definitions, stdout: stdout, stderr: stderr}
+}
+
+// Run はサブコマンド（export・import）を実行します
+func (c *ContentTypeCommand) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintln(c.stderr, contentTypeUsage)
+		return ErrUsage
+	}
+
+	switch args[0] {
+	case "export":
+		return c.export(ctx, args[1:])
+	case "import":
+		return c.importDefinitions(ctx, args[1:])
+	}
+	fmt.Fprintln(c.stderr, contentTypeUsage)
+	return fmt.Errorf("%w: 不明なサブコマンドです: %s", ErrUsage, args[0])
+}
+
+// export はすべてのコンテンツタイプの定義をファイル（未指定の場合は標準出力）に出力します
+func (c *ContentTypeCommand) export(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("export")
+	output := flags.String("o", "", "出力するファイル (省略時は標準出力)")
+	formatName := flags.String("format", "", "ファイルの形式 yaml|json (省略時はファイルの拡張子から判定)")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %v", ErrUsage, err)
+	}
+	format, err := definitionFormat(*formatName, *output)
+	if err != nil {
+		return err
+	}
+
+	definitions, err := c.definitions.ExportContentTypes(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := definitions.Marshal(format)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err = c.stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(*output, data, 0o644); err != nil {
+		return fmt.Errorf("ファイルの書き込みに失敗しました: %w", err)
+	}
+	fmt.Fprintf(c.stdout, "%d件のコンテンツタイプを%sに出力しました\n", len(definitions.ContentTypes), *output)
+	return nil
+}
+
+// importDefinitions はファイルのコンテンツタイプの定義を取り込み、作成・更新・変更なしのコンテンツタイプを出力します
+func (c *ContentTypeCommand) importDefinitions(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("import")
+	input := flags.String("f", "", "取り込むファイル (必須)")
+	formatName := flags.String("format", "", "ファイルの形式 yaml|json (省略時はファイルの拡張子から判定)")
+	dryRun := flags.Bool("dry-run", false, "変更せずに結果の見込みのみを出力する")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %v", ErrUsage, err)
+	}
+	if *input == "" {
+		flags.Usage()
+		return fmt.Errorf("%w: -fは必須です", ErrUsage)
+	}
+	format, err := definitionFormat(*formatName, *input)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(*input)
+	if err != nil {
+		return fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+	}
+	definitions, err := entity.UnmarshalContentTypeDefinitions(data, format)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *input, err)
+	}
+
+	report, err := c.definitions.ImportContentTypes(ctx, definitions, *dryRun)
+	if err != nil {
+		return err
+	}
+	c.printImportReport(report)
+	return nil
+}
+
+// printImportReport は取り込みの結果をコンテンツタイプごとに出力します
+func (c *ContentTypeCommand) printImportReport(report *usecase.ContentTypeImportReport) {
+	for _, name := range report.Created {
+		fmt.Fprintf(c.stdout, "作成: %s\n", name)
+	}
+	for _, name := range report.Updated {
+		fmt.Fprintf(c.stdout, "更新: %s\n", name)
+	}
+	for _, name := range report.Unchanged {
+		fmt.Fprintf(c.stdout, "変更なし: %s\n", name)
+	}
+
+	summary := fmt.Sprintf("作成 %d件・更新 %d件・変更なし %d件", len(report.Created), len(report.Updated), len(report.Unchanged))
+	if report.DryRun {
+		summary += " (ドライランのため変更していません)"
+	}
+	fmt.Fprintln(c.stdout, summary)
+}
+
+// newFlagSet はサブコマンドのフラグを作成します（エラー時は終了せずにエラーを返す）
+func (c *ContentTypeCommand) newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	return flags
+}
+
+// definitionFormat は指定された形式、またはファイルの拡張子からファイルの形式を判定します
+func definitionFormat(name, path string) (entity.DefinitionFormat, error) {
+	if strings.TrimSpace(name) == "" {
+		return entity.DefinitionFormatFromPath(path), nil
+	}
+	format, err := entity.ParseDefinitionFormat(name)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrUsage, err)
+	}
+	return format, nil
+}
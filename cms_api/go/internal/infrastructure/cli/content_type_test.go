@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/cli/mocks"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentTypeCommandExport(t *testing.T) {
+	definitions := &entity.ContentTypeDefinitions{ContentTypes: []entity.ContentTypeDefinition{
+		{Name: "blog_post", DisplayName: "ブログ記事"},
+	}}
+
+	t.Run("正常系：ファイルを指定しない場合はYAMLで標準出力に出力する場合", func(t *testing.T) {
+		d := mocks.NewContentTypeDefinitions(t)
+		d.EXPECT().ExportContentTypes(context.Background()).Return(definitions, nil)
+		var stdout bytes.Buffer
+
+		err := NewContentTypeCommand(d, &stdout, &bytes.Buffer{}).Run(context.Background(), []string{"export"})
+		assert.NoError(t, err)
+		assert.Contains(t, stdout.String(), "content_types:\n  - name: blog_post\n")
+	})
+
+	t.Run("正常系：拡張子から形式を判定してファイルに出力する場合", func(t *testing.T) {
+		d := mocks.NewContentTypeDefinitions(t)
+		d.EXPECT().ExportContentTypes(context.Background()).Return(definitions, nil)
+		path := filepath.Join(t.TempDir(), "content-types.json")
+		var stdout bytes.Buffer
+
+		err := NewContentTypeCommand(d, &stdout, &bytes.Buffer{}).Run(context.Background(), []string{"export", "-o", path})
+		require.NoError(t, err)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"name": "blog_post"`)
+		assert.Contains(t, stdout.String(), "1件")
+	})
+
+	t.Run("異常系：形式が不正な場合", func(t *testing.T) {
+		d := mocks.NewContentTypeDefinitions(t)
+
+		err := NewContentTypeCommand(d, &bytes.Buffer{}, &bytes.Buffer{}).Run(context.Background(), []string{"export", "-format", "xml"})
+		assert.ErrorIs(t, err, ErrUsage)
+	})
+}
+
+func TestContentTypeCommandImport(t *testing.T) {
+	writeFile := func(t *testing.T, name, data string) string {
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+		return path
+	}
+	yamlFile := "content_types:\n  - name: news\n    display_name: お知らせ\n    field_schema:\n      fields: []\n"
+
+	t.Run("正常系：ファイルを取り込んで作成・更新・変更なしを出力する場合", func(t *testing.T) {
+		d := mocks.NewContentTypeDefinitions(t)
+		d.EXPECT().ImportContentTypes(context.Background(), mock.MatchedBy(func(defs *entity.ContentTypeDefinitions) bool {
+			return len(defs.ContentTypes) == 1 && defs.ContentTypes[0].Name == "news"
+		}), false).Return(&usecase.ContentTypeImportReport{
+			Created: []string{"news"}, Updated: []string{"blog_post"}, Unchanged: []string{"page"},
+		}, nil)
+		var stdout bytes.Buffer
+
+		err := NewContentTypeCommand(d, &stdout, &bytes.Buffer{}).Run(context.Background(),
+			[]string{"import", "-f", writeFile(t, "content-types.yaml", yamlFile)})
+		assert.NoError(t, err)
+		assert.Equal(t, "作成: news\n更新: blog_post\n変更なし: page\n作成 1件・更新 1件・変更なし 1件\n", stdout.String())
+	})
+
+	t.Run("正常系：ドライランの場合は変更していないことを出力する場合", func(t *testing.T) {
+		d := mocks.NewContentTypeDefinitions(t)
+		d.EXPECT().ImportContentTypes(context.Background(), mock.Anything, true).
+			Return(&usecase.ContentTypeImportReport{DryRun: true, Unchanged: []string{"news"}}, nil)
+		var stdout bytes.Buffer
+
+		err := NewContentTypeCommand(d, &stdout, &bytes.Buffer{}).Run(context.Background(),
+			[]string{"import", "-f", writeFile(t, "content-types.yaml", yamlFile), "-dry-run"})
+		assert.NoError(t, err)
+		assert.Contains(t, stdout.String(), "ドライラン")
+	})
+
+	t.Run("異常系：ファイルの形式が不正な場合は取り込まない場合", func(t *testing.T) {
+		d := mocks.NewContentTypeDefinitions(t)
+
+		err := NewContentTypeCommand(d, &bytes.Buffer{}, &bytes.Buffer{}).Run(context.Background(),
+			[]string{"import", "-f", writeFile(t, "content-types.json", yamlFile)})
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系：取り込みに失敗した場合", func(t *testing.T) {
+		d := mocks.NewContentTypeDefinitions(t)
+		importErr := errors.New("db error")
+		d.EXPECT().ImportContentTypes(context.Background(), mock.Anything, false).Return(nil, importErr)
+
+		err := NewContentTypeCommand(d, &bytes.Buffer{}, &bytes.Buffer{}).Run(context.Background(),
+			[]string{"import", "-f", writeFile(t, "content-types.yml", yamlFile)})
+		assert.ErrorIs(t, err, importErr)
+	})
+
+	t.Run("異常系：ファイルを指定しない場合", func(t *testing.T) {
+		d := mocks.NewContentTypeDefinitions(t)
+
+		err := NewContentTypeCommand(d, &bytes.Buffer{}, &bytes.Buffer{}).Run(context.Background(), []string{"import"})
+		assert.ErrorIs(t, err, ErrUsage)
+	})
+}
+
+func TestContentTypeCommandUsage(t *testing.T) {
+	d := mocks.NewContentTypeDefinitions(t)
+	var stderr bytes.Buffer
+
+	err := NewContentTypeCommand(d, &bytes.Buffer{}, &stderr).Run(context.Background(), []string{"sync"})
+	assert.ErrorIs(t, err, ErrUsage)
+	assert.Contains(t, stderr.String(), "使い方")
+}
@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "cms_api/internal/domain/entity"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "cms_api/internal/usecase/content"
+)
+
+// ContentTypeDefinitions is an autogenerated mock type for the contentTypeDefinitions type
+type ContentTypeDefinitions struct {
+	mock.Mock
+}
+
+type ContentTypeDefinitions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ContentTypeDefinitions) EXPECT() *ContentTypeDefinitions_Expecter {
+	return &ContentTypeDefinitions_Expecter{mock: &_m.Mock}
+}
+
+// ExportContentTypes provides a mock function with given fields: ctx
+func (_m *ContentTypeDefinitions) ExportContentTypes(ctx context.Context) (*entity.ContentTypeDefinitions, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportContentTypes")
+	}
+
+	var r0 *entity.ContentTypeDefinitions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*entity.ContentTypeDefinitions, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *entity.ContentTypeDefinitions); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentTypeDefinitions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentTypeDefinitions_ExportContentTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportContentTypes'
+type ContentTypeDefinitions_ExportContentTypes_Call struct {
+	*mock.Call
+}
+
+// ExportContentTypes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentTypeDefinitions_Expecter) ExportContentTypes(ctx interface{}) *ContentTypeDefinitions_ExportContentTypes_Call {
+	return &ContentTypeDefinitions_ExportContentTypes_Call{Call: _e.mock.On("ExportContentTypes", ctx)}
+}
+
+func (_c *ContentTypeDefinitions_ExportContentTypes_Call) Run(run func(ctx context.Context)) *ContentTypeDefinitions_ExportContentTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentTypeDefinitions_ExportContentTypes_Call) Return(_a0 *entity.ContentTypeDefinitions, _a1 error) *ContentTypeDefinitions_ExportContentTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentTypeDefinitions_ExportContentTypes_Call) RunAndReturn(run func(context.Context) (*entity.ContentTypeDefinitions, error)) *ContentTypeDefinitions_ExportContentTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportContentTypes provides a mock function with given fields: ctx, definitions, dryRun
+func (_m *ContentTypeDefinitions) ImportContentTypes(ctx context.Context, definitions *entity.ContentTypeDefinitions, dryRun bool) (*usecase.ContentTypeImportReport, error) {
+	ret := _m.Called(ctx, definitions, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportContentTypes")
+	}
+
+	var r0 *usecase.ContentTypeImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentTypeDefinitions, bool) (*usecase.ContentTypeImportReport, error)); ok {
+		return rf(ctx, definitions, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentTypeDefinitions, bool) *usecase.ContentTypeImportReport); ok {
+		r0 = rf(ctx, definitions, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ContentTypeImportReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.ContentTypeDefinitions, bool) error); ok {
+		r1 = rf(ctx, definitions, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentTypeDefinitions_ImportContentTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportContentTypes'
+type ContentTypeDefinitions_ImportContentTypes_Call struct {
+	*mock.Call
+}
+
+// ImportContentTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - definitions *entity.ContentTypeDefinitions
+//   - dryRun bool
+func (_e *ContentTypeDefinitions_Expecter) ImportContentTypes(ctx interface{}, definitions interface{}, dryRun interface{}) *ContentTypeDefinitions_ImportContentTypes_Call {
+	return &ContentTypeDefinitions_ImportContentTypes_Call{Call: _e.mock.On("ImportContentTypes", ctx, definitions, dryRun)}
+}
+
+func (_c *ContentTypeDefinitions_ImportContentTypes_Call) Run(run func(ctx context.Context, definitions *entity.ContentTypeDefinitions, dryRun bool)) *ContentTypeDefinitions_ImportContentTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ContentTypeDefinitions), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ContentTypeDefinitions_ImportContentTypes_Call) Return(_a0 *usecase.ContentTypeImportReport, _a1 error) *ContentTypeDefinitions_ImportContentTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentTypeDefinitions_ImportContentTypes_Call) RunAndReturn(run func(context.Context, *entity.ContentTypeDefinitions, bool) (*usecase.ContentTypeImportReport, error)) *ContentTypeDefinitions_ImportContentTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewContentTypeDefinitions creates a new instance of ContentTypeDefinitions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewContentTypeDefinitions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ContentTypeDefinitions {
+	mock := &ContentTypeDefinitions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
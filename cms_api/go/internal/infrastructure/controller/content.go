@@ -1,43 +1,425 @@
 package controller
 
 import (
-	model "cms_api/internal/domain/entity"
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
-type getContents interface {
-	GetArticles(ctx context.Context) ([]model.Article, error)
+type contentUsecase interface {
+	GetContents(ctx context.Context, input usecase.GetContentsInput) (*usecase.ContentList, error)
+	SearchContents(ctx context.Context, input usecase.GetContentsInput) (*usecase.SearchList, error)
+	GetContentByID(ctx context.Context, id uuid.UUID) (*entity.Content, error)
+	GetContentBySlug(ctx context.Context, contentTypeName, slug string) (*entity.Content, error)
+	ExpandReferences(ctx context.Context, content *entity.Content, depth int, visibleOnly bool) error
+	GetReferrers(ctx context.Context, id uuid.UUID) ([]entity.Referrer, error)
+	GetSlugRedirects(ctx context.Context, contentID uuid.UUID) ([]*entity.SlugRedirect, error)
+	DeleteSlugRedirect(ctx context.Context, contentID, redirectID uuid.UUID) error
+	CreateContent(ctx context.Context, content *entity.Content) (*entity.Content, error)
+	UpdateContent(ctx context.Context, id uuid.UUID, content *entity.Content) (*entity.Content, error)
+	PatchContent(ctx context.Context, id uuid.UUID, input usecase.PatchContentInput) (*entity.Content, error)
+	DeleteContent(ctx context.Context, id uuid.UUID, expectedVersion int, policy entity.ReferrerPolicy) error
+	GetTrashedContents(ctx context.Context, input usecase.GetContentsInput) (*usecase.ContentList, error)
+	PurgeContent(ctx context.Context, id uuid.UUID, policy entity.ReferrerPolicy) error
+	PurgeTrash(ctx context.Context, olderThan time.Duration) (*usecase.PurgeResult, error)
+	TransitionContent(ctx context.Context, id uuid.UUID, action entity.ContentAction, expectedVersion int, policy entity.ReferrerPolicy) (*entity.Content, error)
+	ScheduleContent(ctx context.Context, id uuid.UUID, input usecase.ScheduleContentInput) (*entity.Content, error)
+	UnscheduleContent(ctx context.Context, id uuid.UUID, expectedVersion int) (*entity.Content, error)
+	InsertBlock(ctx context.Context, contentID uuid.UUID, input usecase.InsertBlockInput) (*entity.Content, error)
+	MoveBlock(ctx context.Context, contentID, blockID uuid.UUID, input usecase.MoveBlockInput) (*entity.Content, error)
+	SetBlockVisibility(ctx context.Context, contentID, blockID uuid.UUID, input usecase.BlockVisibilityInput) (*entity.Content, error)
+	DeleteBlock(ctx context.Context, contentID, blockID uuid.UUID, version int) (*entity.Content, error)
+	GetContentVersions(ctx context.Context, contentID uuid.UUID) ([]*entity.ContentVersion, error)
+	GetContentVersion(ctx context.Context, contentID uuid.UUID, version int) (*entity.ContentVersion, error)
+	RestoreContentVersion(ctx context.Context, contentID uuid.UUID, version, expectedVersion int) (*entity.Content, error)
+	DiffContentVersions(ctx context.Context, contentID uuid.UUID, from, to int) (*usecase.ContentDiff, error)
+	GetContentTypes(ctx context.Context, includeInactive bool) ([]*entity.ContentType, error)
+	GetContentTypeByID(ctx context.Context, id uuid.UUID) (*entity.ContentType, error)
+	CreateContentType(ctx context.Context, contentType *entity.ContentType) (*entity.ContentType, error)
+	UpdateContentType(ctx context.Context, id uuid.UUID, contentType *entity.ContentType) (*entity.ContentType, error)
+	DeactivateContentType(ctx context.Context, id uuid.UUID) error
+	ActivateContentType(ctx context.Context, id uuid.UUID) (*entity.ContentType, error)
+	GetContentTypeSchemaVersions(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error)
+	MigrateContentType(ctx context.Context, contentTypeID uuid.UUID, migration entity.SchemaMigration, dryRun bool) (*usecase.SchemaMigrationReport, error)
+	ExportContentTypes(ctx context.Context) (*entity.ContentTypeDefinitions, error)
+	ImportContentTypes(ctx context.Context, definitions *entity.ContentTypeDefinitions, dryRun bool) (*usecase.ContentTypeImportReport, error)
+	GetCategories(ctx context.Context) ([]*entity.Category, error)
+	GetCategoryByID(ctx context.Context, id uuid.UUID) (*entity.Category, error)
+	CreateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	UpdateCategory(ctx context.Context, id uuid.UUID, category *entity.Category) (*entity.Category, error)
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+	GetTags(ctx context.Context) ([]*entity.Tag, error)
+	GetTagByID(ctx context.Context, id uuid.UUID) (*entity.Tag, error)
+	CreateTag(ctx context.Context, tag *entity.Tag) (*entity.Tag, error)
+	UpdateTag(ctx context.Context, id uuid.UUID, tag *entity.Tag) (*entity.Tag, error)
+	DeleteTag(ctx context.Context, id uuid.UUID) error
+	GetCategoryCounts(ctx context.Context) ([]*entity.CategoryCount, error)
+	GetTagCounts(ctx context.Context) ([]*entity.TagCount, error)
+	GetArchiveCounts(ctx context.Context) ([]*entity.ArchiveCount, error)
+	GetArchiveContents(ctx context.Context, year, month int, input usecase.GetContentsInput) (*usecase.ContentList, error)
 }
 
 type ContentController struct {
-	contentUsecase getContents
+	contentUsecase contentUsecase
 }
 
-func NewContentController(cu getContents) *ContentController {
+func NewContentController(cu contentUsecase) *ContentController {
 	return &ContentController{
 		contentUsecase: cu,
 	}
 }
 
-// GetContent godoc
+// GetContents godoc
 // @Summary コンテンツ一覧の取得
-// @Description コンテンツ一覧を取得します
+// @Description コンテンツ一覧をページネーション付きで取得します
 // @Tags content
 // @Accept json
 // @Produce json
-// @Success 200 {array} model.Article
-// @Failure 500 {object} map[string]string
-// @Router / [get]
-func (cc *ContentController) GetContent(c echo.Context) error {
-	contents, err := cc.contentUsecase.GetArticles(c.Request().Context())
+// @Param limit query int false "取得件数 (1-100)"
+// @Param offset query int false "オフセット (0以上)"
+// @Param status query string false "ステータスフィルタ"
+// @Param category query string false "カテゴリのスラッグまたはID"
+// @Param tags query string false "タグのスラッグまたはID（カンマ区切り、すべてのタグが付いたコンテンツに絞り込む）"
+// @Param publishedFrom query string false "公開日時の開始（RFC3339または日付、その日時を含む）"
+// @Param publishedTo query string false "公開日時の終了（RFC3339または日付、日付のみの場合はその日の終わりまで）"
+// @Param createdFrom query string false "作成日時の開始"
+// @Param createdTo query string false "作成日時の終了"
+// @Param updatedFrom query string false "更新日時の開始"
+// @Param updatedTo query string false "更新日時の終了"
+// @Param search query string false "検索キーワード（空白区切り、すべての語をタイトル・スラッグ・本文のいずれかに含むコンテンツに絞り込む）"
+// @Param sort query string false "ソート対象 (createdAt, updatedAt, publishedAt, title)。カンマ区切りで複数指定でき、publishedAt:ascのように個別に順序を指定できる"
+// @Param order query string false "順序を指定しないソート対象のソート順 (asc, desc)"
+// @Param cursor query string false "前後のページのカーソル（指定した場合はoffsetを無視し、総件数を数えない）"
+// @Param view query string false "表示形式 (summary: メタデータと抜粋・カバー画像のみ（デフォルト）, full: ブロックを含める)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents [get]
+func (cc *ContentController) GetContents(c echo.Context) error {
+	input, err := contentsInput(c)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return handleError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, contents)
+	list, err := cc.contentUsecase.GetContents(c.Request().Context(), input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, list)
+}
+
+// GetContentByID godoc
+// @Summary コンテンツ詳細の取得
+// @Description 指定されたIDのコンテンツをブロック込みで取得します
+// @Tags content
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param expand query string false "展開する関連 (referencesで参照ブロックの参照先を展開)"
+// @Param depth query int false "参照を展開する深さ (1〜3。デフォルトは1)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id} [get]
+func (cc *ContentController) GetContentByID(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	depth, err := expandDepth(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.GetContentByID(c.Request().Context(), id)
+	if err != nil {
+		return handleError(c, err)
+	}
+	if depth > 0 {
+		if err := cc.contentUsecase.ExpandReferences(c.Request().Context(), content, depth, false); err != nil {
+			return handleError(c, err)
+		}
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
+
+// CreateContent godoc
+// @Summary コンテンツの作成
+// @Description コンテンツをブロックと共に作成します。ブロックは送信順に並び順が振られます。ステータスはdraft（省略時）またはpublishedを指定できます
+// @Tags content
+// @Accept json
+// @Produce json
+// @Param content body entity.Content true "作成するコンテンツ"
+// @Success 201 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents [post]
+func (cc *ContentController) CreateContent(c echo.Context) error {
+	var content entity.Content
+	if err := bindJSON(c, &content); err != nil {
+		return handleError(c, err)
+	}
+
+	created, err := cc.contentUsecase.CreateContent(c.Request().Context(), &content)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, created)
+	return successResponse(c, http.StatusCreated, created)
+}
+
+// UpdateContent godoc
+// @Summary コンテンツの更新
+// @Description 指定されたIDのコンテンツを送信内容で置き換えます。更新元のバージョンをversionまたはIf-Matchで指定します。アーカイブ・ゴミ箱への移動は POST /contents/{id}/archive・/trash を使用します
+// @Tags content
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "更新元のバージョン (ETag)"
+// @Param content body entity.Content true "更新後のコンテンツ"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id} [put]
+func (cc *ContentController) UpdateContent(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var content entity.Content
+	if err := bindJSON(c, &content); err != nil {
+		return handleError(c, err)
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+	if version > 0 {
+		content.Version = version
+	}
+
+	updated, err := cc.contentUsecase.UpdateContent(c.Request().Context(), id, &content)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, updated)
+	return successResponse(c, http.StatusOK, updated)
+}
+
+// PatchContent godoc
+// @Summary コンテンツの部分更新
+// @Description 指定されたIDのコンテンツのうち、送信されたフィールドのみを更新します。更新元のバージョンをversionまたはIf-Matchで指定します。アーカイブ・ゴミ箱への移動は POST /contents/{id}/archive・/trash を使用します
+// @Tags content
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "更新元のバージョン (ETag)"
+// @Param content body usecase.PatchContentInput true "更新するフィールド"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id} [patch]
+func (cc *ContentController) PatchContent(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var input usecase.PatchContentInput
+	if err := bindJSON(c, &input); err != nil {
+		return handleError(c, err)
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+	if version > 0 {
+		input.Version = &version
+	}
+
+	updated, err := cc.contentUsecase.PatchContent(c.Request().Context(), id, input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, updated)
+	return successResponse(c, http.StatusOK, updated)
+}
+
+// DeleteContent godoc
+// @Summary コンテンツの削除
+// @Description 指定されたIDのコンテンツをゴミ箱へ移動します。更新元のバージョンをIf-Matchまたはversionクエリで指定します。完全に削除する場合は DELETE /trash/{id} を使用します
+// @Tags content
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "更新元のバージョン (ETag)"
+// @Param version query int false "更新元のバージョン"
+// @Param force query string false "参照元がある場合の扱い（detach: 参照元の参照ブロックから参照先を外して非表示にする、remove: 参照元の参照ブロックを削除する）。省略した場合は参照元があると409を返します"
+// @Success 204
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id} [delete]
+func (cc *ContentController) DeleteContent(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	version, err := queryInt(c, "version")
+	if err != nil {
+		return handleError(c, err)
+	}
+	if version, err = requestVersion(c, version); err != nil {
+		return handleError(c, err)
+	}
+	policy, err := referrerPolicy(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	if err := cc.contentUsecase.DeleteContent(c.Request().Context(), id, version, policy); err != nil {
+		return handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bindJSON はリクエストボディをJSONとして読み込みます
+func bindJSON(c echo.Context, v interface{}) error {
+	if err := json.NewDecoder(c.Request().Body).Decode(v); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidFormat, err)
+	}
+	return nil
+}
+
+// setETag はコンテンツのバージョンをETagヘッダーに設定します
+func setETag(c echo.Context, content *entity.Content) {
+	c.Response().Header().Set("ETag", fmt.Sprintf(`"%d"`, content.Version))
+}
+
+// ifMatchVersion はIf-Matchヘッダーから更新元のバージョンを取得します（未指定の場合は0）
+func ifMatchVersion(c echo.Context) (int, error) {
+	raw := strings.TrimSpace(c.Request().Header.Get("If-Match"))
+	if raw == "" || raw == "*" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(raw, "W/"), `"`))
+	if err != nil {
+		return 0, fmt.Errorf("%w: If-Match=%s", entity.ErrInvalidParameter, raw)
+	}
+	return v, nil
+}
+
+// queryInt はクエリパラメータを整数として取得します（未指定の場合は0）
+func queryInt(c echo.Context, name string) (int, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s=%s", entity.ErrInvalidParameter, name, raw)
+	}
+	return v, nil
+}
+
+// contentsInput は一覧取得のクエリパラメータを読み込みます
+func contentsInput(c echo.Context) (usecase.GetContentsInput, error) {
+	limit, err := queryInt(c, "limit")
+	if err != nil {
+		return usecase.GetContentsInput{}, err
+	}
+	offset, err := queryInt(c, "offset")
+	if err != nil {
+		return usecase.GetContentsInput{}, err
+	}
+
+	return usecase.GetContentsInput{
+		Limit:         limit,
+		Offset:        offset,
+		Status:        c.QueryParam("status"),
+		Category:      c.QueryParam("category"),
+		Tags:          queryList(c, "tags"),
+		PublishedFrom: c.QueryParam("publishedFrom"),
+		PublishedTo:   c.QueryParam("publishedTo"),
+		CreatedFrom:   c.QueryParam("createdFrom"),
+		CreatedTo:     c.QueryParam("createdTo"),
+		UpdatedFrom:   c.QueryParam("updatedFrom"),
+		UpdatedTo:     c.QueryParam("updatedTo"),
+		Search:        c.QueryParam("search"),
+		Sort:          c.QueryParam("sort"),
+		Order:         c.QueryParam("order"),
+		Cursor:        c.QueryParam("cursor"),
+		View:          c.QueryParam("view"),
+	}, nil
+}
+
+// expandDepth は参照の展開の指定（expand=references&depth=N）から展開する深さを返します（展開しない場合は0）
+// depthを省略した場合は1とし、範囲の検証はユースケースで行います
+func expandDepth(c echo.Context) (int, error) {
+	expand := queryList(c, "expand")
+	if len(expand) == 0 {
+		if c.QueryParam("depth") != "" {
+			return 0, fmt.Errorf("%w: depthはexpand=referencesと共に指定してください", entity.ErrInvalidParameter)
+		}
+		return 0, nil
+	}
+	for _, name := range expand {
+		if name != "references" {
+			return 0, fmt.Errorf("%w: expand=%s", entity.ErrInvalidParameter, name)
+		}
+	}
+
+	depth, err := queryInt(c, "depth")
+	if err != nil {
+		return 0, err
+	}
+	if c.QueryParam("depth") == "" {
+		depth = 1
+	}
+	return depth, nil
+}
+
+// queryList はカンマ区切りのクエリパラメータを空要素を除いて取得します
+func queryList(c echo.Context, name string) []string {
+	var values []string
+	for _, v := range strings.Split(c.QueryParam(name), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// pathUUID はパスパラメータをUUIDとして取得します
+func pathUUID(c echo.Context, name string) (uuid.UUID, error) {
+	raw := c.Param(name)
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %s=%s", entity.ErrInvalidParameter, name, raw)
+	}
+	return id, nil
 }
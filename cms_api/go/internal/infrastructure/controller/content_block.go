@@ -0,0 +1,186 @@
+package controller
+
+import (
+	usecase "cms_api/internal/usecase/content"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// InsertBlock godoc
+// @Summary ブロックの挿入
+// @Description コンテンツの指定位置にブロックを挿入します。positionを省略した場合は末尾に追加します
+// @Tags content
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "更新元のバージョン (ETag)"
+// @Param block body usecase.InsertBlockInput true "挿入するブロックと位置"
+// @Success 201 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/blocks [post]
+func (cc *ContentController) InsertBlock(c echo.Context) error {
+	contentID, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var input usecase.InsertBlockInput
+	if err := bindJSON(c, &input); err != nil {
+		return handleError(c, err)
+	}
+	if input.Version, err = requestVersion(c, input.Version); err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.InsertBlock(c.Request().Context(), contentID, input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusCreated, content)
+}
+
+// MoveBlock godoc
+// @Summary ブロックの移動
+// @Description ブロックを指定された並び順へ移動し、他のブロックの並び順を詰め直します
+// @Tags content
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param blockId path string true "ブロックID (UUID)"
+// @Param If-Match header string false "更新元のバージョン (ETag)"
+// @Param position body usecase.MoveBlockInput true "移動先の位置 (1始まり)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/blocks/{blockId}/position [put]
+func (cc *ContentController) MoveBlock(c echo.Context) error {
+	contentID, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	blockID, err := pathUUID(c, "blockId")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var input usecase.MoveBlockInput
+	if err := bindJSON(c, &input); err != nil {
+		return handleError(c, err)
+	}
+	if input.Version, err = requestVersion(c, input.Version); err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.MoveBlock(c.Request().Context(), contentID, blockID, input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
+
+// SetBlockVisibility godoc
+// @Summary ブロックの表示切り替え
+// @Description ブロックの表示・非表示を切り替えます
+// @Tags content
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param blockId path string true "ブロックID (UUID)"
+// @Param If-Match header string false "更新元のバージョン (ETag)"
+// @Param visibility body usecase.BlockVisibilityInput true "表示状態"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/blocks/{blockId}/visibility [put]
+func (cc *ContentController) SetBlockVisibility(c echo.Context) error {
+	contentID, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	blockID, err := pathUUID(c, "blockId")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var input usecase.BlockVisibilityInput
+	if err := bindJSON(c, &input); err != nil {
+		return handleError(c, err)
+	}
+	if input.Version, err = requestVersion(c, input.Version); err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.SetBlockVisibility(c.Request().Context(), contentID, blockID, input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
+
+// DeleteBlock godoc
+// @Summary ブロックの削除
+// @Description ブロックを削除し、残りのブロックの並び順を詰め直します。更新元のバージョンをIf-Matchまたはversionクエリで指定します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param blockId path string true "ブロックID (UUID)"
+// @Param If-Match header string false "更新元のバージョン (ETag)"
+// @Param version query int false "更新元のバージョン"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/blocks/{blockId} [delete]
+func (cc *ContentController) DeleteBlock(c echo.Context) error {
+	contentID, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	blockID, err := pathUUID(c, "blockId")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	version, err := queryInt(c, "version")
+	if err != nil {
+		return handleError(c, err)
+	}
+	if version, err = requestVersion(c, version); err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.DeleteBlock(c.Request().Context(), contentID, blockID, version)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
+
+// requestVersion はIf-Matchヘッダーが指定されていればそのバージョンを、なければfallbackを返します
+func requestVersion(c echo.Context, fallback int) (int, error) {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return 0, err
+	}
+	if version > 0 {
+		return version, nil
+	}
+	return fallback, nil
+}
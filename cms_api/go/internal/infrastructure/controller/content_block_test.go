@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// InsertBlockのテスト
+func (s *contentsControllerTestSuite) TestInsertBlock() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		body           string
+		ifMatch        string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+		expectedETag   string
+	}{
+		{
+			name:    "正常系：If-Matchのバージョンでブロックを挿入できる場合",
+			body:    `{"position":2,"block":{"block_type":"text","is_visible":true}}`,
+			ifMatch: `"3"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().InsertBlock(mock.Anything, contentID, mock.MatchedBy(func(in usecase.InsertBlockInput) bool {
+					return in.Version == 3 && in.Position == 2 && in.Block.BlockType == entity.BlockTypeText
+				})).Return(&entity.Content{ID: contentID, Version: 4}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedETag:   `"4"`,
+		},
+		{
+			name:           "異常系：リクエストボディが不正な場合",
+			body:           `{"position":`,
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_FORMAT",
+		},
+		{
+			name: "異常系：バージョンが競合した場合",
+			body: `{"version":2,"block":{"block_type":"text"}}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().InsertBlock(mock.Anything, contentID, mock.Anything).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrVersionConflict, contentID))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "VERSION_CONFLICT",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPost, "/contents/"+contentID.String()+"/blocks", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentID.String())
+
+			assert.NoError(s.T(), s.controller.InsertBlock(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			assert.Equal(s.T(), tc.expectedETag, rec.Header().Get("ETag"))
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// MoveBlockのテスト
+func (s *contentsControllerTestSuite) TestMoveBlock() {
+	contentID := uuid.New()
+	blockID := uuid.New()
+	testCases := []struct {
+		name           string
+		body           string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "正常系：ブロックを移動できる場合",
+			body: `{"version":1,"position":3}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().MoveBlock(mock.Anything, contentID, blockID, usecase.MoveBlockInput{Version: 1, Position: 3}).
+					Return(&entity.Content{ID: contentID, Version: 2}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "異常系：ブロックが存在しない場合",
+			body: `{"version":1,"position":3}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().MoveBlock(mock.Anything, contentID, blockID, mock.Anything).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrBlockNotFound, blockID))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "RESOURCE_NOT_FOUND",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id", "blockId")
+			c.SetParamValues(contentID.String(), blockID.String())
+
+			assert.NoError(s.T(), s.controller.MoveBlock(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// DeleteBlockのテスト
+func (s *contentsControllerTestSuite) TestDeleteBlock() {
+	contentID := uuid.New()
+	blockID := uuid.New()
+	testCases := []struct {
+		name           string
+		query          string
+		ifMatch        string
+		setup          setupFunc
+		expectedStatus int
+	}{
+		{
+			name:  "正常系：versionクエリでブロックを削除できる場合",
+			query: "?version=5",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteBlock(mock.Anything, contentID, blockID, 5).
+					Return(&entity.Content{ID: contentID, Version: 6}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "正常系：If-Matchがversionクエリより優先される場合",
+			query:   "?version=5",
+			ifMatch: `"7"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteBlock(mock.Anything, contentID, blockID, 7).
+					Return(&entity.Content{ID: contentID, Version: 8}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系：versionクエリが数値でない場合",
+			query:          "?version=abc",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodDelete, "/"+tc.query, nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id", "blockId")
+			c.SetParamValues(contentID.String(), blockID.String())
+
+			assert.NoError(s.T(), s.controller.DeleteBlock(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"cms_api/internal/domain/entity"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetReferrers godoc
+// @Summary 参照元のコンテンツ一覧の取得
+// @Description 指定されたIDのコンテンツを参照ブロックで参照しているコンテンツ（ゴミ箱のものを含む）を、参照しているブロックのIDと共にタイトル順に取得します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/referrers [get]
+func (cc *ContentController) GetReferrers(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	referrers, err := cc.contentUsecase.GetReferrers(c.Request().Context(), id)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, referrers)
+}
+
+// referrerPolicy はクエリパラメータforceを参照元の扱いとして読み込みます（省略した場合は参照元があると操作しない）
+func referrerPolicy(c echo.Context) (entity.ReferrerPolicy, error) {
+	policy := entity.ReferrerPolicy(c.QueryParam("force"))
+	if !policy.IsValid() {
+		return "", fmt.Errorf("%w: forceはdetachまたはremoveを指定してください: %s", entity.ErrInvalidParameter, policy)
+	}
+	return policy, nil
+}
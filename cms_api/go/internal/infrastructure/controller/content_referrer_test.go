@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"cms_api/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// GetReferrersのテスト
+func (s *contentsControllerTestSuite) TestGetReferrers() {
+	contentID, referrerID, blockID := uuid.New(), uuid.New(), uuid.New()
+	testCases := []struct {
+		name           string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "正常系：参照元の一覧を取得できる場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetReferrers(mock.Anything, contentID).Return([]entity.Referrer{
+					{ContentID: referrerID, Title: "参照元", Status: entity.ContentStatusPublished, BlockIDs: []uuid.UUID{blockID}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "異常系：コンテンツが存在しない場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetReferrers(mock.Anything, contentID).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrContentNotFound, contentID))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "CONTENT_NOT_FOUND",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/contents/"+contentID.String()+"/referrers", nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentID.String())
+
+			assert.NoError(s.T(), s.controller.GetReferrers(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			body := s.decodeResponse(rec)
+			if tc.expectedCode != "" {
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+				return
+			}
+			referrer := body["data"].([]interface{})[0].(map[string]interface{})
+			assert.Equal(s.T(), referrerID.String(), referrer["content_id"])
+			assert.Equal(s.T(), []interface{}{blockID.String()}, referrer["block_ids"])
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SearchContents godoc
+// @Summary コンテンツの全文検索
+// @Description タイトル・スラッグ・表示中のブロック本文（リッチテキストを含む）を検索し、関連度の高い順に一致箇所の抜粋付きで返します
+// @Tags search
+// @Produce json
+// @Param q query string true "検索キーワード（空白区切り、すべての語を含むコンテンツに絞り込む。全角・半角の違いは区別しない）"
+// @Param limit query int false "取得件数 (1-100)"
+// @Param offset query int false "オフセット (0以上)"
+// @Param status query string false "ステータスフィルタ"
+// @Param category query string false "カテゴリのスラッグまたはID"
+// @Param tags query string false "タグのスラッグまたはID（カンマ区切り）"
+// @Param publishedFrom query string false "公開日時の開始"
+// @Param publishedTo query string false "公開日時の終了"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /search [get]
+func (cc *ContentController) SearchContents(c echo.Context) error {
+	input, err := contentsInput(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+	input.Search = c.QueryParam("q")
+
+	list, err := cc.contentUsecase.SearchContents(c.Request().Context(), input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, list)
+}
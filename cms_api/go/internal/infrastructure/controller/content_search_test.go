@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// SearchContentsのテスト
+func (s *contentsControllerTestSuite) TestSearchContents() {
+	testCases := []struct {
+		name           string
+		query          string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:  "正常系：検索キーワードと絞り込み条件がユースケースに渡る場合",
+			query: "?q=AWS+Lambda&status=published&limit=5",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().SearchContents(mock.Anything, usecase.GetContentsInput{Limit: 5, Status: "published", Search: "AWS Lambda"}).
+					Return(&usecase.SearchList{Hits: []*entity.SearchHit{{Content: &entity.Content{Title: "AWS"}, Snippet: "<mark>AWS</mark>"}}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "異常系：検索キーワードがない場合",
+			query: "",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().SearchContents(mock.Anything, mock.Anything).Return(nil, entity.ErrInvalidParameter)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/search"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+
+			assert.NoError(s.T(), s.controller.SearchContents(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"cms_api/internal/domain/entity"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+)
+
+// slugRedirectResponse は変更前のスラッグが指定された場合のレスポンス（現在のスラッグへの転送先）
+type slugRedirectResponse struct {
+	ContentType string `json:"content_type"`
+	Slug        string `json:"slug"`
+	Location    string `json:"location"`
+}
+
+// GetContentBySlug godoc
+// @Summary スラッグによる公開中のコンテンツの取得
+// @Description コンテンツタイプ名とスラッグで公開中のコンテンツを取得します。下書き・公開予約中・公開終了のコンテンツは404になります。変更前のスラッグの場合は301で現在のスラッグを返します
+// @Tags content
+// @Produce json
+// @Param name path string true "コンテンツタイプ名"
+// @Param slug path string true "スラッグ（日本語はパーセントエンコードする）"
+// @Param expand query string false "展開する関連 (referencesで参照ブロックの公開中の参照先を展開)"
+// @Param depth query int false "参照を展開する深さ (1〜3。デフォルトは1)"
+// @Success 200 {object} apiResponse
+// @Success 301 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/{name}/contents/{slug} [get]
+func (cc *ContentController) GetContentBySlug(c echo.Context) error {
+	name, err := pathString(c, "name")
+	if err != nil {
+		return handleError(c, err)
+	}
+	slug, err := pathString(c, "slug")
+	if err != nil {
+		return handleError(c, err)
+	}
+	depth, err := expandDepth(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.GetContentBySlug(c.Request().Context(), name, slug)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	// 変更前のスラッグ（またはコンテンツタイプ）の場合は現在のURLへ転送する
+	currentName := name
+	if content.ContentType != nil {
+		currentName = content.ContentType.Name
+	}
+	if content.Slug != slug || currentName != name {
+		location := "/content-types/" + url.PathEscape(currentName) + "/contents/" + url.PathEscape(content.Slug)
+		if query := c.QueryString(); query != "" {
+			location += "?" + query
+		}
+		c.Response().Header().Set(echo.HeaderLocation, location)
+		return successResponse(c, http.StatusMovedPermanently, slugRedirectResponse{
+			ContentType: currentName,
+			Slug:        content.Slug,
+			Location:    location,
+		})
+	}
+
+	// 公開向けの取得のため、公開中の参照先のみを展開する
+	if depth > 0 {
+		if err := cc.contentUsecase.ExpandReferences(c.Request().Context(), content, depth, true); err != nil {
+			return handleError(c, err)
+		}
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
+
+// GetSlugRedirects godoc
+// @Summary スラッグのリダイレクト一覧の取得
+// @Description 指定されたIDのコンテンツへのリダイレクト（公開中に変更される前のスラッグ）を新しい順に取得します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/redirects [get]
+func (cc *ContentController) GetSlugRedirects(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	redirects, err := cc.contentUsecase.GetSlugRedirects(c.Request().Context(), id)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, redirects)
+}
+
+// DeleteSlugRedirect godoc
+// @Summary スラッグのリダイレクトの削除
+// @Description 指定されたリダイレクトを削除します。変更前のスラッグは転送されなくなります
+// @Tags content
+// @Param id path string true "コンテンツID (UUID)"
+// @Param redirectId path string true "リダイレクトID (UUID)"
+// @Success 204
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/redirects/{redirectId} [delete]
+func (cc *ContentController) DeleteSlugRedirect(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	redirectID, err := pathUUID(c, "redirectId")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	if err := cc.contentUsecase.DeleteSlugRedirect(c.Request().Context(), id, redirectID); err != nil {
+		return handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// pathString はパスパラメータをデコードして取得します
+func pathString(c echo.Context, name string) (string, error) {
+	raw := c.Param(name)
+	value, err := url.PathUnescape(raw)
+	if err != nil || value == "" {
+		return "", fmt.Errorf("%w: %s=%s", entity.ErrInvalidParameter, name, raw)
+	}
+	return value, nil
+}
@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"cms_api/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// GetContentBySlugのテスト
+func (s *contentsControllerTestSuite) TestGetContentBySlug() {
+	testCases := []struct {
+		name           string
+		slug           string
+		query          string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+		// expectedLocationは301の場合の転送先
+		expectedLocation string
+	}{
+		{
+			name: "正常系：パーセントエンコードされた日本語のスラッグで取得できる場合",
+			slug: "%E5%85%A5%E9%96%80",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentBySlug(mock.Anything, "blog", "入門").
+					Return(&entity.Content{Slug: "入門", Version: 3}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "正常系：変更前のスラッグの場合は301で現在のスラッグを返す場合",
+			slug: "old-slug",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentBySlug(mock.Anything, "blog", "old-slug").
+					Return(&entity.Content{Slug: "入門", ContentType: &entity.ContentType{Name: "blog"}}, nil)
+			},
+			expectedStatus:   http.StatusMovedPermanently,
+			expectedLocation: "/content-types/blog/contents/%E5%85%A5%E9%96%80",
+		},
+		{
+			name: "正常系：コンテンツタイプが変わった場合も301で現在のURLを返す場合",
+			slug: "old-slug",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentBySlug(mock.Anything, "blog", "old-slug").
+					Return(&entity.Content{Slug: "old-slug", ContentType: &entity.ContentType{Name: "page"}}, nil)
+			},
+			expectedStatus:   http.StatusMovedPermanently,
+			expectedLocation: "/content-types/page/contents/old-slug",
+		},
+		{
+			name:  "正常系：公開中の参照先のみを展開する場合",
+			slug:  "入門",
+			query: "?expand=references&depth=2",
+			setup: func(s *contentsControllerTestSuite) {
+				content := &entity.Content{Slug: "入門"}
+				s.mockUsecase.EXPECT().GetContentBySlug(mock.Anything, "blog", "入門").Return(content, nil)
+				s.mockUsecase.EXPECT().ExpandReferences(mock.Anything, content, 2, true).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "正常系：301の転送先にクエリパラメータを引き継ぐ場合",
+			slug:  "old-slug",
+			query: "?expand=references",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentBySlug(mock.Anything, "blog", "old-slug").
+					Return(&entity.Content{Slug: "new-slug", ContentType: &entity.ContentType{Name: "blog"}}, nil)
+			},
+			expectedStatus:   http.StatusMovedPermanently,
+			expectedLocation: "/content-types/blog/contents/new-slug?expand=references",
+		},
+		{
+			name: "異常系：公開中のコンテンツがない場合",
+			slug: "draft-article",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentBySlug(mock.Anything, "blog", "draft-article").
+					Return(nil, fmt.Errorf("%w: blog/draft-article", entity.ErrContentNotFound))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "CONTENT_NOT_FOUND",
+		},
+		{
+			name:           "異常系：スラッグのエンコードが不正な場合",
+			slug:           "%E5%8",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/content-types/blog/contents/x"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("name", "slug")
+			c.SetParamValues("blog", tc.slug)
+
+			assert.NoError(s.T(), s.controller.GetContentBySlug(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			assert.Equal(s.T(), tc.expectedLocation, rec.Header().Get("Location"))
+			if tc.expectedLocation != "" {
+				data := s.decodeResponse(rec)["data"].(map[string]interface{})
+				assert.Equal(s.T(), tc.expectedLocation, data["location"])
+			}
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// GetSlugRedirectsのテスト
+func (s *contentsControllerTestSuite) TestGetSlugRedirects() {
+	contentID := uuid.New()
+	s.mockUsecase.EXPECT().GetSlugRedirects(mock.Anything, contentID).
+		Return([]*entity.SlugRedirect{{ID: uuid.New(), ContentID: contentID, Slug: "old-slug"}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/contents/"+contentID.String()+"/redirects", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(contentID.String())
+
+	assert.NoError(s.T(), s.controller.GetSlugRedirects(c))
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+	data := s.decodeResponse(rec)["data"].([]interface{})
+	assert.Equal(s.T(), "old-slug", data[0].(map[string]interface{})["slug"])
+}
+
+// DeleteSlugRedirectのテスト
+func (s *contentsControllerTestSuite) TestDeleteSlugRedirect() {
+	contentID, redirectID := uuid.New(), uuid.New()
+	testCases := []struct {
+		name           string
+		setup          setupFunc
+		expectedStatus int
+	}{
+		{
+			name: "正常系：リダイレクトを削除できる場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteSlugRedirect(mock.Anything, contentID, redirectID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "異常系：リダイレクトが存在しない場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteSlugRedirect(mock.Anything, contentID, redirectID).
+					Return(fmt.Errorf("%w: %s", entity.ErrSlugRedirectNotFound, redirectID))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodDelete, "/contents/"+contentID.String()+"/redirects/"+redirectID.String(), nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id", "redirectId")
+			c.SetParamValues(contentID.String(), redirectID.String())
+
+			assert.NoError(s.T(), s.controller.DeleteSlugRedirect(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+		})
+	}
+}
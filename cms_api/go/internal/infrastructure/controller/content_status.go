@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PublishContent godoc
+// @Summary コンテンツの公開
+// @Description 下書きのコンテンツを公開し、公開日時を現在時刻に設定します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "現在のバージョン (ETag)"
+// @Param version query int false "現在のバージョン"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/publish [post]
+func (cc *ContentController) PublishContent(c echo.Context) error {
+	return cc.transitionContent(c, entity.ContentActionPublish)
+}
+
+// UnpublishContent godoc
+// @Summary コンテンツの公開停止
+// @Description 公開中またはアーカイブ済みのコンテンツを下書きに戻し、公開日時を解除します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "現在のバージョン (ETag)"
+// @Param version query int false "現在のバージョン"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/unpublish [post]
+func (cc *ContentController) UnpublishContent(c echo.Context) error {
+	return cc.transitionContent(c, entity.ContentActionUnpublish)
+}
+
+// ArchiveContent godoc
+// @Summary コンテンツのアーカイブ
+// @Description 公開中のコンテンツをアーカイブします。他のコンテンツから参照されている場合はforceを指定しない限り409と参照元の一覧を返します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "現在のバージョン (ETag)"
+// @Param version query int false "現在のバージョン"
+// @Param force query string false "参照元がある場合の扱い（detach: 参照元の参照ブロックから参照先を外して非表示にする、remove: 参照元の参照ブロックを削除する）。省略した場合は参照元があると409を返します"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/archive [post]
+func (cc *ContentController) ArchiveContent(c echo.Context) error {
+	return cc.transitionContent(c, entity.ContentActionArchive)
+}
+
+// TrashContent godoc
+// @Summary コンテンツのゴミ箱への移動
+// @Description コンテンツをゴミ箱へ移動します。他のコンテンツから参照されている場合はforceを指定しない限り409と参照元の一覧を返します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "現在のバージョン (ETag)"
+// @Param version query int false "現在のバージョン"
+// @Param force query string false "参照元がある場合の扱い（detach: 参照元の参照ブロックから参照先を外して非表示にする、remove: 参照元の参照ブロックを削除する）。省略した場合は参照元があると409を返します"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/trash [post]
+func (cc *ContentController) TrashContent(c echo.Context) error {
+	return cc.transitionContent(c, entity.ContentActionTrash)
+}
+
+// RestoreContent godoc
+// @Summary コンテンツの復元
+// @Description ゴミ箱のコンテンツを下書きとして復元します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "現在のバージョン (ETag)"
+// @Param version query int false "現在のバージョン"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/restore [post]
+func (cc *ContentController) RestoreContent(c echo.Context) error {
+	return cc.transitionContent(c, entity.ContentActionRestore)
+}
+
+// ScheduleContent godoc
+// @Summary コンテンツの公開予約
+// @Description 下書きの公開予約日時と、下書き・公開中のコンテンツの公開終了日時を設定します。日時を過ぎるとスケジューラーが公開・アーカイブします
+// @Tags content
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "現在のバージョン (ETag)"
+// @Param schedule body usecase.ScheduleContentInput true "公開予約日時と公開終了日時"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/schedule [put]
+func (cc *ContentController) ScheduleContent(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var input usecase.ScheduleContentInput
+	if err := bindJSON(c, &input); err != nil {
+		return handleError(c, err)
+	}
+	if input.Version, err = requestVersion(c, input.Version); err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.ScheduleContent(c.Request().Context(), id, input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
+
+// UnscheduleContent godoc
+// @Summary コンテンツの公開予約の解除
+// @Description 公開予約日時と公開終了日時を解除します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param If-Match header string false "現在のバージョン (ETag)"
+// @Param version query int false "現在のバージョン"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/schedule [delete]
+func (cc *ContentController) UnscheduleContent(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	version, err := queryInt(c, "version")
+	if err != nil {
+		return handleError(c, err)
+	}
+	if version, err = requestVersion(c, version); err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.UnscheduleContent(c.Request().Context(), id, version)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
+
+// transitionContent はパスのコンテンツに状態遷移の操作を適用します
+func (cc *ContentController) transitionContent(c echo.Context, action entity.ContentAction) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	version, err := queryInt(c, "version")
+	if err != nil {
+		return handleError(c, err)
+	}
+	if version, err = requestVersion(c, version); err != nil {
+		return handleError(c, err)
+	}
+	policy, err := referrerPolicy(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.TransitionContent(c.Request().Context(), id, action, version, policy)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// 公開状態を変更するハンドラーのテスト
+func (s *contentsControllerTestSuite) TestTransitionContent() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		handler        func(cc *ContentController) echo.HandlerFunc
+		ifMatch        string
+		query          string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:    "正常系：コンテンツを公開できる場合",
+			handler: func(cc *ContentController) echo.HandlerFunc { return cc.PublishContent },
+			ifMatch: `"2"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().TransitionContent(mock.Anything, contentID, entity.ContentActionPublish, 2, entity.ReferrerPolicyRestrict).
+					Return(&entity.Content{ID: contentID, Status: entity.ContentStatusPublished, Version: 3}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "異常系：許可されていない状態遷移の場合",
+			handler: func(cc *ContentController) echo.HandlerFunc { return cc.ArchiveContent },
+			ifMatch: `"2"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().TransitionContent(mock.Anything, contentID, entity.ContentActionArchive, 2, entity.ReferrerPolicyRestrict).
+					Return(nil, fmt.Errorf("%w: draftのコンテンツにarchiveは実行できません", entity.ErrInvalidStatusTransition))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "INVALID_STATUS_TRANSITION",
+		},
+		{
+			name:    "正常系：参照元の参照ブロックを削除してゴミ箱へ移動できる場合",
+			handler: func(cc *ContentController) echo.HandlerFunc { return cc.TrashContent },
+			ifMatch: `"2"`,
+			query:   "?force=remove",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().TransitionContent(mock.Anything, contentID, entity.ContentActionTrash, 2, entity.ReferrerPolicyRemove).
+					Return(&entity.Content{ID: contentID, Status: entity.ContentStatusTrash, Version: 3}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "異常系：参照元がある場合はアーカイブできない場合",
+			handler: func(cc *ContentController) echo.HandlerFunc { return cc.ArchiveContent },
+			ifMatch: `"2"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().TransitionContent(mock.Anything, contentID, entity.ContentActionArchive, 2, entity.ReferrerPolicyRestrict).
+					Return(nil, &entity.ReferencedError{Referrers: []entity.Referrer{{ContentID: uuid.New()}}})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "CONTENT_REFERENCED",
+		},
+		{
+			name:           "異常系：forceの値が不正な場合",
+			handler:        func(cc *ContentController) echo.HandlerFunc { return cc.ArchiveContent },
+			ifMatch:        `"2"`,
+			query:          "?force=true",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:    "正常系：ゴミ箱から復元できる場合",
+			handler: func(cc *ContentController) echo.HandlerFunc { return cc.RestoreContent },
+			ifMatch: `"5"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().TransitionContent(mock.Anything, contentID, entity.ContentActionRestore, 5, entity.ReferrerPolicyRestrict).
+					Return(&entity.Content{ID: contentID, Status: entity.ContentStatusDraft, Version: 6}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPost, "/"+tc.query, nil)
+			req.Header.Set("If-Match", tc.ifMatch)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentID.String())
+
+			assert.NoError(s.T(), tc.handler(s.controller)(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// ScheduleContentのテスト
+func (s *contentsControllerTestSuite) TestScheduleContent() {
+	contentID := uuid.New()
+	publishAt := time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name           string
+		body           string
+		ifMatch        string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:    "正常系：公開予約日時を設定できる場合",
+			body:    `{"publish_at":"2025-02-01T09:00:00Z"}`,
+			ifMatch: `"2"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().ScheduleContent(mock.Anything, contentID, usecase.ScheduleContentInput{Version: 2, PublishAt: &publishAt}).
+					Return(&entity.Content{ID: contentID, ScheduledAt: &publishAt, Version: 3}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "異常系：バージョンが指定されていない場合",
+			body: `{"publish_at":"2025-02-01T09:00:00Z"}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().ScheduleContent(mock.Anything, contentID, usecase.ScheduleContentInput{PublishAt: &publishAt}).
+					Return(nil, fmt.Errorf("%w: versionは必須です", entity.ErrInvalidParameter))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:           "異常系：日時の形式が不正な場合",
+			body:           `{"publish_at":"tomorrow"}`,
+			ifMatch:        `"2"`,
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentID.String())
+
+			assert.NoError(s.T(), s.controller.ScheduleContent(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
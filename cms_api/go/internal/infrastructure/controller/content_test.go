@@ -1,19 +1,22 @@
 package controller
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
-	"time"
 
-	model "cms_api/internal/domain/entity"
-	"cms_api/internal/usecase/content/mocks"
+	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/controller/mocks"
+	usecase "cms_api/internal/usecase/content"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -21,7 +24,7 @@ type contentsControllerTestSuite struct {
 	suite.Suite
 	echo        *echo.Echo
 	controller  *ContentController
-	mockUsecase *mocks.GetContents
+	mockUsecase *mocks.ContentUsecase
 }
 
 // TestContentsControllerを実行（テストメインエントリーポイント）
@@ -36,7 +39,7 @@ func (s *contentsControllerTestSuite) SetupSuite() {
 
 // 各サブテスト実行前のセットアップ
 func (s *contentsControllerTestSuite) SetupSubTest() {
-	s.mockUsecase = new(mocks.GetContents)
+	s.mockUsecase = mocks.NewContentUsecase(s.T())
 	s.controller = NewContentController(s.mockUsecase)
 }
 
@@ -50,115 +53,520 @@ func (s *contentsControllerTestSuite) setup(fs ...setupFunc) {
 	}
 }
 
+// decodeResponse はレスポンスボディを共通レスポンス形式としてデコードします
+func (s *contentsControllerTestSuite) decodeResponse(rec *httptest.ResponseRecorder) map[string]interface{} {
+	var body map[string]interface{}
+	err := json.Unmarshal(rec.Body.Bytes(), &body)
+	assert.NoError(s.T(), err)
+	return body
+}
+
 // GetContentsのテスト
 func (s *contentsControllerTestSuite) TestGetContents() {
+	contentID := uuid.New()
 	testCases := []struct {
-		name           string
-		setup          setupFunc
-		expectedStatus int
-		expectedBody   []model.Article
-		expectError    bool
+		name            string
+		query           string
+		setup           setupFunc
+		expectedStatus  int
+		expectedCode    string
+		expectedMessage string
 	}{
 		{
-			name: "正常系：コンテンツが正常に取得できる場合",
+			name:  "正常系：コンテンツ一覧が正常に取得できる場合",
+			query: "?limit=10&offset=0&status=published&search=AWS&sort=publishedAt&order=desc&cursor=abc&view=full",
+			setup: func(s *contentsControllerTestSuite) {
+				input := usecase.GetContentsInput{
+					Limit:  10,
+					Offset: 0,
+					Status: "published",
+					Search: "AWS",
+					Sort:   "publishedAt",
+					Order:  "desc",
+					Cursor: "abc",
+					View:   "full",
+				}
+				list := &usecase.ContentList{
+					Contents:   []*entity.Content{{ID: contentID, Title: "テストタイトル"}},
+					Pagination: usecase.Pagination{CurrentPage: 1, PerPage: 10, TotalCount: 1, TotalPages: 1},
+				}
+				s.mockUsecase.EXPECT().GetContents(mock.Anything, input).Return(list, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "正常系：カテゴリとタグで絞り込める場合",
+			query: "?category=aws&tags=go,%20lambda,,",
 			setup: func(s *contentsControllerTestSuite) {
-				contents := []model.Article{
-					{
-						ID:        "1",
-						Title:     "テストタイトル1",
-						Body:      "テスト本文1",
-						CreatedAt: time.Now(),
-						UpdatedAt: time.Now(),
-					},
-					{
-						ID:        "2",
-						Title:     "テストタイトル2",
-						Body:      "テスト本文2",
-						CreatedAt: time.Now(),
-						UpdatedAt: time.Now(),
-					},
+				input := usecase.GetContentsInput{Category: "aws", Tags: []string{"go", "lambda"}}
+				list := &usecase.ContentList{
+					Contents:   []*entity.Content{{ID: contentID, Title: "テストタイトル"}},
+					Pagination: usecase.Pagination{CurrentPage: 1, PerPage: 20, TotalCount: 1, TotalPages: 1},
 				}
-				s.mockUsecase.EXPECT().GetArticles(context.Background()).Return(contents, nil)
+				s.mockUsecase.EXPECT().GetContents(mock.Anything, input).Return(list, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody: []model.Article{
-				{
-					ID:    "1",
-					Title: "テストタイトル1",
-					Body:  "テスト本文1",
-				},
-				{
-					ID:    "2",
-					Title: "テストタイトル2",
-					Body:  "テスト本文2",
-				},
+		},
+		{
+			name:           "異常系：limitが数値でない場合",
+			query:          "?limit=abc",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:  "異常系：ユースケースがパラメータエラーを返す場合",
+			query: "?status=unknown",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContents(mock.Anything, usecase.GetContentsInput{Status: "unknown"}).
+					Return(nil, fmt.Errorf("%w: status=unknown", entity.ErrInvalidParameter))
 			},
-			expectError: false,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
 		},
 		{
-			name: "異常系：コンテンツ取得でエラーが発生する場合",
+			name:  "異常系：コンテンツ取得でエラーが発生する場合",
+			query: "",
 			setup: func(s *contentsControllerTestSuite) {
-				s.mockUsecase.EXPECT().GetArticles(context.Background()).Return(nil, errors.New("取得エラー"))
+				s.mockUsecase.EXPECT().GetContents(mock.Anything, usecase.GetContentsInput{}).
+					Return(nil, errors.New("取得エラー"))
 			},
-			expectedStatus: http.StatusInternalServerError,
-			expectedBody:   nil,
-			expectError:    true,
+			expectedStatus:  http.StatusInternalServerError,
+			expectedCode:    "INTERNAL_ERROR",
+			expectedMessage: "内部サーバーエラーが発生しました",
 		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			// サブテストのセットアップ
-			s.SetupSubTest()
-
 			// テストケース固有のセットアップを実行
 			s.setup(tc.setup)
 
 			// リクエストとレコーダーを作成
-			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req := httptest.NewRequest(http.MethodGet, "/contents"+tc.query, nil)
 			rec := httptest.NewRecorder()
 			c := s.echo.NewContext(req, rec)
 
 			// テスト対象のメソッドを実行
-			err := s.controller.GetContent(c)
+			err := s.controller.GetContents(c)
 
 			// アサーション
-			if tc.expectError {
-				// エラーケースのレスポンスを検証
-				assert.Equal(s.T(), tc.expectedStatus, rec.Code)
-
-				// JSONレスポンスがエラーを含むか検証
-				var responseBody map[string]interface{}
-				err := json.Unmarshal(rec.Body.Bytes(), &responseBody)
-				assert.NoError(s.T(), err)
-				assert.Contains(s.T(), responseBody, "error")
-			} else {
-				// 正常系のレスポンスを検証
-				assert.NoError(s.T(), err)
-				assert.Equal(s.T(), tc.expectedStatus, rec.Code)
-
-				// レスポンスボディを検証
-				if tc.expectedBody != nil {
-					var responseContents []model.Article
-					err := json.Unmarshal(rec.Body.Bytes(), &responseContents)
-					assert.NoError(s.T(), err)
-
-					// 時間フィールドは動的に生成されるため、比較から除外する
-					for i := range responseContents {
-						responseContents[i].CreatedAt = time.Time{}
-						responseContents[i].UpdatedAt = time.Time{}
-					}
-					for i := range tc.expectedBody {
-						tc.expectedBody[i].CreatedAt = time.Time{}
-						tc.expectedBody[i].UpdatedAt = time.Time{}
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+
+			body := s.decodeResponse(rec)
+			if tc.expectedCode != "" {
+				assert.Equal(s.T(), false, body["success"])
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+				if tc.expectedMessage != "" {
+					assert.Equal(s.T(), tc.expectedMessage, body["error"].(map[string]interface{})["message"])
+				}
+				return
+			}
+
+			assert.Equal(s.T(), true, body["success"])
+			data := body["data"].(map[string]interface{})
+			assert.Len(s.T(), data["contents"], 1)
+			assert.Equal(s.T(), float64(1), data["pagination"].(map[string]interface{})["totalCount"])
+		})
+	}
+}
+
+// GetContentByIDのテスト
+func (s *contentsControllerTestSuite) TestGetContentByID() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		id             string
+		query          string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "正常系：コンテンツが正常に取得できる場合",
+			id:   contentID.String(),
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentByID(mock.Anything, contentID).
+					Return(&entity.Content{ID: contentID, Title: "テストタイトル"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "正常系：参照先を指定した深さまで展開する場合",
+			id:    contentID.String(),
+			query: "?expand=references&depth=2",
+			setup: func(s *contentsControllerTestSuite) {
+				content := &entity.Content{ID: contentID}
+				s.mockUsecase.EXPECT().GetContentByID(mock.Anything, contentID).Return(content, nil)
+				s.mockUsecase.EXPECT().ExpandReferences(mock.Anything, content, 2, false).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "正常系：深さを省略した場合は1段階のみ展開する場合",
+			id:    contentID.String(),
+			query: "?expand=references",
+			setup: func(s *contentsControllerTestSuite) {
+				content := &entity.Content{ID: contentID}
+				s.mockUsecase.EXPECT().GetContentByID(mock.Anything, contentID).Return(content, nil)
+				s.mockUsecase.EXPECT().ExpandReferences(mock.Anything, content, 1, false).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系：展開する関連が不明な場合",
+			id:             contentID.String(),
+			query:          "?expand=tags",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:           "異常系：expandを指定せずにdepthを指定した場合",
+			id:             contentID.String(),
+			query:          "?depth=2",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:  "異常系：深さが範囲外の場合",
+			id:    contentID.String(),
+			query: "?expand=references&depth=10",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentByID(mock.Anything, contentID).Return(&entity.Content{ID: contentID}, nil)
+				s.mockUsecase.EXPECT().ExpandReferences(mock.Anything, mock.Anything, 10, false).
+					Return(fmt.Errorf("%w: depth=10", entity.ErrInvalidParameter))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:           "異常系：IDがUUID形式でない場合",
+			id:             "invalid-id",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name: "異常系：コンテンツが存在しない場合",
+			id:   contentID.String(),
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentByID(mock.Anything, contentID).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrContentNotFound, contentID))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "CONTENT_NOT_FOUND",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/contents/"+tc.id+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tc.id)
+
+			err := s.controller.GetContentByID(c)
+
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+
+			body := s.decodeResponse(rec)
+			if tc.expectedCode != "" {
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+				return
+			}
+
+			data := body["data"].(map[string]interface{})
+			assert.Equal(s.T(), contentID.String(), data["id"])
+		})
+	}
+}
+
+// CreateContentのテスト
+func (s *contentsControllerTestSuite) TestCreateContent() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		body           string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+		// expectedDetailsはエラーレスポンスのdetailsに含まれる違反の項目
+		expectedDetails []string
+	}{
+		{
+			name: "正常系：コンテンツが作成できる場合",
+			body: `{"title":"新規記事","slug":"new-article","author_id":"admin","blocks":[{"block_type":"text","data":{"data_type":"text","content_text":"本文"}}]}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().CreateContent(mock.Anything, mock.MatchedBy(func(c *entity.Content) bool {
+					return c.Title == "新規記事" && len(c.Blocks) == 1 && c.Blocks[0].Data.ContentText == "本文"
+				})).Return(&entity.Content{ID: contentID, Title: "新規記事"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "異常系：JSONとして不正なボディの場合",
+			body:           `{"title":`,
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_FORMAT",
+		},
+		{
+			name: "異常系：バリデーションエラーの場合",
+			body: `{"slug":"new-article"}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().CreateContent(mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("%w: タイトルは必須です", entity.ErrInvalidParameter))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name: "異常系：フィールドの定義に違反する場合は違反の一覧を返す",
+			body: `{"title":"新規記事","blocks":[{"block_type":"reference"},{"block_type":"video"}]}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().CreateContent(mock.Anything, mock.Anything).
+					Return(nil, entity.ValidationErrors{
+						{Field: "blocks[0].data.referenced_content_id", Code: entity.ViolationRequired, Message: "参照先のコンテンツは必須です"},
+						{Field: "blocks[1].block_type", Code: entity.ViolationNotAllowed, Message: "このコンテンツタイプでは使えないブロックの種類です: video"},
+					})
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedCode:    "INVALID_PARAMETER",
+			expectedDetails: []string{"blocks[0].data.referenced_content_id", "blocks[1].block_type"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPost, "/contents", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+
+			err := s.controller.CreateContent(c)
+
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+
+			body := s.decodeResponse(rec)
+			if tc.expectedCode != "" {
+				apiErr := body["error"].(map[string]interface{})
+				assert.Equal(s.T(), tc.expectedCode, apiErr["code"])
+				if tc.expectedDetails != nil {
+					var fields []string
+					for _, detail := range apiErr["details"].([]interface{}) {
+						fields = append(fields, detail.(map[string]interface{})["field"].(string))
 					}
+					assert.Equal(s.T(), tc.expectedDetails, fields)
+				}
+				return
+			}
+
+			data := body["data"].(map[string]interface{})
+			assert.Equal(s.T(), contentID.String(), data["id"])
+		})
+	}
+}
+
+// PatchContentのテスト
+func (s *contentsControllerTestSuite) TestPatchContent() {
+	s.Run("正常系：送信したフィールドのみがユースケースへ渡される場合", func() {
+		contentID := uuid.New()
+		title := "部分更新"
+		s.mockUsecase.EXPECT().PatchContent(mock.Anything, contentID, usecase.PatchContentInput{Title: &title}).
+			Return(&entity.Content{ID: contentID, Title: title}, nil)
+
+		req := httptest.NewRequest(http.MethodPatch, "/contents/"+contentID.String(), strings.NewReader(`{"title":"部分更新"}`))
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(contentID.String())
+
+		assert.NoError(s.T(), s.controller.PatchContent(c))
+		assert.Equal(s.T(), http.StatusOK, rec.Code)
+	})
+}
+
+// DeleteContentのテスト
+func (s *contentsControllerTestSuite) TestDeleteContent() {
+	contentID := uuid.New()
+	referrerID := uuid.New()
+	testCases := []struct {
+		name              string
+		ifMatch           string
+		query             string
+		setup             setupFunc
+		expectedStatus    int
+		expectedReferrers []string
+	}{
+		{
+			name:  "正常系：versionクエリのバージョンでゴミ箱へ移動できる場合",
+			query: "?version=5",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteContent(mock.Anything, contentID, 5, entity.ReferrerPolicyRestrict).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:    "正常系：If-Matchのバージョンでゴミ箱へ移動できる場合",
+			ifMatch: `"3"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteContent(mock.Anything, contentID, 3, entity.ReferrerPolicyRestrict).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:  "正常系：参照元の参照ブロックから参照先を外してゴミ箱へ移動できる場合",
+			query: "?version=5&force=detach",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteContent(mock.Anything, contentID, 5, entity.ReferrerPolicyDetach).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "異常系：バージョンを省略した場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteContent(mock.Anything, contentID, 0, entity.ReferrerPolicyRestrict).
+					Return(fmt.Errorf("%w: versionは必須です", entity.ErrInvalidParameter))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "異常系：参照元がある場合は参照元の一覧を返す場合",
+			query: "?version=5",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteContent(mock.Anything, contentID, 5, entity.ReferrerPolicyRestrict).
+					Return(&entity.ReferencedError{Referrers: []entity.Referrer{
+						{ContentID: referrerID, Title: "参照元", BlockIDs: []uuid.UUID{uuid.New()}},
+					}})
+			},
+			expectedStatus:    http.StatusConflict,
+			expectedReferrers: []string{referrerID.String()},
+		},
+		{
+			name:           "異常系：forceの値が不正な場合",
+			query:          "?version=5&force=cascade",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "異常系：削除対象が存在しない場合",
+			query: "?version=5",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteContent(mock.Anything, contentID, 5, entity.ReferrerPolicyRestrict).
+					Return(fmt.Errorf("%w: %s", entity.ErrContentNotFound, contentID))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:  "異常系：既にゴミ箱にある場合",
+			query: "?version=5",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteContent(mock.Anything, contentID, 5, entity.ReferrerPolicyRestrict).
+					Return(fmt.Errorf("%w: trashのコンテンツにtrashは実行できません", entity.ErrInvalidStatusTransition))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodDelete, "/contents/"+contentID.String()+tc.query, nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentID.String())
 
-					assert.Equal(s.T(), tc.expectedBody, responseContents)
+			assert.NoError(s.T(), s.controller.DeleteContent(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedReferrers != nil {
+				apiErr := s.decodeResponse(rec)["error"].(map[string]interface{})
+				assert.Equal(s.T(), "CONTENT_REFERENCED", apiErr["code"])
+				var ids []string
+				for _, referrer := range apiErr["details"].([]interface{}) {
+					ids = append(ids, referrer.(map[string]interface{})["content_id"].(string))
 				}
+				assert.Equal(s.T(), tc.expectedReferrers, ids)
 			}
+		})
+	}
+}
+
+// UpdateContentのテスト
+func (s *contentsControllerTestSuite) TestUpdateContent() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		ifMatch        string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+		expectedETag   string
+	}{
+		{
+			name:    "正常系：If-Matchのバージョンで更新できる場合",
+			ifMatch: `"3"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().UpdateContent(mock.Anything, contentID, mock.MatchedBy(func(c *entity.Content) bool {
+					return c.Version == 3
+				})).Return(&entity.Content{ID: contentID, Version: 4}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedETag:   `"4"`,
+		},
+		{
+			name:           "異常系：If-Matchが不正な形式の場合",
+			ifMatch:        "abc",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:    "異常系：バージョンが競合した場合",
+			ifMatch: `W/"2"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().UpdateContent(mock.Anything, contentID, mock.Anything).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrVersionConflict, contentID))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "VERSION_CONFLICT",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPut, "/contents/"+contentID.String(), strings.NewReader(`{"title":"更新","version":1}`))
+			req.Header.Set("If-Match", tc.ifMatch)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentID.String())
+
+			assert.NoError(s.T(), s.controller.UpdateContent(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
 
-			// モックの検証
-			s.mockUsecase.AssertExpectations(s.T())
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+				return
+			}
+			assert.Equal(s.T(), tc.expectedETag, rec.Header().Get("ETag"))
 		})
 	}
 }
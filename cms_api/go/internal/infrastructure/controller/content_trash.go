@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"cms_api/internal/domain/entity"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetTrashedContents godoc
+// @Summary ゴミ箱のコンテンツ一覧の取得
+// @Description ゴミ箱へ移動したコンテンツ一覧をページネーション付きで取得します（デフォルトはゴミ箱へ移動した日時の新しい順）
+// @Tags trash
+// @Produce json
+// @Param limit query int false "取得件数 (1-100)"
+// @Param offset query int false "オフセット (0以上)"
+// @Param search query string false "検索キーワード"
+// @Param sort query string false "ソート対象 (createdAt, updatedAt, publishedAt, title, deletedAt。カンマ区切りで複数指定可)"
+// @Param order query string false "ソート順 (asc, desc)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /trash [get]
+func (cc *ContentController) GetTrashedContents(c echo.Context) error {
+	input, err := contentsInput(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	list, err := cc.contentUsecase.GetTrashedContents(c.Request().Context(), input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, list)
+}
+
+// PurgeContent godoc
+// @Summary ゴミ箱のコンテンツの完全削除
+// @Description ゴミ箱のコンテンツをブロック・版と共に完全に削除します。ゴミ箱以外のコンテンツは削除できません。ゴミ箱にないコンテンツから参照されている場合はforceを指定しない限り409と参照元の一覧を返します
+// @Tags trash
+// @Param id path string true "コンテンツID (UUID)"
+// @Param force query string false "参照元がある場合の扱い（detach: 参照元の参照ブロックから参照先を外して非表示にする、remove: 参照元の参照ブロックを削除する）。省略した場合は参照元があると409を返します"
+// @Success 204
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /trash/{id} [delete]
+func (cc *ContentController) PurgeContent(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	policy, err := referrerPolicy(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	if err := cc.contentUsecase.PurgeContent(c.Request().Context(), id, policy); err != nil {
+		return handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PurgeTrash godoc
+// @Summary ゴミ箱の一括完全削除
+// @Description ゴミ箱へ移動してから指定日数以上経過したコンテンツを完全に削除します。日数を省略した場合はゴミ箱を空にします
+// @Tags trash
+// @Produce json
+// @Param olderThanDays query int false "ゴミ箱へ移動してからの経過日数 (0以上)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /trash [delete]
+func (cc *ContentController) PurgeTrash(c echo.Context) error {
+	days, err := queryInt(c, "olderThanDays")
+	if err != nil {
+		return handleError(c, err)
+	}
+	if days < 0 {
+		return handleError(c, fmt.Errorf("%w: olderThanDays=%d", entity.ErrInvalidParameter, days))
+	}
+
+	result, err := cc.contentUsecase.PurgeTrash(c.Request().Context(), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, result)
+}
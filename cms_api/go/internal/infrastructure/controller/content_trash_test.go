@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// GetTrashedContentsのテスト
+func (s *contentsControllerTestSuite) TestGetTrashedContents() {
+	s.Run("正常系：ゴミ箱のコンテンツ一覧を取得できる場合", func() {
+		s.setup(func(s *contentsControllerTestSuite) {
+			s.mockUsecase.EXPECT().GetTrashedContents(mock.Anything, usecase.GetContentsInput{Limit: 10, Sort: "deletedAt", Order: "asc"}).
+				Return(&usecase.ContentList{Contents: []*entity.Content{}}, nil)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/trash?limit=10&sort=deletedAt&order=asc", nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+
+		assert.NoError(s.T(), s.controller.GetTrashedContents(c))
+		assert.Equal(s.T(), http.StatusOK, rec.Code)
+	})
+}
+
+// PurgeContentのテスト
+func (s *contentsControllerTestSuite) TestPurgeContent() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		query          string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "正常系：ゴミ箱のコンテンツを完全に削除できる場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().PurgeContent(mock.Anything, contentID, entity.ReferrerPolicyRestrict).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:  "正常系：参照元の参照ブロックを削除して完全に削除できる場合",
+			query: "?force=remove",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().PurgeContent(mock.Anything, contentID, entity.ReferrerPolicyRemove).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "異常系：参照元がある場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().PurgeContent(mock.Anything, contentID, entity.ReferrerPolicyRestrict).
+					Return(&entity.ReferencedError{Referrers: []entity.Referrer{{ContentID: uuid.New()}}})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "CONTENT_REFERENCED",
+		},
+		{
+			name: "異常系：ゴミ箱にないコンテンツの場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().PurgeContent(mock.Anything, contentID, entity.ReferrerPolicyRestrict).
+					Return(fmt.Errorf("%w: publishedのコンテンツは完全に削除できません", entity.ErrInvalidStatusTransition))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "INVALID_STATUS_TRANSITION",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodDelete, "/"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentID.String())
+
+			assert.NoError(s.T(), s.controller.PurgeContent(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// PurgeTrashのテスト
+func (s *contentsControllerTestSuite) TestPurgeTrash() {
+	testCases := []struct {
+		name           string
+		query          string
+		setup          setupFunc
+		expectedStatus int
+	}{
+		{
+			name:  "正常系：経過日数を指定して削除できる場合",
+			query: "?olderThanDays=30",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().PurgeTrash(mock.Anything, 30*24*time.Hour).
+					Return(&usecase.PurgeResult{Purged: []uuid.UUID{uuid.New()}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "正常系：経過日数を省略してゴミ箱を空にできる場合",
+			query: "",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().PurgeTrash(mock.Anything, time.Duration(0)).
+					Return(&usecase.PurgeResult{Purged: []uuid.UUID{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系：経過日数が負の場合",
+			query:          "?olderThanDays=-1",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodDelete, "/trash"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+
+			assert.NoError(s.T(), s.controller.PurgeTrash(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package controller
+
+import (
+	"cms_api/internal/domain/entity"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetContentTypes godoc
+// @Summary コンテンツタイプ一覧の取得
+// @Description コンテンツタイプ一覧をフィールドの定義と共に表示名順に取得します
+// @Tags content-types
+// @Produce json
+// @Param includeInactive query bool false "無効なコンテンツタイプも含める (デフォルトはfalse)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types [get]
+func (cc *ContentController) GetContentTypes(c echo.Context) error {
+	includeInactive, err := queryBool(c, "includeInactive")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	list, err := cc.contentUsecase.GetContentTypes(c.Request().Context(), includeInactive)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, list)
+}
+
+// GetContentTypeByID godoc
+// @Summary コンテンツタイプの取得
+// @Description 指定されたIDの有効なコンテンツタイプを取得します
+// @Tags content-types
+// @Produce json
+// @Param id path string true "コンテンツタイプID (UUID)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/{id} [get]
+func (cc *ContentController) GetContentTypeByID(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	result, err := cc.contentUsecase.GetContentTypeByID(c.Request().Context(), id)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, result)
+}
+
+// CreateContentType godoc
+// @Summary コンテンツタイプの作成
+// @Description コンテンツタイプをフィールドの定義と共に作成します。名前は一意で、作成後は変更できません
+// @Tags content-types
+// @Accept json
+// @Produce json
+// @Param contentType body entity.ContentType true "作成するコンテンツタイプ"
+// @Success 201 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types [post]
+func (cc *ContentController) CreateContentType(c echo.Context) error {
+	var contentType entity.ContentType
+	if err := bindJSON(c, &contentType); err != nil {
+		return handleError(c, err)
+	}
+
+	created, err := cc.contentUsecase.CreateContentType(c.Request().Context(), &contentType)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusCreated, created)
+}
+
+// UpdateContentType godoc
+// @Summary コンテンツタイプの更新
+// @Description 指定されたIDのコンテンツタイプの表示名・説明・アイコン・フィールドの定義を更新します。名前と作成者は変更できません。既存のコンテンツが沿わなくなるフィールドの定義の変更は、POST /content-types/{id}/migrations で行います
+// @Tags content-types
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツタイプID (UUID)"
+// @Param contentType body entity.ContentType true "更新後のコンテンツタイプ"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/{id} [put]
+func (cc *ContentController) UpdateContentType(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var contentType entity.ContentType
+	if err := bindJSON(c, &contentType); err != nil {
+		return handleError(c, err)
+	}
+
+	updated, err := cc.contentUsecase.UpdateContentType(c.Request().Context(), id, &contentType)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, updated)
+}
+
+// DeactivateContentType godoc
+// @Summary コンテンツタイプの無効化
+// @Description 指定されたIDのコンテンツタイプを無効化します。コンテンツは削除されませんが、新規作成・更新とスラッグでの取得はできなくなります
+// @Tags content-types
+// @Param id path string true "コンテンツタイプID (UUID)"
+// @Success 204
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/{id} [delete]
+func (cc *ContentController) DeactivateContentType(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	if err := cc.contentUsecase.DeactivateContentType(c.Request().Context(), id); err != nil {
+		return handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ActivateContentType godoc
+// @Summary コンテンツタイプの有効化
+// @Description 無効化したコンテンツタイプを再び有効化します
+// @Tags content-types
+// @Produce json
+// @Param id path string true "コンテンツタイプID (UUID)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/{id}/activate [post]
+func (cc *ContentController) ActivateContentType(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	result, err := cc.contentUsecase.ActivateContentType(c.Request().Context(), id)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, result)
+}
+
+// GetContentTypeSchemaVersions godoc
+// @Summary フィールドの定義の版一覧の取得
+// @Description 指定されたIDのコンテンツタイプのフィールドの定義の版を新しい順に取得します
+// @Tags content-types
+// @Produce json
+// @Param id path string true "コンテンツタイプID (UUID)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/{id}/schema-versions [get]
+func (cc *ContentController) GetContentTypeSchemaVersions(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	versions, err := cc.contentUsecase.GetContentTypeSchemaVersions(c.Request().Context(), id)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, versions)
+}
+
+// MigrateContentType godoc
+// @Summary フィールドの定義の移行
+// @Description フィールドの定義を変更し、既存のコンテンツのブロックを書き換え手順に従って書き換えます。dryRunを指定した場合は変更せずに影響を受けるコンテンツを返します。書き換えた後もフィールドの定義に沿わないコンテンツがある場合は移行しません
+// @Tags content-types
+// @Accept json
+// @Produce json
+// @Param id path string true "コンテンツタイプID (UUID)"
+// @Param dryRun query bool false "影響を受けるコンテンツの確認のみ行う (デフォルトはfalse)"
+// @Param migration body entity.SchemaMigration true "移行元の版・移行後のフィールドの定義・書き換え手順"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/{id}/migrations [post]
+func (cc *ContentController) MigrateContentType(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	dryRun, err := queryBool(c, "dryRun")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var migration entity.SchemaMigration
+	if err := bindJSON(c, &migration); err != nil {
+		return handleError(c, err)
+	}
+
+	report, err := cc.contentUsecase.MigrateContentType(c.Request().Context(), id, migration, dryRun)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, report)
+}
+
+// ExportContentTypes godoc
+// @Summary コンテンツタイプの定義のエクスポート
+// @Description 無効なものを含むすべてのコンテンツタイプの定義を、名前順にYAMLまたはJSONのファイルとして出力します
+// @Tags content-types
+// @Produce application/yaml,json
+// @Param format query string false "ファイルの形式 (yaml, json。デフォルトはyaml)"
+// @Success 200 {object} entity.ContentTypeDefinitions
+// @Failure 400 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/export [get]
+func (cc *ContentController) ExportContentTypes(c echo.Context) error {
+	format, err := entity.ParseDefinitionFormat(c.QueryParam("format"))
+	if err != nil {
+		return handleError(c, fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err))
+	}
+
+	definitions, err := cc.contentUsecase.ExportContentTypes(c.Request().Context())
+	if err != nil {
+		return handleError(c, err)
+	}
+	data, err := definitions.Marshal(format)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	contentType := "application/yaml"
+	if format == entity.DefinitionFormatJSON {
+		contentType = echo.MIMEApplicationJSONCharsetUTF8
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="content-types.%s"`, format))
+	return c.Blob(http.StatusOK, contentType, data)
+}
+
+// ImportContentTypes godoc
+// @Summary コンテンツタイプの定義のインポート
+// @Description YAMLまたはJSONのファイルのコンテンツタイプを名前で対応付けて作成・更新し、作成・更新・変更なしのコンテンツタイプ名を返します。同じファイルを何度インポートしても結果は変わりません。既存のコンテンツが沿わなくなるフィールドの定義の変更を含む場合は、何も変更しません
+// @Tags content-types
+// @Accept application/yaml,json
+// @Produce json
+// @Param format query string false "ファイルの形式 (yaml, json。省略時はContent-Typeがjsonの場合はjson、それ以外はyaml)"
+// @Param dryRun query bool false "変更せずに結果の見込みのみを返す (デフォルトはfalse)"
+// @Param definitions body entity.ContentTypeDefinitions true "コンテンツタイプの定義"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /content-types/import [post]
+func (cc *ContentController) ImportContentTypes(c echo.Context) error {
+	name := c.QueryParam("format")
+	if name == "" && strings.Contains(c.Request().Header.Get(echo.HeaderContentType), "json") {
+		name = string(entity.DefinitionFormatJSON)
+	}
+	format, err := entity.ParseDefinitionFormat(name)
+	if err != nil {
+		return handleError(c, fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err))
+	}
+	dryRun, err := queryBool(c, "dryRun")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	data, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return handleError(c, fmt.Errorf("%w: %v", errInvalidFormat, err))
+	}
+	definitions, err := entity.UnmarshalContentTypeDefinitions(data, format)
+	if err != nil {
+		return handleError(c, fmt.Errorf("%w: %v", errInvalidFormat, err))
+	}
+
+	report, err := cc.contentUsecase.ImportContentTypes(c.Request().Context(), definitions, dryRun)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, report)
+}
+
+// queryBool は真偽値のクエリパラメータを取得します（未指定の場合はfalse）
+func queryBool(c echo.Context, name string) (bool, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return false, nil
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s=%s", entity.ErrInvalidParameter, name, raw)
+	}
+	return v, nil
+}
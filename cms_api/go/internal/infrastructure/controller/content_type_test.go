@@ -0,0 +1,369 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// GetContentTypesのテスト
+func (s *contentsControllerTestSuite) TestGetContentTypes() {
+	testCases := []struct {
+		name           string
+		query          string
+		setup          setupFunc
+		expectedStatus int
+	}{
+		{
+			name:  "正常系：有効なコンテンツタイプのみを取得する場合",
+			query: "",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentTypes(mock.Anything, false).
+					Return([]*entity.ContentType{{Name: "blog_post"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "正常系：無効なコンテンツタイプも含める場合",
+			query: "?includeInactive=true",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentTypes(mock.Anything, true).
+					Return([]*entity.ContentType{{Name: "blog_post"}, {Name: "legacy"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系：真偽値でない場合",
+			query:          "?includeInactive=maybe",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/content-types"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+
+			assert.NoError(s.T(), s.controller.GetContentTypes(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+// CreateContentTypeのテスト
+func (s *contentsControllerTestSuite) TestCreateContentType() {
+	testCases := []struct {
+		name           string
+		body           string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "正常系：フィールドの定義と共に作成できる場合",
+			body: `{"name":"event","display_name":"イベント","created_by":"admin",
+				"field_schema":{"fields":[{"block_type":"text","label":"概要","required":true,"max_length":200}],"ordered":true}}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().CreateContentType(mock.Anything, mock.MatchedBy(func(ct *entity.ContentType) bool {
+					field, ok := ct.FieldSchema.Field(entity.BlockTypeText)
+					return ct.Name == "event" && ct.FieldSchema.Ordered && ok && field.Required && field.MaxLength == 200
+				})).Return(&entity.ContentType{ID: uuid.New(), Name: "event"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "異常系：名前が重複する場合",
+			body: `{"name":"blog_post","display_name":"ブログ記事","created_by":"admin"}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().CreateContentType(mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("同じ名前のコンテンツタイプが%w: blog_post", entity.ErrAlreadyExists))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "ALREADY_EXISTS",
+		},
+		{
+			name:           "異常系：JSONが不正な場合",
+			body:           `{"name":`,
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_FORMAT",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPost, "/content-types", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+
+			assert.NoError(s.T(), s.controller.CreateContentType(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// DeactivateContentTypeのテスト
+func (s *contentsControllerTestSuite) TestDeactivateContentType() {
+	contentTypeID := uuid.New()
+	testCases := []struct {
+		name           string
+		setup          setupFunc
+		expectedStatus int
+	}{
+		{
+			name: "正常系：無効化できる場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeactivateContentType(mock.Anything, contentTypeID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "異常系：コンテンツタイプが存在しない場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeactivateContentType(mock.Anything, contentTypeID).
+					Return(fmt.Errorf("%w: %s", entity.ErrContentTypeNotFound, contentTypeID))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodDelete, "/content-types/"+contentTypeID.String(), nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentTypeID.String())
+
+			assert.NoError(s.T(), s.controller.DeactivateContentType(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+// MigrateContentTypeのテスト
+func (s *contentsControllerTestSuite) TestMigrateContentType() {
+	contentTypeID := uuid.New()
+	body := `{"schema_version":2,"field_schema":{"fields":[{"block_type":"richtext","label":"本文"}]},"steps":[{"op":"change_type","block_type":"text","to":"richtext"}]}`
+	testCases := []struct {
+		name           string
+		query          string
+		body           string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:  "正常系：ドライランで影響を受けるコンテンツを返す場合",
+			query: "?dryRun=true",
+			body:  body,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().MigrateContentType(mock.Anything, contentTypeID, mock.MatchedBy(func(m entity.SchemaMigration) bool {
+					return m.SchemaVersion == 2 && len(m.Steps) == 1 && m.Steps[0].To == entity.BlockTypeRichText
+				}), true).Return(&usecase.SchemaMigrationReport{DryRun: true, FromVersion: 2, ToVersion: 3, AffectedCount: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "異常系：移行元の版が最新ではない場合",
+			body: body,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().MigrateContentType(mock.Anything, contentTypeID, mock.Anything, false).
+					Return(nil, fmt.Errorf("%w: schema_version=2", entity.ErrVersionConflict))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "VERSION_CONFLICT",
+		},
+		{
+			name:           "異常系：dryRunの値が不正な場合",
+			query:          "?dryRun=maybe",
+			body:           body,
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPost, "/content-types/"+contentTypeID.String()+"/migrations"+tc.query, strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(contentTypeID.String())
+
+			assert.NoError(s.T(), s.controller.MigrateContentType(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+
+			body := s.decodeResponse(rec)
+			if tc.expectedCode != "" {
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+				return
+			}
+			data := body["data"].(map[string]interface{})
+			assert.Equal(s.T(), true, data["dry_run"])
+			assert.Equal(s.T(), float64(1), data["affected_count"])
+		})
+	}
+}
+
+// ExportContentTypesのテスト
+func (s *contentsControllerTestSuite) TestExportContentTypes() {
+	definitions := &entity.ContentTypeDefinitions{ContentTypes: []entity.ContentTypeDefinition{
+		{Name: "blog_post", DisplayName: "ブログ記事"},
+	}}
+	testCases := []struct {
+		name                string
+		query               string
+		setup               setupFunc
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name: "正常系：デフォルトはYAMLのファイルとして出力する場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().ExportContentTypes(mock.Anything).Return(definitions, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/yaml",
+			expectedBody:        "content_types:\n  - name: blog_post\n",
+		},
+		{
+			name:  "正常系：JSONのファイルとして出力する場合",
+			query: "?format=json",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().ExportContentTypes(mock.Anything).Return(definitions, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: echo.MIMEApplicationJSON,
+			expectedBody:        `"name": "blog_post"`,
+		},
+		{
+			name:                "異常系：形式が不正な場合",
+			query:               "?format=xml",
+			setup:               func(s *contentsControllerTestSuite) {},
+			expectedStatus:      http.StatusBadRequest,
+			expectedContentType: echo.MIMEApplicationJSON,
+			expectedBody:        "INVALID_PARAMETER",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/content-types/export"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+
+			assert.NoError(s.T(), s.controller.ExportContentTypes(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			assert.Contains(s.T(), rec.Header().Get(echo.HeaderContentType), tc.expectedContentType)
+			assert.Contains(s.T(), rec.Body.String(), tc.expectedBody)
+		})
+	}
+}
+
+// ImportContentTypesのテスト
+func (s *contentsControllerTestSuite) TestImportContentTypes() {
+	yamlBody := "content_types:\n  - name: news\n    display_name: お知らせ\n    field_schema:\n      fields: []\n"
+	testCases := []struct {
+		name           string
+		query          string
+		contentType    string
+		body           string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:        "正常系：YAMLの定義をインポートする場合",
+			contentType: "application/yaml",
+			body:        yamlBody,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().ImportContentTypes(mock.Anything, mock.MatchedBy(func(d *entity.ContentTypeDefinitions) bool {
+					return len(d.ContentTypes) == 1 && d.ContentTypes[0].Name == "news"
+				}), false).Return(&usecase.ContentTypeImportReport{Created: []string{"news"}, Updated: []string{}, Unchanged: []string{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "正常系：Content-TypeからJSONと判定してドライランでインポートする場合",
+			query:       "?dryRun=true",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"content_types":[{"name":"news","display_name":"お知らせ","field_schema":{"fields":[]}}]}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().ImportContentTypes(mock.Anything, mock.Anything, true).
+					Return(&usecase.ContentTypeImportReport{DryRun: true, Created: []string{"news"}, Updated: []string{}, Unchanged: []string{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系：不明な項目がある場合",
+			contentType:    "application/yaml",
+			body:           "content_types:\n  - name: news\n    displayName: お知らせ\n",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_FORMAT",
+		},
+		{
+			name:        "異常系：名前が重複する場合",
+			contentType: "application/yaml",
+			body:        yamlBody,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().ImportContentTypes(mock.Anything, mock.Anything, false).
+					Return(nil, fmt.Errorf("%w: 名前が重複しています", entity.ErrInvalidParameter))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPost, "/content-types/import"+tc.query, strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, tc.contentType)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+
+			assert.NoError(s.T(), s.controller.ImportContentTypes(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+
+			body := s.decodeResponse(rec)
+			if tc.expectedCode != "" {
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+				return
+			}
+			data := body["data"].(map[string]interface{})
+			assert.Equal(s.T(), []interface{}{"news"}, data["created"])
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"cms_api/internal/domain/entity"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetContentVersions godoc
+// @Summary コンテンツの版一覧の取得
+// @Description コンテンツの版をスナップショットを除いて新しい順に取得します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/versions [get]
+func (cc *ContentController) GetContentVersions(c echo.Context) error {
+	contentID, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	versions, err := cc.contentUsecase.GetContentVersions(c.Request().Context(), contentID)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, map[string]interface{}{
+		"versions": versions,
+	})
+}
+
+// GetContentVersion godoc
+// @Summary コンテンツの版の取得
+// @Description コンテンツの指定された版をブロックを含むスナップショット込みで取得します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param version path int true "バージョン"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/versions/{version} [get]
+func (cc *ContentController) GetContentVersion(c echo.Context) error {
+	contentID, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	version, err := pathInt(c, "version")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	contentVersion, err := cc.contentUsecase.GetContentVersion(c.Request().Context(), contentID, version)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, contentVersion)
+}
+
+// RestoreContentVersion godoc
+// @Summary コンテンツの版の復元
+// @Description 指定された版の内容でコンテンツを更新し、新しい版として保存します。現在のバージョンをIf-Matchまたはversionクエリで指定します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param version path int true "復元する版のバージョン"
+// @Param If-Match header string false "現在のバージョン (ETag)"
+// @Param current query int false "現在のバージョン"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/versions/{version}/restore [post]
+func (cc *ContentController) RestoreContentVersion(c echo.Context) error {
+	contentID, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	version, err := pathInt(c, "version")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	current, err := queryInt(c, "current")
+	if err != nil {
+		return handleError(c, err)
+	}
+	if current, err = requestVersion(c, current); err != nil {
+		return handleError(c, err)
+	}
+
+	content, err := cc.contentUsecase.RestoreContentVersion(c.Request().Context(), contentID, version, current)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	setETag(c, content)
+	return successResponse(c, http.StatusOK, content)
+}
+
+// DiffContentVersions godoc
+// @Summary コンテンツの版の差分の取得
+// @Description 2つの版の間で変更されたメタデータと、ブロックごとの追加・削除・移動・変更を取得します
+// @Tags content
+// @Produce json
+// @Param id path string true "コンテンツID (UUID)"
+// @Param a path int true "比較元のバージョン"
+// @Param b path int true "比較先のバージョン"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /contents/{id}/versions/{a}/diff/{b} [get]
+func (cc *ContentController) DiffContentVersions(c echo.Context) error {
+	contentID, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+	from, err := pathInt(c, "a")
+	if err != nil {
+		return handleError(c, err)
+	}
+	to, err := pathInt(c, "b")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	diff, err := cc.contentUsecase.DiffContentVersions(c.Request().Context(), contentID, from, to)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, diff)
+}
+
+// pathInt はパスパラメータを整数として取得します
+func pathInt(c echo.Context, name string) (int, error) {
+	raw := c.Param(name)
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s=%s", entity.ErrInvalidParameter, name, raw)
+	}
+	return v, nil
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// GetContentVersionのテスト
+func (s *contentsControllerTestSuite) TestGetContentVersion() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		version        string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:    "正常系：版が取得できる場合",
+			version: "2",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentVersion(mock.Anything, contentID, 2).
+					Return(&entity.ContentVersion{ContentID: contentID, Version: 2}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系：バージョンが数値でない場合",
+			version:        "latest",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:    "異常系：版が存在しない場合",
+			version: "9",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetContentVersion(mock.Anything, contentID, 9).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrContentVersionNotFound, contentID))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "RESOURCE_NOT_FOUND",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id", "version")
+			c.SetParamValues(contentID.String(), tc.version)
+
+			assert.NoError(s.T(), s.controller.GetContentVersion(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// RestoreContentVersionのテスト
+func (s *contentsControllerTestSuite) TestRestoreContentVersion() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		query          string
+		ifMatch        string
+		setup          setupFunc
+		expectedStatus int
+		expectedETag   string
+	}{
+		{
+			name:    "正常系：If-Matchの現在のバージョンで復元できる場合",
+			ifMatch: `"5"`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().RestoreContentVersion(mock.Anything, contentID, 2, 5).
+					Return(&entity.Content{ID: contentID, Version: 6}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedETag:   `"6"`,
+		},
+		{
+			name:  "異常系：現在のバージョンが競合した場合",
+			query: "?current=4",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().RestoreContentVersion(mock.Anything, contentID, 2, 4).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrVersionConflict, contentID))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPost, "/"+tc.query, nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id", "version")
+			c.SetParamValues(contentID.String(), "2")
+
+			assert.NoError(s.T(), s.controller.RestoreContentVersion(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			assert.Equal(s.T(), tc.expectedETag, rec.Header().Get("ETag"))
+		})
+	}
+}
+
+// DiffContentVersionsのテスト
+func (s *contentsControllerTestSuite) TestDiffContentVersions() {
+	contentID := uuid.New()
+	testCases := []struct {
+		name           string
+		from           string
+		to             string
+		setup          setupFunc
+		expectedStatus int
+	}{
+		{
+			name: "正常系：差分が取得できる場合",
+			from: "1",
+			to:   "3",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DiffContentVersions(mock.Anything, contentID, 1, 3).
+					Return(&usecase.ContentDiff{ContentID: contentID, From: 1, To: 3}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系：比較先のバージョンが数値でない場合",
+			from:           "1",
+			to:             "x",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id", "a", "b")
+			c.SetParamValues(contentID.String(), tc.from, tc.to)
+
+			assert.NoError(s.T(), s.controller.DiffContentVersions(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,2938 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "cms_api/internal/domain/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	usecase "cms_api/internal/usecase/content"
+
+	uuid "github.com/google/uuid"
+)
+
+// ContentUsecase is an autogenerated mock type for the contentUsecase type
+type ContentUsecase struct {
+	mock.Mock
+}
+
+type ContentUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ContentUsecase) EXPECT() *ContentUsecase_Expecter {
+	return &ContentUsecase_Expecter{mock: &_m.Mock}
+}
+
+// ActivateContentType provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) ActivateContentType(ctx context.Context, id uuid.UUID) (*entity.ContentType, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActivateContentType")
+	}
+
+	var r0 *entity.ContentType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ContentType, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ContentType); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_ActivateContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActivateContentType'
+type ContentUsecase_ActivateContentType_Call struct {
+	*mock.Call
+}
+
+// ActivateContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) ActivateContentType(ctx interface{}, id interface{}) *ContentUsecase_ActivateContentType_Call {
+	return &ContentUsecase_ActivateContentType_Call{Call: _e.mock.On("ActivateContentType", ctx, id)}
+}
+
+func (_c *ContentUsecase_ActivateContentType_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_ActivateContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_ActivateContentType_Call) Return(_a0 *entity.ContentType, _a1 error) *ContentUsecase_ActivateContentType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_ActivateContentType_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.ContentType, error)) *ContentUsecase_ActivateContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCategory provides a mock function with given fields: ctx, category
+func (_m *ContentUsecase) CreateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	ret := _m.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 *entity.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Category) (*entity.Category, error)); ok {
+		return rf(ctx, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Category) *entity.Category); ok {
+		r0 = rf(ctx, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Category) error); ok {
+		r1 = rf(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type ContentUsecase_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *entity.Category
+func (_e *ContentUsecase_Expecter) CreateCategory(ctx interface{}, category interface{}) *ContentUsecase_CreateCategory_Call {
+	return &ContentUsecase_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, category)}
+}
+
+func (_c *ContentUsecase_CreateCategory_Call) Run(run func(ctx context.Context, category *entity.Category)) *ContentUsecase_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Category))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_CreateCategory_Call) Return(_a0 *entity.Category, _a1 error) *ContentUsecase_CreateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_CreateCategory_Call) RunAndReturn(run func(context.Context, *entity.Category) (*entity.Category, error)) *ContentUsecase_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateContent provides a mock function with given fields: ctx, content
+func (_m *ContentUsecase) CreateContent(ctx context.Context, content *entity.Content) (*entity.Content, error) {
+	ret := _m.Called(ctx, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateContent")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Content) (*entity.Content, error)); ok {
+		return rf(ctx, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Content) *entity.Content); ok {
+		r0 = rf(ctx, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Content) error); ok {
+		r1 = rf(ctx, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_CreateContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateContent'
+type ContentUsecase_CreateContent_Call struct {
+	*mock.Call
+}
+
+// CreateContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content *entity.Content
+func (_e *ContentUsecase_Expecter) CreateContent(ctx interface{}, content interface{}) *ContentUsecase_CreateContent_Call {
+	return &ContentUsecase_CreateContent_Call{Call: _e.mock.On("CreateContent", ctx, content)}
+}
+
+func (_c *ContentUsecase_CreateContent_Call) Run(run func(ctx context.Context, content *entity.Content)) *ContentUsecase_CreateContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Content))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_CreateContent_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_CreateContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_CreateContent_Call) RunAndReturn(run func(context.Context, *entity.Content) (*entity.Content, error)) *ContentUsecase_CreateContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateContentType provides a mock function with given fields: ctx, contentType
+func (_m *ContentUsecase) CreateContentType(ctx context.Context, contentType *entity.ContentType) (*entity.ContentType, error) {
+	ret := _m.Called(ctx, contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateContentType")
+	}
+
+	var r0 *entity.ContentType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentType) (*entity.ContentType, error)); ok {
+		return rf(ctx, contentType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentType) *entity.ContentType); ok {
+		r0 = rf(ctx, contentType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.ContentType) error); ok {
+		r1 = rf(ctx, contentType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_CreateContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateContentType'
+type ContentUsecase_CreateContentType_Call struct {
+	*mock.Call
+}
+
+// CreateContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentType *entity.ContentType
+func (_e *ContentUsecase_Expecter) CreateContentType(ctx interface{}, contentType interface{}) *ContentUsecase_CreateContentType_Call {
+	return &ContentUsecase_CreateContentType_Call{Call: _e.mock.On("CreateContentType", ctx, contentType)}
+}
+
+func (_c *ContentUsecase_CreateContentType_Call) Run(run func(ctx context.Context, contentType *entity.ContentType)) *ContentUsecase_CreateContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ContentType))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_CreateContentType_Call) Return(_a0 *entity.ContentType, _a1 error) *ContentUsecase_CreateContentType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_CreateContentType_Call) RunAndReturn(run func(context.Context, *entity.ContentType) (*entity.ContentType, error)) *ContentUsecase_CreateContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: ctx, tag
+func (_m *ContentUsecase) CreateTag(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 *entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Tag) (*entity.Tag, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Tag) *entity.Tag); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Tag) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type ContentUsecase_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag *entity.Tag
+func (_e *ContentUsecase_Expecter) CreateTag(ctx interface{}, tag interface{}) *ContentUsecase_CreateTag_Call {
+	return &ContentUsecase_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, tag)}
+}
+
+func (_c *ContentUsecase_CreateTag_Call) Run(run func(ctx context.Context, tag *entity.Tag)) *ContentUsecase_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Tag))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_CreateTag_Call) Return(_a0 *entity.Tag, _a1 error) *ContentUsecase_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_CreateTag_Call) RunAndReturn(run func(context.Context, *entity.Tag) (*entity.Tag, error)) *ContentUsecase_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateContentType provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) DeactivateContentType(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateContentType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentUsecase_DeactivateContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateContentType'
+type ContentUsecase_DeactivateContentType_Call struct {
+	*mock.Call
+}
+
+// DeactivateContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) DeactivateContentType(ctx interface{}, id interface{}) *ContentUsecase_DeactivateContentType_Call {
+	return &ContentUsecase_DeactivateContentType_Call{Call: _e.mock.On("DeactivateContentType", ctx, id)}
+}
+
+func (_c *ContentUsecase_DeactivateContentType_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_DeactivateContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_DeactivateContentType_Call) Return(_a0 error) *ContentUsecase_DeactivateContentType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentUsecase_DeactivateContentType_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ContentUsecase_DeactivateContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBlock provides a mock function with given fields: ctx, contentID, blockID, version
+func (_m *ContentUsecase) DeleteBlock(ctx context.Context, contentID uuid.UUID, blockID uuid.UUID, version int) (*entity.Content, error) {
+	ret := _m.Called(ctx, contentID, blockID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlock")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) (*entity.Content, error)); ok {
+		return rf(ctx, contentID, blockID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) *entity.Content); ok {
+		r0 = rf(ctx, contentID, blockID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, contentID, blockID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_DeleteBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlock'
+type ContentUsecase_DeleteBlock_Call struct {
+	*mock.Call
+}
+
+// DeleteBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - blockID uuid.UUID
+//   - version int
+func (_e *ContentUsecase_Expecter) DeleteBlock(ctx interface{}, contentID interface{}, blockID interface{}, version interface{}) *ContentUsecase_DeleteBlock_Call {
+	return &ContentUsecase_DeleteBlock_Call{Call: _e.mock.On("DeleteBlock", ctx, contentID, blockID, version)}
+}
+
+func (_c *ContentUsecase_DeleteBlock_Call) Run(run func(ctx context.Context, contentID uuid.UUID, blockID uuid.UUID, version int)) *ContentUsecase_DeleteBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteBlock_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_DeleteBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteBlock_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int) (*entity.Content, error)) *ContentUsecase_DeleteBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentUsecase_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type ContentUsecase_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) DeleteCategory(ctx interface{}, id interface{}) *ContentUsecase_DeleteCategory_Call {
+	return &ContentUsecase_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, id)}
+}
+
+func (_c *ContentUsecase_DeleteCategory_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteCategory_Call) Return(_a0 error) *ContentUsecase_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteCategory_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ContentUsecase_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteContent provides a mock function with given fields: ctx, id, expectedVersion, policy
+func (_m *ContentUsecase) DeleteContent(ctx context.Context, id uuid.UUID, expectedVersion int, policy entity.ReferrerPolicy) error {
+	ret := _m.Called(ctx, id, expectedVersion, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, entity.ReferrerPolicy) error); ok {
+		r0 = rf(ctx, id, expectedVersion, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentUsecase_DeleteContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteContent'
+type ContentUsecase_DeleteContent_Call struct {
+	*mock.Call
+}
+
+// DeleteContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - expectedVersion int
+//   - policy entity.ReferrerPolicy
+func (_e *ContentUsecase_Expecter) DeleteContent(ctx interface{}, id interface{}, expectedVersion interface{}, policy interface{}) *ContentUsecase_DeleteContent_Call {
+	return &ContentUsecase_DeleteContent_Call{Call: _e.mock.On("DeleteContent", ctx, id, expectedVersion, policy)}
+}
+
+func (_c *ContentUsecase_DeleteContent_Call) Run(run func(ctx context.Context, id uuid.UUID, expectedVersion int, policy entity.ReferrerPolicy)) *ContentUsecase_DeleteContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(entity.ReferrerPolicy))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteContent_Call) Return(_a0 error) *ContentUsecase_DeleteContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteContent_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, entity.ReferrerPolicy) error) *ContentUsecase_DeleteContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSlugRedirect provides a mock function with given fields: ctx, contentID, redirectID
+func (_m *ContentUsecase) DeleteSlugRedirect(ctx context.Context, contentID uuid.UUID, redirectID uuid.UUID) error {
+	ret := _m.Called(ctx, contentID, redirectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSlugRedirect")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, contentID, redirectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentUsecase_DeleteSlugRedirect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSlugRedirect'
+type ContentUsecase_DeleteSlugRedirect_Call struct {
+	*mock.Call
+}
+
+// DeleteSlugRedirect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - redirectID uuid.UUID
+func (_e *ContentUsecase_Expecter) DeleteSlugRedirect(ctx interface{}, contentID interface{}, redirectID interface{}) *ContentUsecase_DeleteSlugRedirect_Call {
+	return &ContentUsecase_DeleteSlugRedirect_Call{Call: _e.mock.On("DeleteSlugRedirect", ctx, contentID, redirectID)}
+}
+
+func (_c *ContentUsecase_DeleteSlugRedirect_Call) Run(run func(ctx context.Context, contentID uuid.UUID, redirectID uuid.UUID)) *ContentUsecase_DeleteSlugRedirect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteSlugRedirect_Call) Return(_a0 error) *ContentUsecase_DeleteSlugRedirect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteSlugRedirect_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ContentUsecase_DeleteSlugRedirect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentUsecase_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type ContentUsecase_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) DeleteTag(ctx interface{}, id interface{}) *ContentUsecase_DeleteTag_Call {
+	return &ContentUsecase_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, id)}
+}
+
+func (_c *ContentUsecase_DeleteTag_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteTag_Call) Return(_a0 error) *ContentUsecase_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentUsecase_DeleteTag_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ContentUsecase_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffContentVersions provides a mock function with given fields: ctx, contentID, from, to
+func (_m *ContentUsecase) DiffContentVersions(ctx context.Context, contentID uuid.UUID, from int, to int) (*usecase.ContentDiff, error) {
+	ret := _m.Called(ctx, contentID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffContentVersions")
+	}
+
+	var r0 *usecase.ContentDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) (*usecase.ContentDiff, error)); ok {
+		return rf(ctx, contentID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) *usecase.ContentDiff); ok {
+		r0 = rf(ctx, contentID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ContentDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, contentID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_DiffContentVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffContentVersions'
+type ContentUsecase_DiffContentVersions_Call struct {
+	*mock.Call
+}
+
+// DiffContentVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - from int
+//   - to int
+func (_e *ContentUsecase_Expecter) DiffContentVersions(ctx interface{}, contentID interface{}, from interface{}, to interface{}) *ContentUsecase_DiffContentVersions_Call {
+	return &ContentUsecase_DiffContentVersions_Call{Call: _e.mock.On("DiffContentVersions", ctx, contentID, from, to)}
+}
+
+func (_c *ContentUsecase_DiffContentVersions_Call) Run(run func(ctx context.Context, contentID uuid.UUID, from int, to int)) *ContentUsecase_DiffContentVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_DiffContentVersions_Call) Return(_a0 *usecase.ContentDiff, _a1 error) *ContentUsecase_DiffContentVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_DiffContentVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) (*usecase.ContentDiff, error)) *ContentUsecase_DiffContentVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpandReferences provides a mock function with given fields: ctx, content, depth, visibleOnly
+func (_m *ContentUsecase) ExpandReferences(ctx context.Context, content *entity.Content, depth int, visibleOnly bool) error {
+	ret := _m.Called(ctx, content, depth, visibleOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandReferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Content, int, bool) error); ok {
+		r0 = rf(ctx, content, depth, visibleOnly)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentUsecase_ExpandReferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandReferences'
+type ContentUsecase_ExpandReferences_Call struct {
+	*mock.Call
+}
+
+// ExpandReferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content *entity.Content
+//   - depth int
+//   - visibleOnly bool
+func (_e *ContentUsecase_Expecter) ExpandReferences(ctx interface{}, content interface{}, depth interface{}, visibleOnly interface{}) *ContentUsecase_ExpandReferences_Call {
+	return &ContentUsecase_ExpandReferences_Call{Call: _e.mock.On("ExpandReferences", ctx, content, depth, visibleOnly)}
+}
+
+func (_c *ContentUsecase_ExpandReferences_Call) Run(run func(ctx context.Context, content *entity.Content, depth int, visibleOnly bool)) *ContentUsecase_ExpandReferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Content), args[2].(int), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_ExpandReferences_Call) Return(_a0 error) *ContentUsecase_ExpandReferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentUsecase_ExpandReferences_Call) RunAndReturn(run func(context.Context, *entity.Content, int, bool) error) *ContentUsecase_ExpandReferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportContentTypes provides a mock function with given fields: ctx
+func (_m *ContentUsecase) ExportContentTypes(ctx context.Context) (*entity.ContentTypeDefinitions, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportContentTypes")
+	}
+
+	var r0 *entity.ContentTypeDefinitions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*entity.ContentTypeDefinitions, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *entity.ContentTypeDefinitions); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentTypeDefinitions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_ExportContentTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportContentTypes'
+type ContentUsecase_ExportContentTypes_Call struct {
+	*mock.Call
+}
+
+// ExportContentTypes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentUsecase_Expecter) ExportContentTypes(ctx interface{}) *ContentUsecase_ExportContentTypes_Call {
+	return &ContentUsecase_ExportContentTypes_Call{Call: _e.mock.On("ExportContentTypes", ctx)}
+}
+
+func (_c *ContentUsecase_ExportContentTypes_Call) Run(run func(ctx context.Context)) *ContentUsecase_ExportContentTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_ExportContentTypes_Call) Return(_a0 *entity.ContentTypeDefinitions, _a1 error) *ContentUsecase_ExportContentTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_ExportContentTypes_Call) RunAndReturn(run func(context.Context) (*entity.ContentTypeDefinitions, error)) *ContentUsecase_ExportContentTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArchiveContents provides a mock function with given fields: ctx, year, month, input
+func (_m *ContentUsecase) GetArchiveContents(ctx context.Context, year int, month int, input usecase.GetContentsInput) (*usecase.ContentList, error) {
+	ret := _m.Called(ctx, year, month, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArchiveContents")
+	}
+
+	var r0 *usecase.ContentList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, usecase.GetContentsInput) (*usecase.ContentList, error)); ok {
+		return rf(ctx, year, month, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, usecase.GetContentsInput) *usecase.ContentList); ok {
+		r0 = rf(ctx, year, month, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ContentList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, usecase.GetContentsInput) error); ok {
+		r1 = rf(ctx, year, month, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetArchiveContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArchiveContents'
+type ContentUsecase_GetArchiveContents_Call struct {
+	*mock.Call
+}
+
+// GetArchiveContents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - year int
+//   - month int
+//   - input usecase.GetContentsInput
+func (_e *ContentUsecase_Expecter) GetArchiveContents(ctx interface{}, year interface{}, month interface{}, input interface{}) *ContentUsecase_GetArchiveContents_Call {
+	return &ContentUsecase_GetArchiveContents_Call{Call: _e.mock.On("GetArchiveContents", ctx, year, month, input)}
+}
+
+func (_c *ContentUsecase_GetArchiveContents_Call) Run(run func(ctx context.Context, year int, month int, input usecase.GetContentsInput)) *ContentUsecase_GetArchiveContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(usecase.GetContentsInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetArchiveContents_Call) Return(_a0 *usecase.ContentList, _a1 error) *ContentUsecase_GetArchiveContents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetArchiveContents_Call) RunAndReturn(run func(context.Context, int, int, usecase.GetContentsInput) (*usecase.ContentList, error)) *ContentUsecase_GetArchiveContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArchiveCounts provides a mock function with given fields: ctx
+func (_m *ContentUsecase) GetArchiveCounts(ctx context.Context) ([]*entity.ArchiveCount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArchiveCounts")
+	}
+
+	var r0 []*entity.ArchiveCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.ArchiveCount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.ArchiveCount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ArchiveCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetArchiveCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArchiveCounts'
+type ContentUsecase_GetArchiveCounts_Call struct {
+	*mock.Call
+}
+
+// GetArchiveCounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentUsecase_Expecter) GetArchiveCounts(ctx interface{}) *ContentUsecase_GetArchiveCounts_Call {
+	return &ContentUsecase_GetArchiveCounts_Call{Call: _e.mock.On("GetArchiveCounts", ctx)}
+}
+
+func (_c *ContentUsecase_GetArchiveCounts_Call) Run(run func(ctx context.Context)) *ContentUsecase_GetArchiveCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetArchiveCounts_Call) Return(_a0 []*entity.ArchiveCount, _a1 error) *ContentUsecase_GetArchiveCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetArchiveCounts_Call) RunAndReturn(run func(context.Context) ([]*entity.ArchiveCount, error)) *ContentUsecase_GetArchiveCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategories provides a mock function with given fields: ctx
+func (_m *ContentUsecase) GetCategories(ctx context.Context) ([]*entity.Category, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategories")
+	}
+
+	var r0 []*entity.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Category, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Category); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategories'
+type ContentUsecase_GetCategories_Call struct {
+	*mock.Call
+}
+
+// GetCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentUsecase_Expecter) GetCategories(ctx interface{}) *ContentUsecase_GetCategories_Call {
+	return &ContentUsecase_GetCategories_Call{Call: _e.mock.On("GetCategories", ctx)}
+}
+
+func (_c *ContentUsecase_GetCategories_Call) Run(run func(ctx context.Context)) *ContentUsecase_GetCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetCategories_Call) Return(_a0 []*entity.Category, _a1 error) *ContentUsecase_GetCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetCategories_Call) RunAndReturn(run func(context.Context) ([]*entity.Category, error)) *ContentUsecase_GetCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryByID provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) GetCategoryByID(ctx context.Context, id uuid.UUID) (*entity.Category, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryByID")
+	}
+
+	var r0 *entity.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Category, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Category); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetCategoryByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryByID'
+type ContentUsecase_GetCategoryByID_Call struct {
+	*mock.Call
+}
+
+// GetCategoryByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) GetCategoryByID(ctx interface{}, id interface{}) *ContentUsecase_GetCategoryByID_Call {
+	return &ContentUsecase_GetCategoryByID_Call{Call: _e.mock.On("GetCategoryByID", ctx, id)}
+}
+
+func (_c *ContentUsecase_GetCategoryByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_GetCategoryByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetCategoryByID_Call) Return(_a0 *entity.Category, _a1 error) *ContentUsecase_GetCategoryByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetCategoryByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Category, error)) *ContentUsecase_GetCategoryByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryCounts provides a mock function with given fields: ctx
+func (_m *ContentUsecase) GetCategoryCounts(ctx context.Context) ([]*entity.CategoryCount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryCounts")
+	}
+
+	var r0 []*entity.CategoryCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.CategoryCount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.CategoryCount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.CategoryCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetCategoryCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryCounts'
+type ContentUsecase_GetCategoryCounts_Call struct {
+	*mock.Call
+}
+
+// GetCategoryCounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentUsecase_Expecter) GetCategoryCounts(ctx interface{}) *ContentUsecase_GetCategoryCounts_Call {
+	return &ContentUsecase_GetCategoryCounts_Call{Call: _e.mock.On("GetCategoryCounts", ctx)}
+}
+
+func (_c *ContentUsecase_GetCategoryCounts_Call) Run(run func(ctx context.Context)) *ContentUsecase_GetCategoryCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetCategoryCounts_Call) Return(_a0 []*entity.CategoryCount, _a1 error) *ContentUsecase_GetCategoryCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetCategoryCounts_Call) RunAndReturn(run func(context.Context) ([]*entity.CategoryCount, error)) *ContentUsecase_GetCategoryCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentByID provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) GetContentByID(ctx context.Context, id uuid.UUID) (*entity.Content, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentByID")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Content, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Content); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetContentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentByID'
+type ContentUsecase_GetContentByID_Call struct {
+	*mock.Call
+}
+
+// GetContentByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) GetContentByID(ctx interface{}, id interface{}) *ContentUsecase_GetContentByID_Call {
+	return &ContentUsecase_GetContentByID_Call{Call: _e.mock.On("GetContentByID", ctx, id)}
+}
+
+func (_c *ContentUsecase_GetContentByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_GetContentByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentByID_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_GetContentByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Content, error)) *ContentUsecase_GetContentByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentBySlug provides a mock function with given fields: ctx, contentTypeName, slug
+func (_m *ContentUsecase) GetContentBySlug(ctx context.Context, contentTypeName string, slug string) (*entity.Content, error) {
+	ret := _m.Called(ctx, contentTypeName, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentBySlug")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.Content, error)); ok {
+		return rf(ctx, contentTypeName, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entity.Content); ok {
+		r0 = rf(ctx, contentTypeName, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, contentTypeName, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetContentBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentBySlug'
+type ContentUsecase_GetContentBySlug_Call struct {
+	*mock.Call
+}
+
+// GetContentBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentTypeName string
+//   - slug string
+func (_e *ContentUsecase_Expecter) GetContentBySlug(ctx interface{}, contentTypeName interface{}, slug interface{}) *ContentUsecase_GetContentBySlug_Call {
+	return &ContentUsecase_GetContentBySlug_Call{Call: _e.mock.On("GetContentBySlug", ctx, contentTypeName, slug)}
+}
+
+func (_c *ContentUsecase_GetContentBySlug_Call) Run(run func(ctx context.Context, contentTypeName string, slug string)) *ContentUsecase_GetContentBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentBySlug_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_GetContentBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentBySlug_Call) RunAndReturn(run func(context.Context, string, string) (*entity.Content, error)) *ContentUsecase_GetContentBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentTypeByID provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) GetContentTypeByID(ctx context.Context, id uuid.UUID) (*entity.ContentType, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentTypeByID")
+	}
+
+	var r0 *entity.ContentType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ContentType, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ContentType); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetContentTypeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentTypeByID'
+type ContentUsecase_GetContentTypeByID_Call struct {
+	*mock.Call
+}
+
+// GetContentTypeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) GetContentTypeByID(ctx interface{}, id interface{}) *ContentUsecase_GetContentTypeByID_Call {
+	return &ContentUsecase_GetContentTypeByID_Call{Call: _e.mock.On("GetContentTypeByID", ctx, id)}
+}
+
+func (_c *ContentUsecase_GetContentTypeByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_GetContentTypeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentTypeByID_Call) Return(_a0 *entity.ContentType, _a1 error) *ContentUsecase_GetContentTypeByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentTypeByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.ContentType, error)) *ContentUsecase_GetContentTypeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentTypeSchemaVersions provides a mock function with given fields: ctx, contentTypeID
+func (_m *ContentUsecase) GetContentTypeSchemaVersions(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error) {
+	ret := _m.Called(ctx, contentTypeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentTypeSchemaVersions")
+	}
+
+	var r0 []*entity.ContentTypeSchemaVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error)); ok {
+		return rf(ctx, contentTypeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.ContentTypeSchemaVersion); ok {
+		r0 = rf(ctx, contentTypeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ContentTypeSchemaVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentTypeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetContentTypeSchemaVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentTypeSchemaVersions'
+type ContentUsecase_GetContentTypeSchemaVersions_Call struct {
+	*mock.Call
+}
+
+// GetContentTypeSchemaVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentTypeID uuid.UUID
+func (_e *ContentUsecase_Expecter) GetContentTypeSchemaVersions(ctx interface{}, contentTypeID interface{}) *ContentUsecase_GetContentTypeSchemaVersions_Call {
+	return &ContentUsecase_GetContentTypeSchemaVersions_Call{Call: _e.mock.On("GetContentTypeSchemaVersions", ctx, contentTypeID)}
+}
+
+func (_c *ContentUsecase_GetContentTypeSchemaVersions_Call) Run(run func(ctx context.Context, contentTypeID uuid.UUID)) *ContentUsecase_GetContentTypeSchemaVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentTypeSchemaVersions_Call) Return(_a0 []*entity.ContentTypeSchemaVersion, _a1 error) *ContentUsecase_GetContentTypeSchemaVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentTypeSchemaVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error)) *ContentUsecase_GetContentTypeSchemaVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentTypes provides a mock function with given fields: ctx, includeInactive
+func (_m *ContentUsecase) GetContentTypes(ctx context.Context, includeInactive bool) ([]*entity.ContentType, error) {
+	ret := _m.Called(ctx, includeInactive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentTypes")
+	}
+
+	var r0 []*entity.ContentType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]*entity.ContentType, error)); ok {
+		return rf(ctx, includeInactive)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*entity.ContentType); ok {
+		r0 = rf(ctx, includeInactive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ContentType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, includeInactive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetContentTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentTypes'
+type ContentUsecase_GetContentTypes_Call struct {
+	*mock.Call
+}
+
+// GetContentTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeInactive bool
+func (_e *ContentUsecase_Expecter) GetContentTypes(ctx interface{}, includeInactive interface{}) *ContentUsecase_GetContentTypes_Call {
+	return &ContentUsecase_GetContentTypes_Call{Call: _e.mock.On("GetContentTypes", ctx, includeInactive)}
+}
+
+func (_c *ContentUsecase_GetContentTypes_Call) Run(run func(ctx context.Context, includeInactive bool)) *ContentUsecase_GetContentTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentTypes_Call) Return(_a0 []*entity.ContentType, _a1 error) *ContentUsecase_GetContentTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentTypes_Call) RunAndReturn(run func(context.Context, bool) ([]*entity.ContentType, error)) *ContentUsecase_GetContentTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentVersion provides a mock function with given fields: ctx, contentID, version
+func (_m *ContentUsecase) GetContentVersion(ctx context.Context, contentID uuid.UUID, version int) (*entity.ContentVersion, error) {
+	ret := _m.Called(ctx, contentID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentVersion")
+	}
+
+	var r0 *entity.ContentVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (*entity.ContentVersion, error)); ok {
+		return rf(ctx, contentID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) *entity.ContentVersion); ok {
+		r0 = rf(ctx, contentID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, contentID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetContentVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentVersion'
+type ContentUsecase_GetContentVersion_Call struct {
+	*mock.Call
+}
+
+// GetContentVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - version int
+func (_e *ContentUsecase_Expecter) GetContentVersion(ctx interface{}, contentID interface{}, version interface{}) *ContentUsecase_GetContentVersion_Call {
+	return &ContentUsecase_GetContentVersion_Call{Call: _e.mock.On("GetContentVersion", ctx, contentID, version)}
+}
+
+func (_c *ContentUsecase_GetContentVersion_Call) Run(run func(ctx context.Context, contentID uuid.UUID, version int)) *ContentUsecase_GetContentVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentVersion_Call) Return(_a0 *entity.ContentVersion, _a1 error) *ContentUsecase_GetContentVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (*entity.ContentVersion, error)) *ContentUsecase_GetContentVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentVersions provides a mock function with given fields: ctx, contentID
+func (_m *ContentUsecase) GetContentVersions(ctx context.Context, contentID uuid.UUID) ([]*entity.ContentVersion, error) {
+	ret := _m.Called(ctx, contentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentVersions")
+	}
+
+	var r0 []*entity.ContentVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.ContentVersion, error)); ok {
+		return rf(ctx, contentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.ContentVersion); ok {
+		r0 = rf(ctx, contentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ContentVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetContentVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentVersions'
+type ContentUsecase_GetContentVersions_Call struct {
+	*mock.Call
+}
+
+// GetContentVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+func (_e *ContentUsecase_Expecter) GetContentVersions(ctx interface{}, contentID interface{}) *ContentUsecase_GetContentVersions_Call {
+	return &ContentUsecase_GetContentVersions_Call{Call: _e.mock.On("GetContentVersions", ctx, contentID)}
+}
+
+func (_c *ContentUsecase_GetContentVersions_Call) Run(run func(ctx context.Context, contentID uuid.UUID)) *ContentUsecase_GetContentVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentVersions_Call) Return(_a0 []*entity.ContentVersion, _a1 error) *ContentUsecase_GetContentVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetContentVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.ContentVersion, error)) *ContentUsecase_GetContentVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContents provides a mock function with given fields: ctx, input
+func (_m *ContentUsecase) GetContents(ctx context.Context, input usecase.GetContentsInput) (*usecase.ContentList, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContents")
+	}
+
+	var r0 *usecase.ContentList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.GetContentsInput) (*usecase.ContentList, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.GetContentsInput) *usecase.ContentList); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ContentList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.GetContentsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContents'
+type ContentUsecase_GetContents_Call struct {
+	*mock.Call
+}
+
+// GetContents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecase.GetContentsInput
+func (_e *ContentUsecase_Expecter) GetContents(ctx interface{}, input interface{}) *ContentUsecase_GetContents_Call {
+	return &ContentUsecase_GetContents_Call{Call: _e.mock.On("GetContents", ctx, input)}
+}
+
+func (_c *ContentUsecase_GetContents_Call) Run(run func(ctx context.Context, input usecase.GetContentsInput)) *ContentUsecase_GetContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.GetContentsInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetContents_Call) Return(_a0 *usecase.ContentList, _a1 error) *ContentUsecase_GetContents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetContents_Call) RunAndReturn(run func(context.Context, usecase.GetContentsInput) (*usecase.ContentList, error)) *ContentUsecase_GetContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferrers provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) GetReferrers(ctx context.Context, id uuid.UUID) ([]entity.Referrer, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferrers")
+	}
+
+	var r0 []entity.Referrer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Referrer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Referrer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Referrer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetReferrers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferrers'
+type ContentUsecase_GetReferrers_Call struct {
+	*mock.Call
+}
+
+// GetReferrers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) GetReferrers(ctx interface{}, id interface{}) *ContentUsecase_GetReferrers_Call {
+	return &ContentUsecase_GetReferrers_Call{Call: _e.mock.On("GetReferrers", ctx, id)}
+}
+
+func (_c *ContentUsecase_GetReferrers_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_GetReferrers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetReferrers_Call) Return(_a0 []entity.Referrer, _a1 error) *ContentUsecase_GetReferrers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetReferrers_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.Referrer, error)) *ContentUsecase_GetReferrers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSlugRedirects provides a mock function with given fields: ctx, contentID
+func (_m *ContentUsecase) GetSlugRedirects(ctx context.Context, contentID uuid.UUID) ([]*entity.SlugRedirect, error) {
+	ret := _m.Called(ctx, contentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlugRedirects")
+	}
+
+	var r0 []*entity.SlugRedirect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.SlugRedirect, error)); ok {
+		return rf(ctx, contentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.SlugRedirect); ok {
+		r0 = rf(ctx, contentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SlugRedirect)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetSlugRedirects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSlugRedirects'
+type ContentUsecase_GetSlugRedirects_Call struct {
+	*mock.Call
+}
+
+// GetSlugRedirects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+func (_e *ContentUsecase_Expecter) GetSlugRedirects(ctx interface{}, contentID interface{}) *ContentUsecase_GetSlugRedirects_Call {
+	return &ContentUsecase_GetSlugRedirects_Call{Call: _e.mock.On("GetSlugRedirects", ctx, contentID)}
+}
+
+func (_c *ContentUsecase_GetSlugRedirects_Call) Run(run func(ctx context.Context, contentID uuid.UUID)) *ContentUsecase_GetSlugRedirects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetSlugRedirects_Call) Return(_a0 []*entity.SlugRedirect, _a1 error) *ContentUsecase_GetSlugRedirects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetSlugRedirects_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.SlugRedirect, error)) *ContentUsecase_GetSlugRedirects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagByID provides a mock function with given fields: ctx, id
+func (_m *ContentUsecase) GetTagByID(ctx context.Context, id uuid.UUID) (*entity.Tag, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagByID")
+	}
+
+	var r0 *entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Tag, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Tag); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetTagByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagByID'
+type ContentUsecase_GetTagByID_Call struct {
+	*mock.Call
+}
+
+// GetTagByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentUsecase_Expecter) GetTagByID(ctx interface{}, id interface{}) *ContentUsecase_GetTagByID_Call {
+	return &ContentUsecase_GetTagByID_Call{Call: _e.mock.On("GetTagByID", ctx, id)}
+}
+
+func (_c *ContentUsecase_GetTagByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentUsecase_GetTagByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetTagByID_Call) Return(_a0 *entity.Tag, _a1 error) *ContentUsecase_GetTagByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetTagByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Tag, error)) *ContentUsecase_GetTagByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagCounts provides a mock function with given fields: ctx
+func (_m *ContentUsecase) GetTagCounts(ctx context.Context) ([]*entity.TagCount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagCounts")
+	}
+
+	var r0 []*entity.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.TagCount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.TagCount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetTagCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagCounts'
+type ContentUsecase_GetTagCounts_Call struct {
+	*mock.Call
+}
+
+// GetTagCounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentUsecase_Expecter) GetTagCounts(ctx interface{}) *ContentUsecase_GetTagCounts_Call {
+	return &ContentUsecase_GetTagCounts_Call{Call: _e.mock.On("GetTagCounts", ctx)}
+}
+
+func (_c *ContentUsecase_GetTagCounts_Call) Run(run func(ctx context.Context)) *ContentUsecase_GetTagCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetTagCounts_Call) Return(_a0 []*entity.TagCount, _a1 error) *ContentUsecase_GetTagCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetTagCounts_Call) RunAndReturn(run func(context.Context) ([]*entity.TagCount, error)) *ContentUsecase_GetTagCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: ctx
+func (_m *ContentUsecase) GetTags(ctx context.Context) ([]*entity.Tag, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []*entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Tag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type ContentUsecase_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentUsecase_Expecter) GetTags(ctx interface{}) *ContentUsecase_GetTags_Call {
+	return &ContentUsecase_GetTags_Call{Call: _e.mock.On("GetTags", ctx)}
+}
+
+func (_c *ContentUsecase_GetTags_Call) Run(run func(ctx context.Context)) *ContentUsecase_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetTags_Call) Return(_a0 []*entity.Tag, _a1 error) *ContentUsecase_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetTags_Call) RunAndReturn(run func(context.Context) ([]*entity.Tag, error)) *ContentUsecase_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashedContents provides a mock function with given fields: ctx, input
+func (_m *ContentUsecase) GetTrashedContents(ctx context.Context, input usecase.GetContentsInput) (*usecase.ContentList, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedContents")
+	}
+
+	var r0 *usecase.ContentList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.GetContentsInput) (*usecase.ContentList, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.GetContentsInput) *usecase.ContentList); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ContentList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.GetContentsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_GetTrashedContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashedContents'
+type ContentUsecase_GetTrashedContents_Call struct {
+	*mock.Call
+}
+
+// GetTrashedContents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecase.GetContentsInput
+func (_e *ContentUsecase_Expecter) GetTrashedContents(ctx interface{}, input interface{}) *ContentUsecase_GetTrashedContents_Call {
+	return &ContentUsecase_GetTrashedContents_Call{Call: _e.mock.On("GetTrashedContents", ctx, input)}
+}
+
+func (_c *ContentUsecase_GetTrashedContents_Call) Run(run func(ctx context.Context, input usecase.GetContentsInput)) *ContentUsecase_GetTrashedContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.GetContentsInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_GetTrashedContents_Call) Return(_a0 *usecase.ContentList, _a1 error) *ContentUsecase_GetTrashedContents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_GetTrashedContents_Call) RunAndReturn(run func(context.Context, usecase.GetContentsInput) (*usecase.ContentList, error)) *ContentUsecase_GetTrashedContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportContentTypes provides a mock function with given fields: ctx, definitions, dryRun
+func (_m *ContentUsecase) ImportContentTypes(ctx context.Context, definitions *entity.ContentTypeDefinitions, dryRun bool) (*usecase.ContentTypeImportReport, error) {
+	ret := _m.Called(ctx, definitions, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportContentTypes")
+	}
+
+	var r0 *usecase.ContentTypeImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentTypeDefinitions, bool) (*usecase.ContentTypeImportReport, error)); ok {
+		return rf(ctx, definitions, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentTypeDefinitions, bool) *usecase.ContentTypeImportReport); ok {
+		r0 = rf(ctx, definitions, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ContentTypeImportReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.ContentTypeDefinitions, bool) error); ok {
+		r1 = rf(ctx, definitions, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_ImportContentTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportContentTypes'
+type ContentUsecase_ImportContentTypes_Call struct {
+	*mock.Call
+}
+
+// ImportContentTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - definitions *entity.ContentTypeDefinitions
+//   - dryRun bool
+func (_e *ContentUsecase_Expecter) ImportContentTypes(ctx interface{}, definitions interface{}, dryRun interface{}) *ContentUsecase_ImportContentTypes_Call {
+	return &ContentUsecase_ImportContentTypes_Call{Call: _e.mock.On("ImportContentTypes", ctx, definitions, dryRun)}
+}
+
+func (_c *ContentUsecase_ImportContentTypes_Call) Run(run func(ctx context.Context, definitions *entity.ContentTypeDefinitions, dryRun bool)) *ContentUsecase_ImportContentTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ContentTypeDefinitions), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_ImportContentTypes_Call) Return(_a0 *usecase.ContentTypeImportReport, _a1 error) *ContentUsecase_ImportContentTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_ImportContentTypes_Call) RunAndReturn(run func(context.Context, *entity.ContentTypeDefinitions, bool) (*usecase.ContentTypeImportReport, error)) *ContentUsecase_ImportContentTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertBlock provides a mock function with given fields: ctx, contentID, input
+func (_m *ContentUsecase) InsertBlock(ctx context.Context, contentID uuid.UUID, input usecase.InsertBlockInput) (*entity.Content, error) {
+	ret := _m.Called(ctx, contentID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertBlock")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, usecase.InsertBlockInput) (*entity.Content, error)); ok {
+		return rf(ctx, contentID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, usecase.InsertBlockInput) *entity.Content); ok {
+		r0 = rf(ctx, contentID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, usecase.InsertBlockInput) error); ok {
+		r1 = rf(ctx, contentID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_InsertBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBlock'
+type ContentUsecase_InsertBlock_Call struct {
+	*mock.Call
+}
+
+// InsertBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - input usecase.InsertBlockInput
+func (_e *ContentUsecase_Expecter) InsertBlock(ctx interface{}, contentID interface{}, input interface{}) *ContentUsecase_InsertBlock_Call {
+	return &ContentUsecase_InsertBlock_Call{Call: _e.mock.On("InsertBlock", ctx, contentID, input)}
+}
+
+func (_c *ContentUsecase_InsertBlock_Call) Run(run func(ctx context.Context, contentID uuid.UUID, input usecase.InsertBlockInput)) *ContentUsecase_InsertBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(usecase.InsertBlockInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_InsertBlock_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_InsertBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_InsertBlock_Call) RunAndReturn(run func(context.Context, uuid.UUID, usecase.InsertBlockInput) (*entity.Content, error)) *ContentUsecase_InsertBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrateContentType provides a mock function with given fields: ctx, contentTypeID, migration, dryRun
+func (_m *ContentUsecase) MigrateContentType(ctx context.Context, contentTypeID uuid.UUID, migration entity.SchemaMigration, dryRun bool) (*usecase.SchemaMigrationReport, error) {
+	ret := _m.Called(ctx, contentTypeID, migration, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateContentType")
+	}
+
+	var r0 *usecase.SchemaMigrationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.SchemaMigration, bool) (*usecase.SchemaMigrationReport, error)); ok {
+		return rf(ctx, contentTypeID, migration, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.SchemaMigration, bool) *usecase.SchemaMigrationReport); ok {
+		r0 = rf(ctx, contentTypeID, migration, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.SchemaMigrationReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.SchemaMigration, bool) error); ok {
+		r1 = rf(ctx, contentTypeID, migration, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_MigrateContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateContentType'
+type ContentUsecase_MigrateContentType_Call struct {
+	*mock.Call
+}
+
+// MigrateContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentTypeID uuid.UUID
+//   - migration entity.SchemaMigration
+//   - dryRun bool
+func (_e *ContentUsecase_Expecter) MigrateContentType(ctx interface{}, contentTypeID interface{}, migration interface{}, dryRun interface{}) *ContentUsecase_MigrateContentType_Call {
+	return &ContentUsecase_MigrateContentType_Call{Call: _e.mock.On("MigrateContentType", ctx, contentTypeID, migration, dryRun)}
+}
+
+func (_c *ContentUsecase_MigrateContentType_Call) Run(run func(ctx context.Context, contentTypeID uuid.UUID, migration entity.SchemaMigration, dryRun bool)) *ContentUsecase_MigrateContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.SchemaMigration), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_MigrateContentType_Call) Return(_a0 *usecase.SchemaMigrationReport, _a1 error) *ContentUsecase_MigrateContentType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_MigrateContentType_Call) RunAndReturn(run func(context.Context, uuid.UUID, entity.SchemaMigration, bool) (*usecase.SchemaMigrationReport, error)) *ContentUsecase_MigrateContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveBlock provides a mock function with given fields: ctx, contentID, blockID, input
+func (_m *ContentUsecase) MoveBlock(ctx context.Context, contentID uuid.UUID, blockID uuid.UUID, input usecase.MoveBlockInput) (*entity.Content, error) {
+	ret := _m.Called(ctx, contentID, blockID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveBlock")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, usecase.MoveBlockInput) (*entity.Content, error)); ok {
+		return rf(ctx, contentID, blockID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, usecase.MoveBlockInput) *entity.Content); ok {
+		r0 = rf(ctx, contentID, blockID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, usecase.MoveBlockInput) error); ok {
+		r1 = rf(ctx, contentID, blockID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_MoveBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveBlock'
+type ContentUsecase_MoveBlock_Call struct {
+	*mock.Call
+}
+
+// MoveBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - blockID uuid.UUID
+//   - input usecase.MoveBlockInput
+func (_e *ContentUsecase_Expecter) MoveBlock(ctx interface{}, contentID interface{}, blockID interface{}, input interface{}) *ContentUsecase_MoveBlock_Call {
+	return &ContentUsecase_MoveBlock_Call{Call: _e.mock.On("MoveBlock", ctx, contentID, blockID, input)}
+}
+
+func (_c *ContentUsecase_MoveBlock_Call) Run(run func(ctx context.Context, contentID uuid.UUID, blockID uuid.UUID, input usecase.MoveBlockInput)) *ContentUsecase_MoveBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(usecase.MoveBlockInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_MoveBlock_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_MoveBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_MoveBlock_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, usecase.MoveBlockInput) (*entity.Content, error)) *ContentUsecase_MoveBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchContent provides a mock function with given fields: ctx, id, input
+func (_m *ContentUsecase) PatchContent(ctx context.Context, id uuid.UUID, input usecase.PatchContentInput) (*entity.Content, error) {
+	ret := _m.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchContent")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, usecase.PatchContentInput) (*entity.Content, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, usecase.PatchContentInput) *entity.Content); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, usecase.PatchContentInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_PatchContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchContent'
+type ContentUsecase_PatchContent_Call struct {
+	*mock.Call
+}
+
+// PatchContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - input usecase.PatchContentInput
+func (_e *ContentUsecase_Expecter) PatchContent(ctx interface{}, id interface{}, input interface{}) *ContentUsecase_PatchContent_Call {
+	return &ContentUsecase_PatchContent_Call{Call: _e.mock.On("PatchContent", ctx, id, input)}
+}
+
+func (_c *ContentUsecase_PatchContent_Call) Run(run func(ctx context.Context, id uuid.UUID, input usecase.PatchContentInput)) *ContentUsecase_PatchContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(usecase.PatchContentInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_PatchContent_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_PatchContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_PatchContent_Call) RunAndReturn(run func(context.Context, uuid.UUID, usecase.PatchContentInput) (*entity.Content, error)) *ContentUsecase_PatchContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeContent provides a mock function with given fields: ctx, id, policy
+func (_m *ContentUsecase) PurgeContent(ctx context.Context, id uuid.UUID, policy entity.ReferrerPolicy) error {
+	ret := _m.Called(ctx, id, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.ReferrerPolicy) error); ok {
+		r0 = rf(ctx, id, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentUsecase_PurgeContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeContent'
+type ContentUsecase_PurgeContent_Call struct {
+	*mock.Call
+}
+
+// PurgeContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - policy entity.ReferrerPolicy
+func (_e *ContentUsecase_Expecter) PurgeContent(ctx interface{}, id interface{}, policy interface{}) *ContentUsecase_PurgeContent_Call {
+	return &ContentUsecase_PurgeContent_Call{Call: _e.mock.On("PurgeContent", ctx, id, policy)}
+}
+
+func (_c *ContentUsecase_PurgeContent_Call) Run(run func(ctx context.Context, id uuid.UUID, policy entity.ReferrerPolicy)) *ContentUsecase_PurgeContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.ReferrerPolicy))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_PurgeContent_Call) Return(_a0 error) *ContentUsecase_PurgeContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentUsecase_PurgeContent_Call) RunAndReturn(run func(context.Context, uuid.UUID, entity.ReferrerPolicy) error) *ContentUsecase_PurgeContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTrash provides a mock function with given fields: ctx, olderThan
+func (_m *ContentUsecase) PurgeTrash(ctx context.Context, olderThan time.Duration) (*usecase.PurgeResult, error) {
+	ret := _m.Called(ctx, olderThan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 *usecase.PurgeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (*usecase.PurgeResult, error)); ok {
+		return rf(ctx, olderThan)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) *usecase.PurgeResult); ok {
+		r0 = rf(ctx, olderThan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.PurgeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, olderThan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_PurgeTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrash'
+type ContentUsecase_PurgeTrash_Call struct {
+	*mock.Call
+}
+
+// PurgeTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - olderThan time.Duration
+func (_e *ContentUsecase_Expecter) PurgeTrash(ctx interface{}, olderThan interface{}) *ContentUsecase_PurgeTrash_Call {
+	return &ContentUsecase_PurgeTrash_Call{Call: _e.mock.On("PurgeTrash", ctx, olderThan)}
+}
+
+func (_c *ContentUsecase_PurgeTrash_Call) Run(run func(ctx context.Context, olderThan time.Duration)) *ContentUsecase_PurgeTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_PurgeTrash_Call) Return(_a0 *usecase.PurgeResult, _a1 error) *ContentUsecase_PurgeTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_PurgeTrash_Call) RunAndReturn(run func(context.Context, time.Duration) (*usecase.PurgeResult, error)) *ContentUsecase_PurgeTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreContentVersion provides a mock function with given fields: ctx, contentID, version, expectedVersion
+func (_m *ContentUsecase) RestoreContentVersion(ctx context.Context, contentID uuid.UUID, version int, expectedVersion int) (*entity.Content, error) {
+	ret := _m.Called(ctx, contentID, version, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreContentVersion")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) (*entity.Content, error)); ok {
+		return rf(ctx, contentID, version, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) *entity.Content); ok {
+		r0 = rf(ctx, contentID, version, expectedVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r1 = rf(ctx, contentID, version, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_RestoreContentVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreContentVersion'
+type ContentUsecase_RestoreContentVersion_Call struct {
+	*mock.Call
+}
+
+// RestoreContentVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - version int
+//   - expectedVersion int
+func (_e *ContentUsecase_Expecter) RestoreContentVersion(ctx interface{}, contentID interface{}, version interface{}, expectedVersion interface{}) *ContentUsecase_RestoreContentVersion_Call {
+	return &ContentUsecase_RestoreContentVersion_Call{Call: _e.mock.On("RestoreContentVersion", ctx, contentID, version, expectedVersion)}
+}
+
+func (_c *ContentUsecase_RestoreContentVersion_Call) Run(run func(ctx context.Context, contentID uuid.UUID, version int, expectedVersion int)) *ContentUsecase_RestoreContentVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_RestoreContentVersion_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_RestoreContentVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_RestoreContentVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, int) (*entity.Content, error)) *ContentUsecase_RestoreContentVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduleContent provides a mock function with given fields: ctx, id, input
+func (_m *ContentUsecase) ScheduleContent(ctx context.Context, id uuid.UUID, input usecase.ScheduleContentInput) (*entity.Content, error) {
+	ret := _m.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleContent")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, usecase.ScheduleContentInput) (*entity.Content, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, usecase.ScheduleContentInput) *entity.Content); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, usecase.ScheduleContentInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_ScheduleContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleContent'
+type ContentUsecase_ScheduleContent_Call struct {
+	*mock.Call
+}
+
+// ScheduleContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - input usecase.ScheduleContentInput
+func (_e *ContentUsecase_Expecter) ScheduleContent(ctx interface{}, id interface{}, input interface{}) *ContentUsecase_ScheduleContent_Call {
+	return &ContentUsecase_ScheduleContent_Call{Call: _e.mock.On("ScheduleContent", ctx, id, input)}
+}
+
+func (_c *ContentUsecase_ScheduleContent_Call) Run(run func(ctx context.Context, id uuid.UUID, input usecase.ScheduleContentInput)) *ContentUsecase_ScheduleContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(usecase.ScheduleContentInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_ScheduleContent_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_ScheduleContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_ScheduleContent_Call) RunAndReturn(run func(context.Context, uuid.UUID, usecase.ScheduleContentInput) (*entity.Content, error)) *ContentUsecase_ScheduleContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchContents provides a mock function with given fields: ctx, input
+func (_m *ContentUsecase) SearchContents(ctx context.Context, input usecase.GetContentsInput) (*usecase.SearchList, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchContents")
+	}
+
+	var r0 *usecase.SearchList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.GetContentsInput) (*usecase.SearchList, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.GetContentsInput) *usecase.SearchList); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.SearchList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecase.GetContentsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_SearchContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchContents'
+type ContentUsecase_SearchContents_Call struct {
+	*mock.Call
+}
+
+// SearchContents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input usecase.GetContentsInput
+func (_e *ContentUsecase_Expecter) SearchContents(ctx interface{}, input interface{}) *ContentUsecase_SearchContents_Call {
+	return &ContentUsecase_SearchContents_Call{Call: _e.mock.On("SearchContents", ctx, input)}
+}
+
+func (_c *ContentUsecase_SearchContents_Call) Run(run func(ctx context.Context, input usecase.GetContentsInput)) *ContentUsecase_SearchContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.GetContentsInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_SearchContents_Call) Return(_a0 *usecase.SearchList, _a1 error) *ContentUsecase_SearchContents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_SearchContents_Call) RunAndReturn(run func(context.Context, usecase.GetContentsInput) (*usecase.SearchList, error)) *ContentUsecase_SearchContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBlockVisibility provides a mock function with given fields: ctx, contentID, blockID, input
+func (_m *ContentUsecase) SetBlockVisibility(ctx context.Context, contentID uuid.UUID, blockID uuid.UUID, input usecase.BlockVisibilityInput) (*entity.Content, error) {
+	ret := _m.Called(ctx, contentID, blockID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBlockVisibility")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, usecase.BlockVisibilityInput) (*entity.Content, error)); ok {
+		return rf(ctx, contentID, blockID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, usecase.BlockVisibilityInput) *entity.Content); ok {
+		r0 = rf(ctx, contentID, blockID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, usecase.BlockVisibilityInput) error); ok {
+		r1 = rf(ctx, contentID, blockID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_SetBlockVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBlockVisibility'
+type ContentUsecase_SetBlockVisibility_Call struct {
+	*mock.Call
+}
+
+// SetBlockVisibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - blockID uuid.UUID
+//   - input usecase.BlockVisibilityInput
+func (_e *ContentUsecase_Expecter) SetBlockVisibility(ctx interface{}, contentID interface{}, blockID interface{}, input interface{}) *ContentUsecase_SetBlockVisibility_Call {
+	return &ContentUsecase_SetBlockVisibility_Call{Call: _e.mock.On("SetBlockVisibility", ctx, contentID, blockID, input)}
+}
+
+func (_c *ContentUsecase_SetBlockVisibility_Call) Run(run func(ctx context.Context, contentID uuid.UUID, blockID uuid.UUID, input usecase.BlockVisibilityInput)) *ContentUsecase_SetBlockVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(usecase.BlockVisibilityInput))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_SetBlockVisibility_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_SetBlockVisibility_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_SetBlockVisibility_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, usecase.BlockVisibilityInput) (*entity.Content, error)) *ContentUsecase_SetBlockVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionContent provides a mock function with given fields: ctx, id, action, expectedVersion, policy
+func (_m *ContentUsecase) TransitionContent(ctx context.Context, id uuid.UUID, action entity.ContentAction, expectedVersion int, policy entity.ReferrerPolicy) (*entity.Content, error) {
+	ret := _m.Called(ctx, id, action, expectedVersion, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionContent")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.ContentAction, int, entity.ReferrerPolicy) (*entity.Content, error)); ok {
+		return rf(ctx, id, action, expectedVersion, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.ContentAction, int, entity.ReferrerPolicy) *entity.Content); ok {
+		r0 = rf(ctx, id, action, expectedVersion, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.ContentAction, int, entity.ReferrerPolicy) error); ok {
+		r1 = rf(ctx, id, action, expectedVersion, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_TransitionContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionContent'
+type ContentUsecase_TransitionContent_Call struct {
+	*mock.Call
+}
+
+// TransitionContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - action entity.ContentAction
+//   - expectedVersion int
+//   - policy entity.ReferrerPolicy
+func (_e *ContentUsecase_Expecter) TransitionContent(ctx interface{}, id interface{}, action interface{}, expectedVersion interface{}, policy interface{}) *ContentUsecase_TransitionContent_Call {
+	return &ContentUsecase_TransitionContent_Call{Call: _e.mock.On("TransitionContent", ctx, id, action, expectedVersion, policy)}
+}
+
+func (_c *ContentUsecase_TransitionContent_Call) Run(run func(ctx context.Context, id uuid.UUID, action entity.ContentAction, expectedVersion int, policy entity.ReferrerPolicy)) *ContentUsecase_TransitionContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.ContentAction), args[3].(int), args[4].(entity.ReferrerPolicy))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_TransitionContent_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_TransitionContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_TransitionContent_Call) RunAndReturn(run func(context.Context, uuid.UUID, entity.ContentAction, int, entity.ReferrerPolicy) (*entity.Content, error)) *ContentUsecase_TransitionContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnscheduleContent provides a mock function with given fields: ctx, id, expectedVersion
+func (_m *ContentUsecase) UnscheduleContent(ctx context.Context, id uuid.UUID, expectedVersion int) (*entity.Content, error) {
+	ret := _m.Called(ctx, id, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnscheduleContent")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (*entity.Content, error)); ok {
+		return rf(ctx, id, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) *entity.Content); ok {
+		r0 = rf(ctx, id, expectedVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, id, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_UnscheduleContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnscheduleContent'
+type ContentUsecase_UnscheduleContent_Call struct {
+	*mock.Call
+}
+
+// UnscheduleContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - expectedVersion int
+func (_e *ContentUsecase_Expecter) UnscheduleContent(ctx interface{}, id interface{}, expectedVersion interface{}) *ContentUsecase_UnscheduleContent_Call {
+	return &ContentUsecase_UnscheduleContent_Call{Call: _e.mock.On("UnscheduleContent", ctx, id, expectedVersion)}
+}
+
+func (_c *ContentUsecase_UnscheduleContent_Call) Run(run func(ctx context.Context, id uuid.UUID, expectedVersion int)) *ContentUsecase_UnscheduleContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_UnscheduleContent_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_UnscheduleContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_UnscheduleContent_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (*entity.Content, error)) *ContentUsecase_UnscheduleContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, id, category
+func (_m *ContentUsecase) UpdateCategory(ctx context.Context, id uuid.UUID, category *entity.Category) (*entity.Category, error) {
+	ret := _m.Called(ctx, id, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 *entity.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.Category) (*entity.Category, error)); ok {
+		return rf(ctx, id, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.Category) *entity.Category); ok {
+		r0 = rf(ctx, id, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *entity.Category) error); ok {
+		r1 = rf(ctx, id, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type ContentUsecase_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - category *entity.Category
+func (_e *ContentUsecase_Expecter) UpdateCategory(ctx interface{}, id interface{}, category interface{}) *ContentUsecase_UpdateCategory_Call {
+	return &ContentUsecase_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, id, category)}
+}
+
+func (_c *ContentUsecase_UpdateCategory_Call) Run(run func(ctx context.Context, id uuid.UUID, category *entity.Category)) *ContentUsecase_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*entity.Category))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_UpdateCategory_Call) Return(_a0 *entity.Category, _a1 error) *ContentUsecase_UpdateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_UpdateCategory_Call) RunAndReturn(run func(context.Context, uuid.UUID, *entity.Category) (*entity.Category, error)) *ContentUsecase_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateContent provides a mock function with given fields: ctx, id, content
+func (_m *ContentUsecase) UpdateContent(ctx context.Context, id uuid.UUID, content *entity.Content) (*entity.Content, error) {
+	ret := _m.Called(ctx, id, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContent")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.Content) (*entity.Content, error)); ok {
+		return rf(ctx, id, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.Content) *entity.Content); ok {
+		r0 = rf(ctx, id, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *entity.Content) error); ok {
+		r1 = rf(ctx, id, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_UpdateContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateContent'
+type ContentUsecase_UpdateContent_Call struct {
+	*mock.Call
+}
+
+// UpdateContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - content *entity.Content
+func (_e *ContentUsecase_Expecter) UpdateContent(ctx interface{}, id interface{}, content interface{}) *ContentUsecase_UpdateContent_Call {
+	return &ContentUsecase_UpdateContent_Call{Call: _e.mock.On("UpdateContent", ctx, id, content)}
+}
+
+func (_c *ContentUsecase_UpdateContent_Call) Run(run func(ctx context.Context, id uuid.UUID, content *entity.Content)) *ContentUsecase_UpdateContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*entity.Content))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_UpdateContent_Call) Return(_a0 *entity.Content, _a1 error) *ContentUsecase_UpdateContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_UpdateContent_Call) RunAndReturn(run func(context.Context, uuid.UUID, *entity.Content) (*entity.Content, error)) *ContentUsecase_UpdateContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateContentType provides a mock function with given fields: ctx, id, contentType
+func (_m *ContentUsecase) UpdateContentType(ctx context.Context, id uuid.UUID, contentType *entity.ContentType) (*entity.ContentType, error) {
+	ret := _m.Called(ctx, id, contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContentType")
+	}
+
+	var r0 *entity.ContentType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.ContentType) (*entity.ContentType, error)); ok {
+		return rf(ctx, id, contentType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.ContentType) *entity.ContentType); ok {
+		r0 = rf(ctx, id, contentType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *entity.ContentType) error); ok {
+		r1 = rf(ctx, id, contentType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_UpdateContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateContentType'
+type ContentUsecase_UpdateContentType_Call struct {
+	*mock.Call
+}
+
+// UpdateContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - contentType *entity.ContentType
+func (_e *ContentUsecase_Expecter) UpdateContentType(ctx interface{}, id interface{}, contentType interface{}) *ContentUsecase_UpdateContentType_Call {
+	return &ContentUsecase_UpdateContentType_Call{Call: _e.mock.On("UpdateContentType", ctx, id, contentType)}
+}
+
+func (_c *ContentUsecase_UpdateContentType_Call) Run(run func(ctx context.Context, id uuid.UUID, contentType *entity.ContentType)) *ContentUsecase_UpdateContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*entity.ContentType))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_UpdateContentType_Call) Return(_a0 *entity.ContentType, _a1 error) *ContentUsecase_UpdateContentType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_UpdateContentType_Call) RunAndReturn(run func(context.Context, uuid.UUID, *entity.ContentType) (*entity.ContentType, error)) *ContentUsecase_UpdateContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, id, tag
+func (_m *ContentUsecase) UpdateTag(ctx context.Context, id uuid.UUID, tag *entity.Tag) (*entity.Tag, error) {
+	ret := _m.Called(ctx, id, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 *entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.Tag) (*entity.Tag, error)); ok {
+		return rf(ctx, id, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.Tag) *entity.Tag); ok {
+		r0 = rf(ctx, id, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *entity.Tag) error); ok {
+		r1 = rf(ctx, id, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentUsecase_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type ContentUsecase_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - tag *entity.Tag
+func (_e *ContentUsecase_Expecter) UpdateTag(ctx interface{}, id interface{}, tag interface{}) *ContentUsecase_UpdateTag_Call {
+	return &ContentUsecase_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, id, tag)}
+}
+
+func (_c *ContentUsecase_UpdateTag_Call) Run(run func(ctx context.Context, id uuid.UUID, tag *entity.Tag)) *ContentUsecase_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*entity.Tag))
+	})
+	return _c
+}
+
+func (_c *ContentUsecase_UpdateTag_Call) Return(_a0 *entity.Tag, _a1 error) *ContentUsecase_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentUsecase_UpdateTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, *entity.Tag) (*entity.Tag, error)) *ContentUsecase_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewContentUsecase creates a new instance of ContentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewContentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ContentUsecase {
+	mock := &ContentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
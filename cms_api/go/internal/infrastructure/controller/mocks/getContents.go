@@ -1,96 +0,0 @@
-// Code generated by mockery. DO NOT EDIT.
-
-package mocks
-
-import (
-	context "context"
-
-	mock "github.com/stretchr/testify/mock"
-
-	model "cms_api/internal/domain/entity"
-)
-
-// GetContents is an autogenerated mock type for the getContents type
-type GetContents struct {
-	mock.Mock
-}
-
-type GetContents_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *GetContents) EXPECT() *GetContents_Expecter {
-	return &GetContents_Expecter{mock: &_m.Mock}
-}
-
-// GetArticles provides a mock function with given fields: ctx
-func (_m *GetContents) GetArticles(ctx context.Context) ([]model.Article, error) {
-	ret := _m.Called(ctx)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetArticles")
-	}
-
-	var r0 []model.Article
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Article, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) []model.Article); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Article)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// GetContents_GetArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticles'
-type GetContents_GetArticles_Call struct {
-	*mock.Call
-}
-
-// GetArticles is a helper method to define mock.On call
-//   - ctx context.Context
-func (_e *GetContents_Expecter) GetArticles(ctx interface{}) *GetContents_GetArticles_Call {
-	return &GetContents_GetArticles_Call{Call: _e.mock.On("GetArticles", ctx)}
-}
-
-func (_c *GetContents_GetArticles_Call) Run(run func(ctx context.Context)) *GetContents_GetArticles_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
-	})
-	return _c
-}
-
-func (_c *GetContents_GetArticles_Call) Return(_a0 []model.Article, _a1 error) *GetContents_GetArticles_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *GetContents_GetArticles_Call) RunAndReturn(run func(context.Context) ([]model.Article, error)) *GetContents_GetArticles_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// NewGetContents creates a new instance of GetContents. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewGetContents(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *GetContents {
-	mock := &GetContents{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"cms_api/internal/domain/entity"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// エラーコード（api-endpoints.md のエラーコード一覧に対応）
+const (
+	codeInvalidParameter  = "INVALID_PARAMETER"
+	codeInvalidFormat     = "INVALID_FORMAT"
+	codeContentNotFound   = "CONTENT_NOT_FOUND"
+	codeResourceNotFound  = "RESOURCE_NOT_FOUND"
+	codeVersionConflict   = "VERSION_CONFLICT"
+	codeInvalidTransition = "INVALID_STATUS_TRANSITION"
+	codeAlreadyExists     = "ALREADY_EXISTS"
+	codeContentReferenced = "CONTENT_REFERENCED"
+	codeInternalError     = "INTERNAL_ERROR"
+)
+
+// errInvalidFormat はリクエストボディの形式が不正であることを表す
+var errInvalidFormat = errors.New("データ形式が不正です")
+
+// internalErrorMessage は想定外のエラーのメッセージ（内部の詳細はログにのみ出力し、レスポンスには含めない）
+const internalErrorMessage = "内部サーバーエラーが発生しました"
+
+// apiResponse は共通レスポンス形式
+type apiResponse struct {
+	Success bool        `json:"success"`
+	Data    interface{} `json:"data"`
+	Error   *apiError   `json:"error,omitempty"`
+}
+
+// apiError はエラーレスポンスの詳細
+type apiError struct {
+	Code      string      `json:"code"`
+	Message   string      `json:"message"`
+	Details   interface{} `json:"details,omitempty"`
+	Timestamp string      `json:"timestamp"`
+}
+
+// successResponse は成功レスポンスを返します
+func successResponse(c echo.Context, status int, data interface{}) error {
+	return c.JSON(status, apiResponse{
+		Success: true,
+		Data:    data,
+	})
+}
+
+// errorResponse はエラーレスポンスを返します
+func errorResponse(c echo.Context, status int, code, message string) error {
+	return c.JSON(status, apiResponse{
+		Success: false,
+		Error: &apiError{
+			Code:      code,
+			Message:   message,
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		},
+	})
+}
+
+// errorDetailsResponse は詳細（バリデーションの違反の一覧など）を含むエラーレスポンスを返します
+func errorDetailsResponse(c echo.Context, status int, code, message string, details interface{}) error {
+	return c.JSON(status, apiResponse{
+		Success: false,
+		Error: &apiError{
+			Code:      code,
+			Message:   message,
+			Details:   details,
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		},
+	})
+}
+
+// handleError はドメインエラーをHTTPステータスとエラーコードに変換して返します
+// バリデーションの違反の一覧（entity.ValidationErrors）と参照元の一覧（entity.ReferencedError）はdetailsとして返します
+func handleError(c echo.Context, err error) error {
+	var violations entity.ValidationErrors
+	var referenced *entity.ReferencedError
+	switch {
+	case errors.As(err, &violations):
+		return errorDetailsResponse(c, http.StatusBadRequest, codeInvalidParameter, err.Error(), violations)
+	case errors.As(err, &referenced):
+		return errorDetailsResponse(c, http.StatusConflict, codeContentReferenced, err.Error(), referenced.Referrers)
+	case errors.Is(err, entity.ErrInvalidParameter):
+		return errorResponse(c, http.StatusBadRequest, codeInvalidParameter, err.Error())
+	case errors.Is(err, errInvalidFormat):
+		return errorResponse(c, http.StatusBadRequest, codeInvalidFormat, err.Error())
+	case errors.Is(err, entity.ErrContentNotFound):
+		return errorResponse(c, http.StatusNotFound, codeContentNotFound, err.Error())
+	case errors.Is(err, entity.ErrContentTypeNotFound), errors.Is(err, entity.ErrBlockNotFound),
+		errors.Is(err, entity.ErrContentVersionNotFound), errors.Is(err, entity.ErrCategoryNotFound),
+		errors.Is(err, entity.ErrTagNotFound), errors.Is(err, entity.ErrSlugRedirectNotFound):
+		return errorResponse(c, http.StatusNotFound, codeResourceNotFound, err.Error())
+	case errors.Is(err, entity.ErrVersionConflict):
+		return errorResponse(c, http.StatusConflict, codeVersionConflict, err.Error())
+	case errors.Is(err, entity.ErrInvalidStatusTransition):
+		return errorResponse(c, http.StatusConflict, codeInvalidTransition, err.Error())
+	case errors.Is(err, entity.ErrAlreadyExists):
+		return errorResponse(c, http.StatusConflict, codeAlreadyExists, err.Error())
+	default:
+		c.Logger().Errorf("リクエストの処理に失敗しました: %v", err)
+		return errorResponse(c, http.StatusInternalServerError, codeInternalError, internalErrorMessage)
+	}
+}
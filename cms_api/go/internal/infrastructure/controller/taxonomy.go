@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"cms_api/internal/domain/entity"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetCategories godoc
+// @Summary カテゴリ一覧の取得
+// @Description カテゴリ一覧を名前順に取得します
+// @Tags categories
+// @Produce json
+// @Success 200 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /categories [get]
+func (cc *ContentController) GetCategories(c echo.Context) error {
+	list, err := cc.contentUsecase.GetCategories(c.Request().Context())
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, list)
+}
+
+// GetCategoryByID godoc
+// @Summary カテゴリの取得
+// @Description 指定されたIDのカテゴリを取得します
+// @Tags categories
+// @Produce json
+// @Param id path string true "カテゴリID (UUID)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /categories/{id} [get]
+func (cc *ContentController) GetCategoryByID(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	result, err := cc.contentUsecase.GetCategoryByID(c.Request().Context(), id)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, result)
+}
+
+// CreateCategory godoc
+// @Summary カテゴリの作成
+// @Description カテゴリを作成します。スラッグはカテゴリの中で一意である必要があります
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category body entity.Category true "作成するカテゴリ"
+// @Success 201 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /categories [post]
+func (cc *ContentController) CreateCategory(c echo.Context) error {
+	var category entity.Category
+	if err := bindJSON(c, &category); err != nil {
+		return handleError(c, err)
+	}
+
+	created, err := cc.contentUsecase.CreateCategory(c.Request().Context(), &category)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusCreated, created)
+}
+
+// UpdateCategory godoc
+// @Summary カテゴリの更新
+// @Description 指定されたIDのカテゴリを送信内容で置き換えます
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "カテゴリID (UUID)"
+// @Param category body entity.Category true "更新後のカテゴリ"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /categories/{id} [put]
+func (cc *ContentController) UpdateCategory(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var category entity.Category
+	if err := bindJSON(c, &category); err != nil {
+		return handleError(c, err)
+	}
+
+	updated, err := cc.contentUsecase.UpdateCategory(c.Request().Context(), id, &category)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, updated)
+}
+
+// DeleteCategory godoc
+// @Summary カテゴリの削除
+// @Description 指定されたIDのカテゴリを削除します。属していたコンテンツはカテゴリなしになります
+// @Tags categories
+// @Param id path string true "カテゴリID (UUID)"
+// @Success 204
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /categories/{id} [delete]
+func (cc *ContentController) DeleteCategory(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	if err := cc.contentUsecase.DeleteCategory(c.Request().Context(), id); err != nil {
+		return handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetTags godoc
+// @Summary タグ一覧の取得
+// @Description タグ一覧を名前順に取得します
+// @Tags tags
+// @Produce json
+// @Success 200 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /tags [get]
+func (cc *ContentController) GetTags(c echo.Context) error {
+	list, err := cc.contentUsecase.GetTags(c.Request().Context())
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, list)
+}
+
+// GetTagByID godoc
+// @Summary タグの取得
+// @Description 指定されたIDのタグを取得します
+// @Tags tags
+// @Produce json
+// @Param id path string true "タグID (UUID)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /tags/{id} [get]
+func (cc *ContentController) GetTagByID(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	result, err := cc.contentUsecase.GetTagByID(c.Request().Context(), id)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, result)
+}
+
+// CreateTag godoc
+// @Summary タグの作成
+// @Description タグを作成します。スラッグはタグの中で一意である必要があります
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tag body entity.Tag true "作成するタグ"
+// @Success 201 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /tags [post]
+func (cc *ContentController) CreateTag(c echo.Context) error {
+	var tag entity.Tag
+	if err := bindJSON(c, &tag); err != nil {
+		return handleError(c, err)
+	}
+
+	created, err := cc.contentUsecase.CreateTag(c.Request().Context(), &tag)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusCreated, created)
+}
+
+// UpdateTag godoc
+// @Summary タグの更新
+// @Description 指定されたIDのタグを送信内容で置き換えます
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path string true "タグID (UUID)"
+// @Param tag body entity.Tag true "更新後のタグ"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 409 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /tags/{id} [put]
+func (cc *ContentController) UpdateTag(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	var tag entity.Tag
+	if err := bindJSON(c, &tag); err != nil {
+		return handleError(c, err)
+	}
+
+	updated, err := cc.contentUsecase.UpdateTag(c.Request().Context(), id, &tag)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, updated)
+}
+
+// DeleteTag godoc
+// @Summary タグの削除
+// @Description 指定されたIDのタグを削除します。コンテンツとの関連付けも解除されます
+// @Tags tags
+// @Param id path string true "タグID (UUID)"
+// @Success 204
+// @Failure 400 {object} apiResponse
+// @Failure 404 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /tags/{id} [delete]
+func (cc *ContentController) DeleteTag(c echo.Context) error {
+	id, err := pathUUID(c, "id")
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	if err := cc.contentUsecase.DeleteTag(c.Request().Context(), id); err != nil {
+		return handleError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
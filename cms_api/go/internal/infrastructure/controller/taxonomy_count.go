@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetCategoryCounts godoc
+// @Summary カテゴリ別のコンテンツ数の取得
+// @Description すべてのカテゴリと、そのカテゴリに属する公開中のコンテンツ数を名前順に取得します
+// @Tags categories
+// @Produce json
+// @Success 200 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /categories/counts [get]
+func (cc *ContentController) GetCategoryCounts(c echo.Context) error {
+	counts, err := cc.contentUsecase.GetCategoryCounts(c.Request().Context())
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, counts)
+}
+
+// GetTagCounts godoc
+// @Summary タグ別のコンテンツ数の取得
+// @Description すべてのタグと、そのタグが付いた公開中のコンテンツ数を名前順に取得します
+// @Tags tags
+// @Produce json
+// @Success 200 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /tags/counts [get]
+func (cc *ContentController) GetTagCounts(c echo.Context) error {
+	counts, err := cc.contentUsecase.GetTagCounts(c.Request().Context())
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, counts)
+}
+
+// GetArchiveCounts godoc
+// @Summary 年月別アーカイブの取得
+// @Description 公開中のコンテンツを公開年月（日本時間）ごとに集計し、新しい順に取得します
+// @Tags archives
+// @Produce json
+// @Success 200 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /archives [get]
+func (cc *ContentController) GetArchiveCounts(c echo.Context) error {
+	counts, err := cc.contentUsecase.GetArchiveCounts(c.Request().Context())
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, counts)
+}
+
+// GetArchiveContents godoc
+// @Summary 年月別アーカイブのコンテンツ一覧の取得
+// @Description 指定された年月（日本時間）に公開された、公開中のコンテンツ一覧をページネーション付きで取得します
+// @Tags archives
+// @Produce json
+// @Param year path int true "公開年"
+// @Param month path int true "公開月 (1-12)"
+// @Param limit query int false "取得件数 (1-100)"
+// @Param offset query int false "オフセット (0以上)"
+// @Param category query string false "カテゴリのスラッグまたはID"
+// @Param tags query string false "タグのスラッグまたはID（カンマ区切り）"
+// @Param sort query string false "ソート対象 (createdAt, updatedAt, publishedAt, title。カンマ区切りで複数指定可)"
+// @Param order query string false "ソート順 (asc, desc)"
+// @Success 200 {object} apiResponse
+// @Failure 400 {object} apiResponse
+// @Failure 500 {object} apiResponse
+// @Router /archives/{year}/{month} [get]
+func (cc *ContentController) GetArchiveContents(c echo.Context) error {
+	year, err := pathInt(c, "year")
+	if err != nil {
+		return handleError(c, err)
+	}
+	month, err := pathInt(c, "month")
+	if err != nil {
+		return handleError(c, err)
+	}
+	input, err := contentsInput(c)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	list, err := cc.contentUsecase.GetArchiveContents(c.Request().Context(), year, month, input)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	return successResponse(c, http.StatusOK, list)
+}
@@ -0,0 +1,210 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"cms_api/internal/domain/entity"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// CreateCategoryのテスト
+func (s *contentsControllerTestSuite) TestCreateCategory() {
+	testCases := []struct {
+		name           string
+		body           string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "正常系：カテゴリを作成できる場合",
+			body: `{"name":"AWS","slug":"aws"}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().CreateCategory(mock.Anything, &entity.Category{Name: "AWS", Slug: "aws"}).
+					Return(&entity.Category{ID: uuid.New(), Name: "AWS", Slug: "aws"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "異常系：スラッグが重複する場合",
+			body: `{"name":"AWS","slug":"aws"}`,
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().CreateCategory(mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("スラッグが%w: aws", entity.ErrAlreadyExists))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "ALREADY_EXISTS",
+		},
+		{
+			name:           "異常系：JSONが不正な場合",
+			body:           `{"name":`,
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_FORMAT",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodPost, "/categories", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+
+			assert.NoError(s.T(), s.controller.CreateCategory(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// DeleteTagのテスト
+func (s *contentsControllerTestSuite) TestDeleteTag() {
+	tagID := uuid.New()
+	testCases := []struct {
+		name           string
+		setup          setupFunc
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "正常系：タグを削除できる場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteTag(mock.Anything, tagID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "異常系：タグが存在しない場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteTag(mock.Anything, tagID).
+					Return(fmt.Errorf("削除対象の%w: %s", entity.ErrTagNotFound, tagID))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "RESOURCE_NOT_FOUND",
+		},
+		{
+			name: "異常系：削除でエラーが発生する場合",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().DeleteTag(mock.Anything, tagID).Return(errors.New("削除エラー"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   "INTERNAL_ERROR",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tagID.String())
+
+			assert.NoError(s.T(), s.controller.DeleteTag(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+// GetTagCountsのテスト
+func (s *contentsControllerTestSuite) TestGetTagCounts() {
+	s.Run("正常系：タグとコンテンツ数が同じ階層で返る場合", func() {
+		s.setup(func(s *contentsControllerTestSuite) {
+			s.mockUsecase.EXPECT().GetTagCounts(mock.Anything).
+				Return([]*entity.TagCount{{Tag: entity.Tag{ID: uuid.New(), Name: "Go", Slug: "go"}, Count: 2}}, nil)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/tags/counts", nil)
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+
+		assert.NoError(s.T(), s.controller.GetTagCounts(c))
+		assert.Equal(s.T(), http.StatusOK, rec.Code)
+		data := s.decodeResponse(rec)["data"].([]interface{})
+		assert.Equal(s.T(), "go", data[0].(map[string]interface{})["slug"])
+		assert.Equal(s.T(), float64(2), data[0].(map[string]interface{})["count"])
+	})
+}
+
+// GetArchiveContentsのテスト
+func (s *contentsControllerTestSuite) TestGetArchiveContents() {
+	testCases := []struct {
+		name           string
+		year, month    string
+		query          string
+		setup          func(s *contentsControllerTestSuite)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:  "正常系：年月とクエリパラメータがユースケースに渡る場合",
+			year:  "2025",
+			month: "1",
+			query: "?limit=5&tags=go",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetArchiveContents(mock.Anything, 2025, 1, usecase.GetContentsInput{Limit: 5, Tags: []string{"go"}}).
+					Return(&usecase.ContentList{Contents: []*entity.Content{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "異常系：月が数値でない場合",
+			year:           "2025",
+			month:          "jan",
+			setup:          func(s *contentsControllerTestSuite) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+		{
+			name:  "異常系：ユースケースで不正な年月と判定された場合",
+			year:  "2025",
+			month: "13",
+			setup: func(s *contentsControllerTestSuite) {
+				s.mockUsecase.EXPECT().GetArchiveContents(mock.Anything, 2025, 13, mock.Anything).
+					Return(nil, entity.ErrInvalidParameter)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_PARAMETER",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setup(tc.setup)
+
+			req := httptest.NewRequest(http.MethodGet, "/archives/"+tc.year+"/"+tc.month+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := s.echo.NewContext(req, rec)
+			c.SetParamNames("year", "month")
+			c.SetParamValues(tc.year, tc.month)
+
+			assert.NoError(s.T(), s.controller.GetArchiveContents(c))
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				body := s.decodeResponse(rec)
+				assert.Equal(s.T(), tc.expectedCode, body["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
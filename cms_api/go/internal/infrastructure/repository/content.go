@@ -4,6 +4,7 @@ import (
 	"cms_api/internal/domain/entity"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -13,26 +14,115 @@ import (
 type ContentRepository interface {
 	// コンテンツ操作
 	GetContentByID(ctx context.Context, id uuid.UUID) (*entity.Content, error)
+	// GetContentBySlug はコンテンツタイプ名とスラッグでコンテンツを取得します（ゴミ箱のコンテンツは除く）
+	GetContentBySlug(ctx context.Context, contentTypeName, slug string) (*entity.Content, error)
+	// ContentSlugExists はコンテンツタイプ内でexceptID以外のコンテンツがスラッグを使っているかを確認します
+	ContentSlugExists(ctx context.Context, contentTypeID uuid.UUID, slug string, exceptID uuid.UUID) (bool, error)
+	// GetSlugRedirect はコンテンツタイプ名と変更前のスラッグでリダイレクトを取得します
+	GetSlugRedirect(ctx context.Context, contentTypeName, slug string) (*entity.SlugRedirect, error)
+	// GetSlugRedirects はコンテンツへのリダイレクトを新しい順に取得します
+	GetSlugRedirects(ctx context.Context, contentID uuid.UUID) ([]*entity.SlugRedirect, error)
+	// DeleteSlugRedirect はコンテンツへのリダイレクトを削除します（存在しない場合はErrSlugRedirectNotFound）
+	DeleteSlugRedirect(ctx context.Context, contentID, redirectID uuid.UUID) error
+	// GetContentTypeNames はコンテンツIDごとのコンテンツタイプ名を返します（存在しないコンテンツとゴミ箱のコンテンツは含まない）
+	GetContentTypeNames(ctx context.Context, contentIDs []uuid.UUID) (map[uuid.UUID]string, error)
+	// GetContentsByIDs はIDのコンテンツをブロックと共にまとめて取得します（存在しないコンテンツとゴミ箱のコンテンツは含まない）
+	GetContentsByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Content, error)
+	// GetReferrers は参照ブロックでコンテンツを参照しているコンテンツ（ゴミ箱のものを含む）を返します
+	GetReferrers(ctx context.Context, contentID uuid.UUID) ([]entity.Referrer, error)
 	GetContents(ctx context.Context, limit, offset int, filters ContentFilters) ([]*entity.Content, int64, error)
+	// GetContentsByKeyset は基準位置の次（または前）のコンテンツを最大limit件取得し、さらに先があるかを返します（総数は数えません）
+	GetContentsByKeyset(ctx context.Context, limit int, keyset Keyset, filters ContentFilters) ([]*entity.Content, bool, error)
+	// SearchContents はfilters.Termsに一致するコンテンツを関連度の高い順に取得します（抜粋は含みません）
+	SearchContents(ctx context.Context, limit, offset int, filters ContentFilters) ([]*entity.SearchHit, int64, error)
 	CreateContent(ctx context.Context, content *entity.Content) error
+	// UpdateContent は公開中のコンテンツのスラッグが変わる場合、変更前のスラッグをリダイレクトとして記録します
 	UpdateContent(ctx context.Context, content *entity.Content) error
-	DeleteContent(ctx context.Context, id uuid.UUID) error
+	// UpdateContentStatus はステータス・公開日時・公開予約のみを更新します（content.Versionが一致する場合のみ）
+	UpdateContentStatus(ctx context.Context, content *entity.Content) error
+	// UpdateReferencedContentStatus は参照元をpolicyに従って処理してから、ステータス・公開日時・公開予約を更新します
+	// 参照元（ゴミ箱のものを除く）があり、policyがReferrerPolicyRestrictの場合は*entity.ReferencedErrorを返します
+	UpdateReferencedContentStatus(ctx context.Context, content *entity.Content, policy entity.ReferrerPolicy) error
+	// GetDueContents はnowの時点で公開予約日時または公開終了日時を過ぎたコンテンツを取得します（ブロックは含みません）
+	GetDueContents(ctx context.Context, now time.Time, limit int) ([]*entity.Content, error)
 	
-	// コンテンツタイプ操作
-	GetContentTypes(ctx context.Context) ([]*entity.ContentType, error)
+	// ブロック操作（いずれもexpectedVersionが一致する場合のみ実行し、コンテンツのバージョンを進める）
+	InsertBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, block *entity.ContentBlock, position int) error
+	MoveBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID, position int) error
+	SetBlockVisibility(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID, visible bool) error
+	DeleteBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID) error
+	
+	// ゴミ箱操作（完全削除はブロック・ブロックデータ・版を含めて物理削除する）
+	// PurgeContent はゴミ箱のコンテンツを完全に削除します（ゴミ箱以外の場合はErrInvalidStatusTransition）
+	// 参照元はUpdateReferencedContentStatusと同様にpolicyに従って処理します
+	PurgeContent(ctx context.Context, id uuid.UUID, policy entity.ReferrerPolicy) error
+	// PurgeTrashedContents はbefore以前にゴミ箱へ移動したコンテンツを最大limit件完全に削除し、削除したIDを返します
+	// 削除したコンテンツへの参照ブロックは参照先を外して非表示にし、参照元のバージョンを進めて版を保存します
+	// 参照元が同時に更新された場合はErrVersionConflictを返し、何も削除しません
+	PurgeTrashedContents(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error)
+	
+	// 版操作（版はコンテンツの作成・更新のたびに自動で保存される）
+	GetContentVersions(ctx context.Context, contentID uuid.UUID) ([]*entity.ContentVersion, error)
+	GetContentVersion(ctx context.Context, contentID uuid.UUID, version int) (*entity.ContentVersion, error)
+	
+	// コンテンツタイプ操作（GetContentTypeByIDとUpdateContentTypeは有効なコンテンツタイプのみを対象とする）
+	GetContentTypes(ctx context.Context, includeInactive bool) ([]*entity.ContentType, error)
 	GetContentTypeByID(ctx context.Context, id uuid.UUID) (*entity.ContentType, error)
+	// CreateContentType は名前が重複する場合はErrAlreadyExistsを返します
 	CreateContentType(ctx context.Context, contentType *entity.ContentType) error
+	// UpdateContentType は表示名・説明・アイコン・フィールドの定義を更新します（名前は変更しない）
+	// フィールドの定義が変わる場合は版を進めて保存します
+	UpdateContentType(ctx context.Context, contentType *entity.ContentType) error
+	// SetContentTypeActive はコンテンツタイプを有効化・無効化します（無効なコンテンツタイプではコンテンツを作成できない）
+	SetContentTypeActive(ctx context.Context, id uuid.UUID, active bool) error
+	// GetContentTypeSchemaVersions はフィールドの定義の版を新しい順に取得します
+	GetContentTypeSchemaVersions(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error)
+	// GetContentsByContentType はコンテンツタイプのすべてのコンテンツ（ゴミ箱を含む）をブロックと共に取得します
+	GetContentsByContentType(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.Content, error)
+	// MigrateContentType はフィールドの定義を移行し、書き換えたコンテンツのブロックを1トランザクションで保存します
+	// contentType.SchemaVersionが最新の版でない場合、またはコンテンツのバージョンが一致しない場合はErrVersionConflictを返します
+	MigrateContentType(ctx context.Context, contentType *entity.ContentType, contents []*entity.Content) error
+	
+	// カテゴリ・タグ操作（スラッグが重複する場合はErrAlreadyExistsを返す）
+	GetCategories(ctx context.Context) ([]*entity.Category, error)
+	GetCategoryByID(ctx context.Context, id uuid.UUID) (*entity.Category, error)
+	CreateCategory(ctx context.Context, category *entity.Category) error
+	UpdateCategory(ctx context.Context, category *entity.Category) error
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+	GetTags(ctx context.Context) ([]*entity.Tag, error)
+	GetTagByID(ctx context.Context, id uuid.UUID) (*entity.Tag, error)
+	CreateTag(ctx context.Context, tag *entity.Tag) error
+	UpdateTag(ctx context.Context, tag *entity.Tag) error
+	DeleteTag(ctx context.Context, id uuid.UUID) error
+	
+	// 集計（いずれもvisibleAtの時点で公開中のコンテンツのみを数える）
+	GetCategoryCounts(ctx context.Context, visibleAt time.Time) ([]*entity.CategoryCount, error)
+	GetTagCounts(ctx context.Context, visibleAt time.Time) ([]*entity.TagCount, error)
+	GetArchiveCounts(ctx context.Context, visibleAt time.Time, timeZone string) ([]*entity.ArchiveCount, error)
 }
 
 // ContentFilters はコンテンツ検索時のフィルター条件
 type ContentFilters struct {
-	Status     *entity.ContentStatus
-	Category   string
-	Tags       []string
-	Search     string
-	Sort       string
-	Order      string
-	AuthorID   string
+	Status *entity.ContentStatus
+	// VisibleAtを指定した場合は、その時点で公開中（公開日時を過ぎ、公開終了日時の前）のコンテンツに絞り込む
+	VisibleAt *time.Time
+	// CategoryはカテゴリのIDまたはスラッグ、Tagsは指定されたすべてのタグ（IDまたはスラッグ）が付いたコンテンツに絞り込む
+	Category string
+	Tags     []string
+	// 日時の範囲（Fromはその日時を含み、Toは含まない）
+	PublishedFrom *time.Time
+	PublishedTo   *time.Time
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	UpdatedFrom   *time.Time
+	UpdatedTo     *time.Time
+	// Termsは正規化済みの検索語（すべての語をタイトル・スラッグ・表示中のブロック本文のいずれかに含むコンテンツに絞り込む）
+	Terms []string
+	// Sortは並び順（未指定の場合はDefaultSort。いずれの場合も最後にIDの昇順で並べる）
+	Sort     []SortKey
+	AuthorID string
+	// WithBlocksがtrueの場合はブロックとブロックデータも取得する（falseの場合はメタデータと抜粋・カバー画像のみ）
+	WithBlocks bool
 }
 
 type contentRepository struct {
@@ -52,44 +142,31 @@ func (r *contentRepository) GetContentByID(ctx context.Context, id uuid.UUID) (*
 	
 	err := r.db.WithContext(ctx).
 		Preload("ContentType").
+		Preload("Category").
+		Preload("Tags", orderTags).
+		Preload("Blocks", orderBlocks).
 		Preload("Blocks.Data").
 		Where("id = ?", id).
 		First(&contentModel).Error
 	
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("コンテンツが見つかりません: %s", id.String())
+			return nil, fmt.Errorf("%w: %s", entity.ErrContentNotFound, id.String())
 		}
 		return nil, fmt.Errorf("コンテンツの取得に失敗しました: %w", err)
 	}
 	
-	return contentModel.ToContentEntity(), nil
+	return contentModel.ToContentEntity()
 }
 
 // GetContents はコンテンツ一覧を取得します
 func (r *contentRepository) GetContents(ctx context.Context, limit, offset int, filters ContentFilters) ([]*entity.Content, int64, error) {
-	query := r.db.WithContext(ctx).Model(&ContentModel{}).
-		Preload("ContentType").
-		Preload("Blocks.Data")
-	
-	// フィルター条件の適用
-	if filters.Status != nil {
-		query = query.Where("status = ?", string(*filters.Status))
-	}
-	
-	if filters.AuthorID != "" {
-		query = query.Where("author_id = ?", filters.AuthorID)
-	}
-	
-	if filters.Search != "" {
-		query = query.Where("title ILIKE ? OR slug ILIKE ?", 
-			"%"+filters.Search+"%", "%"+filters.Search+"%")
-	}
+	query := preloadContents(applyContentFilters(r.db.WithContext(ctx).Model(&ContentModel{}), filters), filters.WithBlocks)
 	
 	// ソート条件の適用
-	orderBy := "created_at DESC" // デフォルト
-	if filters.Sort != "" && filters.Order != "" {
-		orderBy = fmt.Sprintf("%s %s", filters.Sort, filters.Order)
+	orderBy, err := orderByClause(filters.Sort, false)
+	if err != nil {
+		return nil, 0, err
 	}
 	query = query.Order(orderBy)
 	
@@ -102,7 +179,7 @@ func (r *contentRepository) GetContents(ctx context.Context, limit, offset int,
 	
 	// ページネーション適用してモデルを取得
 	var contentModels []ContentModel
-	err := query.Limit(limit).Offset(offset).Find(&contentModels).Error
+	err = query.Limit(limit).Offset(offset).Find(&contentModels).Error
 	if err != nil {
 		return nil, 0, fmt.Errorf("コンテンツ一覧の取得に失敗しました: %w", err)
 	}
@@ -110,12 +187,82 @@ func (r *contentRepository) GetContents(ctx context.Context, limit, offset int,
 	// ドメインエンティティに変換
 	contents := make([]*entity.Content, len(contentModels))
 	for i, model := range contentModels {
-		contents[i] = model.ToContentEntity()
+		content, err := model.ToContentEntity()
+		if err != nil {
+			return nil, 0, err
+		}
+		contents[i] = content
 	}
 	
 	return contents, total, nil
 }
 
+// preloadContents は一覧取得時の関連データを読み込みます（ブロックはwithBlocksがtrueの場合のみ）
+func preloadContents(query *gorm.DB, withBlocks bool) *gorm.DB {
+	query = query.
+		Preload("ContentType").
+		Preload("Category").
+		Preload("Tags", orderTags)
+	if withBlocks {
+		query = query.Preload("Blocks", orderBlocks).Preload("Blocks.Data")
+	}
+	return query
+}
+
+// applyContentFilters はコンテンツの一覧取得・検索に共通のフィルター条件を適用します
+func applyContentFilters(query *gorm.DB, filters ContentFilters) *gorm.DB {
+	// フィルター条件の適用（ステータス未指定の場合はゴミ箱のコンテンツを除く）
+	if filters.Status != nil {
+		query = query.Where("status = ?", string(*filters.Status))
+	} else {
+		query = query.Where("status <> ?", string(entity.ContentStatusTrash))
+	}
+	
+	if filters.VisibleAt != nil {
+		query = query.Where("published_at <= ? AND (expires_at IS NULL OR expires_at > ?)",
+			*filters.VisibleAt, *filters.VisibleAt)
+	}
+	
+	if filters.Category != "" {
+		query = query.Where("category_id IN (SELECT id FROM categories WHERE id::text = ? OR slug = ?)",
+			filters.Category, filters.Category)
+	}
+	
+	if len(filters.Tags) > 0 {
+		query = query.Where(`id IN (
+			SELECT ct.content_id FROM content_tags ct JOIN tags t ON t.id = ct.tag_id
+			WHERE t.id::text IN ? OR t.slug IN ?
+			GROUP BY ct.content_id HAVING COUNT(DISTINCT ct.tag_id) = ?)`,
+			filters.Tags, filters.Tags, len(filters.Tags))
+	}
+	
+	for _, r := range []struct {
+		column   string
+		from, to *time.Time
+	}{
+		{"published_at", filters.PublishedFrom, filters.PublishedTo},
+		{"created_at", filters.CreatedFrom, filters.CreatedTo},
+		{"updated_at", filters.UpdatedFrom, filters.UpdatedTo},
+	} {
+		if r.from != nil {
+			query = query.Where(r.column+" >= ?", *r.from)
+		}
+		if r.to != nil {
+			query = query.Where(r.column+" < ?", *r.to)
+		}
+	}
+	
+	if filters.AuthorID != "" {
+		query = query.Where("author_id = ?", filters.AuthorID)
+	}
+	
+	for _, term := range filters.Terms {
+		query = query.Where(searchCondition, searchConditionArgs(term)...)
+	}
+	
+	return query
+}
+
 // CreateContent は新しいコンテンツを作成します
 func (r *contentRepository) CreateContent(ctx context.Context, content *entity.Content) error {
 	// バリデーション
@@ -137,39 +284,37 @@ func (r *contentRepository) CreateContent(ctx context.Context, content *entity.C
 		// IDを更新（DB生成の場合）
 		content.ID = contentModel.ID
 		
+		// 同じスラッグのリダイレクトは新しいコンテンツを優先して削除
+		if err := releaseSlugRedirect(tx, content.ContentTypeID, content.Slug); err != nil {
+			return err
+		}
+		
+		// カテゴリとタグの関連付け
+		if err := checkCategory(tx, content.CategoryID); err != nil {
+			return err
+		}
+		if err := syncTags(tx, content.ID, content.TagIDs); err != nil {
+			return err
+		}
+		
 		// ブロックがある場合は作成
 		for i := range content.Blocks {
 			content.Blocks[i].ContentID = content.ID
 			
-			var blockModel ContentBlockModel
-			blockModel.FromContentBlockEntity(&content.Blocks[i])
-			
-			if err := tx.Create(&blockModel).Error; err != nil {
-				return fmt.Errorf("コンテンツブロックの作成に失敗しました: %w", err)
-			}
-			
-			content.Blocks[i].ID = blockModel.ID
-			
-			// ブロックデータがある場合は作成
-			if content.Blocks[i].Data != nil {
-				content.Blocks[i].Data.BlockID = content.Blocks[i].ID
-				
-				var dataModel ContentBlockDataModel
-				dataModel.FromContentBlockDataEntity(content.Blocks[i].Data)
-				
-				if err := tx.Create(&dataModel).Error; err != nil {
-					return fmt.Errorf("ブロックデータの作成に失敗しました: %w", err)
-				}
-				
-				content.Blocks[i].Data.ID = dataModel.ID
+			if err := createBlock(tx, &content.Blocks[i]); err != nil {
+				return err
 			}
 		}
 		
-		return nil
+		// 初版のスナップショットを保存
+		return saveVersion(tx, content.ID)
 	})
 }
 
-// UpdateContent はコンテンツを更新します
+// UpdateContent はコンテンツとそのブロックを更新します
+// ブロックは送信順に並び順を振り直し、追加・変更・削除を同一トランザクションで反映します
+// content.Versionが保存済みのバージョンと一致しない場合はErrVersionConflictを返し、
+// 成功時はバージョンを1つ進めてcontent.Versionに反映します
 func (r *contentRepository) UpdateContent(ctx context.Context, content *entity.Content) error {
 	// バリデーション
 	if err := content.Validate(); err != nil {
@@ -180,65 +325,167 @@ func (r *contentRepository) UpdateContent(ctx context.Context, content *entity.C
 	var existing ContentModel
 	if err := r.db.WithContext(ctx).Where("id = ?", content.ID).First(&existing).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("更新対象のコンテンツが見つかりません: %s", content.ID.String())
+			return fmt.Errorf("更新対象の%w: %s", entity.ErrContentNotFound, content.ID.String())
 		}
 		return fmt.Errorf("コンテンツの存在確認に失敗しました: %w", err)
 	}
 	
 	// トランザクション内で更新
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkCategory(tx, content.CategoryID); err != nil {
+			return err
+		}
+		
 		// ドメインエンティティからGormモデルに変換
 		var contentModel ContentModel
 		contentModel.FromContentEntity(content)
 		
-		// コンテンツの更新
-		if err := tx.Save(&contentModel).Error; err != nil {
-			return fmt.Errorf("コンテンツの更新に失敗しました: %w", err)
+		// 期待するバージョンと一致する場合のみ更新し、バージョンを進める
+		result := tx.Model(&ContentModel{}).
+			Where("id = ? AND version = ?", content.ID, content.Version).
+			Updates(map[string]interface{}{
+				"content_type_id": contentModel.ContentTypeID,
+				"title":           contentModel.Title,
+				"slug":            contentModel.Slug,
+				"status":          contentModel.Status,
+				"published_at":    contentModel.PublishedAt,
+				"author_id":       contentModel.AuthorID,
+				"scheduled_at":    contentModel.ScheduledAt,
+				"expires_at":      contentModel.ExpiresAt,
+				"deleted_at":      contentModel.DeletedAt,
+				"category_id":     contentModel.CategoryID,
+				"version":         gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return fmt.Errorf("コンテンツの更新に失敗しました: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s (version=%d, current=%d)",
+				entity.ErrVersionConflict, content.ID.String(), content.Version, existing.Version)
 		}
 		
-		return nil
+		content.Version++
+		
+		// 公開中にスラッグが変わる場合は変更前のスラッグからのリダイレクトを記録
+		if err := recordSlugRedirect(tx, &existing, &contentModel); err != nil {
+			return err
+		}
+		
+		// タグとブロックを送信内容に合わせて同期
+		if err := syncTags(tx, content.ID, content.TagIDs); err != nil {
+			return err
+		}
+		if err := syncBlocks(tx, content); err != nil {
+			return err
+		}
+		
+		// 更新後のスナップショットを保存
+		return saveVersion(tx, content.ID)
 	})
 }
 
-// DeleteContent はコンテンツを削除します
-func (r *contentRepository) DeleteContent(ctx context.Context, id uuid.UUID) error {
-	// 存在確認
-	var contentModel ContentModel
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&contentModel).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("削除対象のコンテンツが見つかりません: %s", id.String())
-		}
-		return fmt.Errorf("コンテンツの存在確認に失敗しました: %w", err)
+// UpdateContentStatus はコンテンツのステータス・公開日時・公開予約を更新します
+// content.Versionが保存済みのバージョンと一致しない場合はErrVersionConflictを返し、
+// 成功時はバージョンを1つ進めてcontent.Versionに反映します
+func (r *contentRepository) UpdateContentStatus(ctx context.Context, content *entity.Content) error {
+	err := r.changeContent(ctx, content.ID, content.Version, func(tx *gorm.DB) error {
+		return updateStatus(tx, content)
+	})
+	if err != nil {
+		return err
 	}
 	
-	// トランザクション内で削除
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// ブロックデータの削除
-		if err := tx.Where("block_id IN (SELECT id FROM content_blocks WHERE content_id = ?)", id).Delete(&ContentBlockDataModel{}).Error; err != nil {
-			return fmt.Errorf("ブロックデータの削除に失敗しました: %w", err)
+	content.Version++
+	return nil
+}
+
+// updateStatus はコンテンツのステータス・公開日時・公開予約・ゴミ箱へ移動した日時を更新します
+func updateStatus(tx *gorm.DB, content *entity.Content) error {
+	err := tx.Model(&ContentModel{}).
+		Where("id = ?", content.ID).
+		Updates(map[string]interface{}{
+			"status":       string(content.Status),
+			"published_at": content.PublishedAt,
+			"scheduled_at": content.ScheduledAt,
+			"expires_at":   content.ExpiresAt,
+			"deleted_at":   content.DeletedAt,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("コンテンツのステータスの更新に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// GetDueContents は公開予約日時を過ぎた下書きと、公開終了日時を過ぎた公開中のコンテンツを取得します
+func (r *contentRepository) GetDueContents(ctx context.Context, now time.Time, limit int) ([]*entity.Content, error) {
+	var contentModels []ContentModel
+	err := r.db.WithContext(ctx).
+		Where("(status = ? AND scheduled_at <= ?) OR (status = ? AND expires_at <= ?)",
+			string(entity.ContentStatusDraft), now, string(entity.ContentStatusPublished), now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&contentModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("予約済みコンテンツの取得に失敗しました: %w", err)
+	}
+	
+	contents := make([]*entity.Content, len(contentModels))
+	for i, model := range contentModels {
+		content, err := model.ToContentEntity()
+		if err != nil {
+			return nil, err
 		}
-		
-		// ブロックの削除
-		if err := tx.Where("content_id = ?", id).Delete(&ContentBlockModel{}).Error; err != nil {
-			return fmt.Errorf("コンテンツブロックの削除に失敗しました: %w", err)
+		contents[i] = content
+	}
+	return contents, nil
+}
+
+// changeContent はバージョンを進めた上でコンテンツを変更し、変更後のスナップショットを保存するまでを1トランザクションで行います
+func (r *contentRepository) changeContent(ctx context.Context, contentID uuid.UUID, expectedVersion int, change func(tx *gorm.DB) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, contentID, expectedVersion); err != nil {
+			return err
 		}
-		
-		// コンテンツの削除
-		if err := tx.Delete(&contentModel).Error; err != nil {
-			return fmt.Errorf("コンテンツの削除に失敗しました: %w", err)
+		if err := change(tx); err != nil {
+			return err
 		}
-		
-		return nil
+		return saveVersion(tx, contentID)
 	})
 }
 
-// GetContentTypes はコンテンツタイプ一覧を取得します
-func (r *contentRepository) GetContentTypes(ctx context.Context) ([]*entity.ContentType, error) {
+// bumpVersion は期待するバージョンと一致する場合のみコンテンツのバージョンを1つ進めます
+// 更新対象の行はトランザクション終了までロックされるため、同一コンテンツへの操作は直列化されます
+func bumpVersion(tx *gorm.DB, contentID uuid.UUID, expectedVersion int) error {
+	result := tx.Model(&ContentModel{}).
+		Where("id = ? AND version = ?", contentID, expectedVersion).
+		Update("version", gorm.Expr("version + 1"))
+	if result.Error != nil {
+		return fmt.Errorf("コンテンツのバージョン更新に失敗しました: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&ContentModel{}).Where("id = ?", contentID).Count(&count).Error; err != nil {
+		return fmt.Errorf("コンテンツの存在確認に失敗しました: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: %s", entity.ErrContentNotFound, contentID.String())
+	}
+	return fmt.Errorf("%w: %s (version=%d)", entity.ErrVersionConflict, contentID.String(), expectedVersion)
+}
+
+// GetContentTypes はコンテンツタイプ一覧を取得します（includeInactiveがfalseの場合は有効なもののみ）
+func (r *contentRepository) GetContentTypes(ctx context.Context, includeInactive bool) ([]*entity.ContentType, error) {
 	var contentTypeModels []ContentTypeModel
 	
-	err := r.db.WithContext(ctx).
-		Where("is_active = ?", true).
-		Order("display_name ASC").
+	query := r.db.WithContext(ctx)
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+	err := query.
+		Order("display_name ASC, id ASC").
 		Find(&contentTypeModels).Error
 	
 	if err != nil {
@@ -248,7 +495,11 @@ func (r *contentRepository) GetContentTypes(ctx context.Context) ([]*entity.Cont
 	// ドメインエンティティに変換
 	contentTypes := make([]*entity.ContentType, len(contentTypeModels))
 	for i, model := range contentTypeModels {
-		contentTypes[i] = model.ToContentTypeEntity()
+		contentType, err := model.ToContentTypeEntity()
+		if err != nil {
+			return nil, err
+		}
+		contentTypes[i] = contentType
 	}
 	
 	return contentTypes, nil
@@ -264,37 +515,44 @@ func (r *contentRepository) GetContentTypeByID(ctx context.Context, id uuid.UUID
 	
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("コンテンツタイプが見つかりません: %s", id.String())
+			return nil, fmt.Errorf("%w: %s", entity.ErrContentTypeNotFound, id.String())
 		}
 		return nil, fmt.Errorf("コンテンツタイプの取得に失敗しました: %w", err)
 	}
 	
-	return contentTypeModel.ToContentTypeEntity(), nil
+	return contentTypeModel.ToContentTypeEntity()
 }
 
 // CreateContentType は新しいコンテンツタイプを作成します
 func (r *contentRepository) CreateContentType(ctx context.Context, contentType *entity.ContentType) error {
 	// バリデーション
 	if err := contentType.Validate(); err != nil {
-		return fmt.Errorf("コンテンツタイプのバリデーションエラー: %w", err)
+		return fmt.Errorf("%w: コンテンツタイプのバリデーションエラー: %v", entity.ErrInvalidParameter, err)
 	}
 	
-	// 名前の重複チェック
+	// 名前の重複チェック（無効なコンテンツタイプを含む）
 	var existing ContentTypeModel
 	err := r.db.WithContext(ctx).Where("name = ?", contentType.Name).First(&existing).Error
 	if err == nil {
-		return fmt.Errorf("同じ名前のコンテンツタイプが既に存在します: %s", contentType.Name)
+		return fmt.Errorf("同じ名前のコンテンツタイプが%w: %s", entity.ErrAlreadyExists, contentType.Name)
 	} else if err != gorm.ErrRecordNotFound {
 		return fmt.Errorf("コンテンツタイプの重複チェックに失敗しました: %w", err)
 	}
 	
-	// ドメインエンティティからGormモデルに変換
+	// ドメインエンティティからGormモデルに変換（フィールドの定義は初版とする）
 	var contentTypeModel ContentTypeModel
+	contentType.SchemaVersion = 1
 	contentTypeModel.FromContentTypeEntity(contentType)
 	
-	// 作成
-	if err := r.db.WithContext(ctx).Create(&contentTypeModel).Error; err != nil {
-		return fmt.Errorf("コンテンツタイプの作成に失敗しました: %w", err)
+	// 作成し、フィールドの定義の初版を保存
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&contentTypeModel).Error; err != nil {
+			return fmt.Errorf("コンテンツタイプの作成に失敗しました: %w", err)
+		}
+		return saveSchemaVersion(tx, &contentTypeModel)
+	})
+	if err != nil {
+		return err
 	}
 	
 	// IDを更新（DB生成の場合）
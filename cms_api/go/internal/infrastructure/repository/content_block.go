@@ -0,0 +1,327 @@
+package repository
+
+import (
+	"bytes"
+	"cms_api/internal/domain/entity"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InsertBlock は指定された位置（1始まり、0の場合は末尾）にブロックを挿入します
+func (r *contentRepository) InsertBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, block *entity.ContentBlock, position int) error {
+	return r.changeContent(ctx, contentID, expectedVersion, func(tx *gorm.DB) error {
+		ids, err := orderedBlockIDs(tx, contentID)
+		if err != nil {
+			return err
+		}
+		if position == 0 {
+			position = len(ids) + 1
+		}
+		if position < 1 || position > len(ids)+1 {
+			return fmt.Errorf("%w: position=%d (1-%d)", entity.ErrInvalidParameter, position, len(ids)+1)
+		}
+
+		block.ID = uuid.Nil
+		block.ContentID = contentID
+		block.BlockOrder = position
+		if err := createBlock(tx, block); err != nil {
+			return err
+		}
+
+		ids = append(ids[:position-1], append([]uuid.UUID{block.ID}, ids[position-1:]...)...)
+		return renumberBlocks(tx, ids)
+	})
+}
+
+// MoveBlock はブロックを指定された位置（1始まり）へ移動します
+func (r *contentRepository) MoveBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID, position int) error {
+	return r.changeContent(ctx, contentID, expectedVersion, func(tx *gorm.DB) error {
+		ids, err := orderedBlockIDs(tx, contentID)
+		if err != nil {
+			return err
+		}
+		index, err := blockIndex(ids, blockID)
+		if err != nil {
+			return err
+		}
+		if position < 1 || position > len(ids) {
+			return fmt.Errorf("%w: position=%d (1-%d)", entity.ErrInvalidParameter, position, len(ids))
+		}
+
+		ids = append(ids[:index], ids[index+1:]...)
+		ids = append(ids[:position-1], append([]uuid.UUID{blockID}, ids[position-1:]...)...)
+		return renumberBlocks(tx, ids)
+	})
+}
+
+// SetBlockVisibility はブロックの表示・非表示を切り替えます
+func (r *contentRepository) SetBlockVisibility(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID, visible bool) error {
+	return r.changeContent(ctx, contentID, expectedVersion, func(tx *gorm.DB) error {
+		result := tx.Model(&ContentBlockModel{}).
+			Where("id = ? AND content_id = ?", blockID, contentID).
+			Update("is_visible", visible)
+		if result.Error != nil {
+			return fmt.Errorf("ブロックの表示設定の更新に失敗しました: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s", entity.ErrBlockNotFound, blockID.String())
+		}
+		return nil
+	})
+}
+
+// DeleteBlock はブロックを削除し、残りのブロックの並び順を詰めます
+func (r *contentRepository) DeleteBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID) error {
+	return r.changeContent(ctx, contentID, expectedVersion, func(tx *gorm.DB) error {
+		ids, err := orderedBlockIDs(tx, contentID)
+		if err != nil {
+			return err
+		}
+		index, err := blockIndex(ids, blockID)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteBlocks(tx, []uuid.UUID{blockID}); err != nil {
+			return err
+		}
+		return renumberBlocks(tx, append(ids[:index], ids[index+1:]...))
+	})
+}
+
+// orderedBlockIDs はコンテンツのブロックIDを並び順に取得します
+func orderedBlockIDs(tx *gorm.DB, contentID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := tx.Model(&ContentBlockModel{}).
+		Where("content_id = ?", contentID).
+		Scopes(orderBlocks).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("コンテンツブロックの取得に失敗しました: %w", err)
+	}
+	return ids, nil
+}
+
+// blockIndex はブロックID一覧の中から指定されたブロックの位置を返します
+func blockIndex(ids []uuid.UUID, blockID uuid.UUID) (int, error) {
+	for i, id := range ids {
+		if id == blockID {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("%w: %s", entity.ErrBlockNotFound, blockID.String())
+}
+
+// renumberBlocks はブロックID一覧の順に並び順を1から振り直します
+func renumberBlocks(tx *gorm.DB, ids []uuid.UUID) error {
+	for i, id := range ids {
+		err := tx.Model(&ContentBlockModel{}).
+			Where("id = ? AND block_order <> ?", id, i+1).
+			Update("block_order", i+1).Error
+		if err != nil {
+			return fmt.Errorf("ブロックの並び順の更新に失敗しました: %w", err)
+		}
+	}
+	return nil
+}
+
+// createBlock はブロックとブロックデータを作成し、生成されたIDをエンティティに反映します
+func createBlock(tx *gorm.DB, block *entity.ContentBlock) error {
+	var blockModel ContentBlockModel
+	blockModel.FromContentBlockEntity(block)
+
+	if err := tx.Create(&blockModel).Error; err != nil {
+		return fmt.Errorf("コンテンツブロックの作成に失敗しました: %w", err)
+	}
+
+	block.ID = blockModel.ID
+
+	// ブロックデータがある場合は作成
+	if block.Data != nil {
+		return createBlockData(tx, block)
+	}
+	return nil
+}
+
+// createBlockData はブロックデータを作成し、生成されたIDをエンティティに反映します
+func createBlockData(tx *gorm.DB, block *entity.ContentBlock) error {
+	block.Data.ID = uuid.Nil
+	block.Data.BlockID = block.ID
+
+	var dataModel ContentBlockDataModel
+	dataModel.FromContentBlockDataEntity(block.Data)
+
+	if err := tx.Create(&dataModel).Error; err != nil {
+		return fmt.Errorf("ブロックデータの作成に失敗しました: %w", err)
+	}
+
+	block.Data.ID = dataModel.ID
+	return nil
+}
+
+// syncBlocks は送信されたブロック一覧と保存済みのブロックを突き合わせ、
+// 新規ブロックの追加・変更されたブロックの更新・送信されなかったブロックの削除を行います
+// 並び順は送信順に1から振り直します
+func syncBlocks(tx *gorm.DB, content *entity.Content) error {
+	var stored []ContentBlockModel
+	if err := tx.Preload("Data").Where("content_id = ?", content.ID).Find(&stored).Error; err != nil {
+		return fmt.Errorf("保存済みブロックの取得に失敗しました: %w", err)
+	}
+
+	storedByID := make(map[uuid.UUID]*ContentBlockModel, len(stored))
+	for i := range stored {
+		storedByID[stored[i].ID] = &stored[i]
+	}
+
+	kept := make(map[uuid.UUID]bool, len(content.Blocks))
+	for i := range content.Blocks {
+		block := &content.Blocks[i]
+		block.ContentID = content.ID
+		block.BlockOrder = i + 1
+
+		existing, ok := storedByID[block.ID]
+		if !ok || kept[block.ID] {
+			// 未保存、他コンテンツのID、または重複したIDは新規ブロックとして扱う
+			block.ID = uuid.Nil
+			if err := createBlock(tx, block); err != nil {
+				return err
+			}
+			continue
+		}
+
+		kept[block.ID] = true
+		if err := updateBlock(tx, existing, block); err != nil {
+			return err
+		}
+	}
+
+	// 送信されなかったブロックを削除
+	var removed []uuid.UUID
+	for _, model := range stored {
+		if !kept[model.ID] {
+			removed = append(removed, model.ID)
+		}
+	}
+	return deleteBlocks(tx, removed)
+}
+
+// updateBlock は保存済みのブロックと比較し、変更がある場合のみブロックとブロックデータを更新します
+func updateBlock(tx *gorm.DB, stored *ContentBlockModel, block *entity.ContentBlock) error {
+	if stored.BlockType != string(block.BlockType) ||
+		stored.BlockOrder != block.BlockOrder ||
+		stored.IsVisible != block.IsVisible {
+		err := tx.Model(&ContentBlockModel{}).Where("id = ?", block.ID).Updates(map[string]interface{}{
+			"block_type":  string(block.BlockType),
+			"block_order": block.BlockOrder,
+			"is_visible":  block.IsVisible,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("コンテンツブロックの更新に失敗しました: %w", err)
+		}
+	}
+
+	switch {
+	case block.Data == nil && stored.Data != nil:
+		if err := tx.Where("block_id = ?", block.ID).Delete(&ContentBlockDataModel{}).Error; err != nil {
+			return fmt.Errorf("ブロックデータの削除に失敗しました: %w", err)
+		}
+	case block.Data != nil && stored.Data == nil:
+		return createBlockData(tx, block)
+	case block.Data != nil && stored.Data != nil:
+		block.Data.ID = stored.Data.ID
+		block.Data.BlockID = block.ID
+		if blockDataEqual(stored.Data, block.Data) {
+			return nil
+		}
+
+		var dataModel ContentBlockDataModel
+		dataModel.FromContentBlockDataEntity(block.Data)
+		err := tx.Model(&ContentBlockDataModel{}).Where("id = ?", dataModel.ID).Updates(map[string]interface{}{
+			"data_type":             dataModel.DataType,
+			"content_text":          dataModel.ContentText,
+			"content_richtext":      dataModel.ContentRichtext,
+			"content_number":        dataModel.ContentNumber,
+			"content_url":           dataModel.ContentURL,
+			"content_json":          dataModel.ContentJSON,
+			"referenced_content_id": dataModel.ReferencedContentID,
+			"settings":              dataModel.Settings,
+			"search_text":           dataModel.SearchText,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("ブロックデータの更新に失敗しました: %w", err)
+		}
+	}
+	return nil
+}
+
+// deleteBlocks は指定されたブロックとそのブロックデータを削除します
+func deleteBlocks(tx *gorm.DB, blockIDs []uuid.UUID) error {
+	if len(blockIDs) == 0 {
+		return nil
+	}
+	if err := tx.Where("block_id IN ?", blockIDs).Delete(&ContentBlockDataModel{}).Error; err != nil {
+		return fmt.Errorf("ブロックデータの削除に失敗しました: %w", err)
+	}
+	if err := tx.Where("id IN ?", blockIDs).Delete(&ContentBlockModel{}).Error; err != nil {
+		return fmt.Errorf("コンテンツブロックの削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// blockDataEqual は保存済みのブロックデータと送信されたブロックデータの内容が同じかを判定します
+func blockDataEqual(stored *ContentBlockDataModel, data *entity.ContentBlockData) bool {
+	if stored.DataType != string(data.DataType) ||
+		stored.ContentText != data.ContentText ||
+		stored.ContentURL != data.ContentURL ||
+		!jsonEqual(stored.ContentRichtext, data.ContentRichtext) ||
+		!jsonEqual(stored.ContentJSON, data.ContentJSON) ||
+		!jsonEqual(stored.Settings, data.Settings) {
+		return false
+	}
+
+	switch {
+	case stored.ContentNumber == nil || data.ContentNumber == nil:
+		if stored.ContentNumber != data.ContentNumber {
+			return false
+		}
+	case !stored.ContentNumber.Equal(*data.ContentNumber):
+		return false
+	}
+
+	switch {
+	case stored.ReferencedContentID == nil || data.ReferencedContentID == nil:
+		return stored.ReferencedContentID == data.ReferencedContentID
+	default:
+		return *stored.ReferencedContentID == *data.ReferencedContentID
+	}
+}
+
+// jsonEqual はJSONの値として同じかを判定します
+// jsonbはキーの順序や空白を保持しないため、バイト列ではなく読み込んだ値で比較します（未設定とnullは同じとみなす）
+func jsonEqual(a, b json.RawMessage) bool {
+	va, errA := decodeJSONValue(a)
+	vb, errB := decodeJSONValue(b)
+	if errA != nil || errB != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// decodeJSONValue はJSONを値として読み込みます（数値は表記の違いによる誤差が出ないようjson.Numberのまま扱う）
+func decodeJSONValue(data json.RawMessage) (interface{}, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockDataEqual(t *testing.T) {
+	refID := uuid.New()
+	number := decimal.NewFromFloat(1.5)
+	stored := &ContentBlockDataModel{
+		DataType:            string(entity.DataTypeText),
+		ContentText:         "本文",
+		ContentRichtext:     json.RawMessage(`{"type":"doc"}`),
+		ContentNumber:       &number,
+		ReferencedContentID: &refID,
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(d *entity.ContentBlockData)
+		expected bool
+	}{
+		{
+			name:     "正常系：内容が同じ場合",
+			modify:   func(d *entity.ContentBlockData) {},
+			expected: true,
+		},
+		{
+			name:     "テキストが変更された場合",
+			modify:   func(d *entity.ContentBlockData) { d.ContentText = "変更後" },
+			expected: false,
+		},
+		{
+			name:     "正常系：jsonbでキーの順序や空白が変わった場合",
+			modify:   func(d *entity.ContentBlockData) { d.ContentRichtext = json.RawMessage(`{ "type" : "doc" }`) },
+			expected: true,
+		},
+		{
+			name:     "リッチテキストが変更された場合",
+			modify:   func(d *entity.ContentBlockData) { d.ContentRichtext = json.RawMessage(`{"type":"paragraph"}`) },
+			expected: false,
+		},
+		{
+			name: "数値が同値の別インスタンスの場合",
+			modify: func(d *entity.ContentBlockData) {
+				n := decimal.RequireFromString("1.50")
+				d.ContentNumber = &n
+			},
+			expected: true,
+		},
+		{
+			name:     "数値が削除された場合",
+			modify:   func(d *entity.ContentBlockData) { d.ContentNumber = nil },
+			expected: false,
+		},
+		{
+			name: "参照先が変更された場合",
+			modify: func(d *entity.ContentBlockData) {
+				other := uuid.New()
+				d.ReferencedContentID = &other
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sameRef := refID
+			sameNumber := number
+			data := &entity.ContentBlockData{
+				DataType:            entity.DataTypeText,
+				ContentText:         "本文",
+				ContentRichtext:     json.RawMessage(`{"type":"doc"}`),
+				ContentNumber:       &sameNumber,
+				ReferencedContentID: &sameRef,
+			}
+			tc.modify(data)
+
+			assert.Equal(t, tc.expected, blockDataEqual(stored, data))
+		})
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a, b     string
+		expected bool
+	}{
+		{name: "キーの順序と空白が異なる場合", a: `{"type":"doc","content":[{"text":"本文","level":1}]}`, b: `{"content": [{"level": 1, "text": "本文"}], "type": "doc"}`, expected: true},
+		{name: "未設定とnullの場合", a: ``, b: `null`, expected: true},
+		{name: "配列の順序が異なる場合", a: `[1,2]`, b: `[2,1]`, expected: false},
+		{name: "値が異なる場合", a: `{"type":"doc"}`, b: `{"type":"paragraph"}`, expected: false},
+		{name: "未設定と空のオブジェクトの場合", a: ``, b: `{}`, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, jsonEqual(json.RawMessage(tc.a), json.RawMessage(tc.b)))
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Keyset はキーセットページネーションの基準位置
+type Keyset struct {
+	// Valuesは基準となるコンテンツのソート対象の値（ContentFilters.Sortと同じ順。time.Timeまたはstring、NULLはnil）
+	Values []interface{}
+	ID     uuid.UUID
+	// Backwardがtrueの場合は基準位置より前、falseの場合は後のコンテンツを取得する
+	Backward bool
+}
+
+// GetContentsByKeyset は基準位置の次（Backwardの場合は前）のコンテンツを並び順どおりに最大limit件取得します
+// 総数は数えず、さらに先のコンテンツがあるかどうかを返します
+func (r *contentRepository) GetContentsByKeyset(ctx context.Context, limit int, keyset Keyset, filters ContentFilters) ([]*entity.Content, bool, error) {
+	keys := filters.Sort
+	if len(keys) == 0 {
+		keys = DefaultSort
+	}
+
+	condition, args, err := keysetCondition(keys, keyset)
+	if err != nil {
+		return nil, false, err
+	}
+	orderBy, err := orderByClause(keys, keyset.Backward)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// 1件多く取得して、さらに先のコンテンツがあるかを判定する
+	var contentModels []ContentModel
+	err = preloadContents(applyContentFilters(r.db.WithContext(ctx).Model(&ContentModel{}), filters), filters.WithBlocks).
+		Where(condition, args...).
+		Order(orderBy).
+		Limit(limit + 1).
+		Find(&contentModels).Error
+	if err != nil {
+		return nil, false, fmt.Errorf("コンテンツ一覧の取得に失敗しました: %w", err)
+	}
+
+	hasMore := len(contentModels) > limit
+	if hasMore {
+		contentModels = contentModels[:limit]
+	}
+	if keyset.Backward {
+		slices.Reverse(contentModels)
+	}
+
+	contents := make([]*entity.Content, len(contentModels))
+	for i, model := range contentModels {
+		content, err := model.ToContentEntity()
+		if err != nil {
+			return nil, false, err
+		}
+		contents[i] = content
+	}
+
+	return contents, hasMore, nil
+}
+
+// keysetCondition は並び順で基準位置より後（Backwardの場合は前）にある行の条件を組み立てます
+// 先頭からi-1番目までのソート対象が等しく、i番目が後にある行をソート対象ごとに列挙し、
+// すべて等しい場合はIDで比較します（NULLは昇順・降順とも末尾に並ぶものとして扱う）
+func keysetCondition(keys []SortKey, keyset Keyset) (string, []interface{}, error) {
+	if len(keyset.Values) != len(keys) {
+		return "", nil, fmt.Errorf("%w: カーソルが並び順と一致しません", entity.ErrInvalidParameter)
+	}
+
+	var (
+		alternatives []string
+		args         []interface{}
+		equals       []string
+		equalArgs    []interface{}
+	)
+	for i, key := range keys {
+		if !key.Field.IsValid() {
+			return "", nil, fmt.Errorf("%w: sort=%s", entity.ErrInvalidParameter, key.Field)
+		}
+		column, value := string(key.Field), keyset.Values[i]
+
+		// i番目のソート対象で基準位置より後になる条件
+		var beyond string
+		switch {
+		case value == nil && keyset.Backward:
+			beyond = column + " IS NOT NULL"
+		case value == nil:
+			// NULLより後の値はないため、このソート対象では決まらない
+		case key.Desc != keyset.Backward:
+			beyond = column + " < ?"
+		default:
+			beyond = column + " > ?"
+		}
+		if beyond != "" {
+			if value != nil && key.Field.nullable() && !keyset.Backward {
+				beyond = "(" + beyond + " OR " + column + " IS NULL)"
+			}
+			alternatives = append(alternatives, strings.Join(append(slices.Clone(equals), beyond), " AND "))
+			args = append(args, equalArgs...)
+			if value != nil {
+				args = append(args, value)
+			}
+		}
+
+		if value == nil {
+			equals = append(equals, column+" IS NULL")
+		} else {
+			equals = append(equals, column+" = ?")
+			equalArgs = append(equalArgs, value)
+		}
+	}
+
+	idCondition := "id > ?"
+	if keyset.Backward {
+		idCondition = "id < ?"
+	}
+	alternatives = append(alternatives, strings.Join(append(equals, idCondition), " AND "))
+	args = append(args, equalArgs...)
+	args = append(args, keyset.ID)
+
+	return "((" + strings.Join(alternatives, ") OR (") + "))", args, nil
+}
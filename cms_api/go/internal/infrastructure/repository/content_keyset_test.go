@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeysetCondition(t *testing.T) {
+	id := uuid.New()
+	publishedAt := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	keys := []SortKey{{Field: SortFieldPublishedAt, Desc: true}, {Field: SortFieldTitle}}
+
+	testCases := []struct {
+		name              string
+		keyset            Keyset
+		expectedCondition string
+		expectedArgs      []interface{}
+		expectedError     error
+	}{
+		{
+			name:   "正常系：次のページはNULLを末尾に含めて後ろの行を取得する",
+			keyset: Keyset{Values: []interface{}{publishedAt, "AWS"}, ID: id},
+			expectedCondition: "(((published_at < ? OR published_at IS NULL)) OR " +
+				"(published_at = ? AND title > ?) OR " +
+				"(published_at = ? AND title = ? AND id > ?))",
+			expectedArgs: []interface{}{publishedAt, publishedAt, "AWS", publishedAt, "AWS", id},
+		},
+		{
+			name:              "正常系：前のページは逆向きに比較し、NULLの前にはNULL以外の行がある",
+			keyset:            Keyset{Values: []interface{}{nil, "AWS"}, ID: id, Backward: true},
+			expectedCondition: "((published_at IS NOT NULL) OR (published_at IS NULL AND title < ?) OR (published_at IS NULL AND title = ? AND id < ?))",
+			expectedArgs:      []interface{}{"AWS", "AWS", id},
+		},
+		{
+			name:              "正常系：NULLより後の値はないため、NULLの行どうしで比較する",
+			keyset:            Keyset{Values: []interface{}{nil, "AWS"}, ID: id},
+			expectedCondition: "((published_at IS NULL AND title > ?) OR (published_at IS NULL AND title = ? AND id > ?))",
+			expectedArgs:      []interface{}{"AWS", "AWS", id},
+		},
+		{
+			name:          "異常系：ソート対象の数が一致しない場合",
+			keyset:        Keyset{Values: []interface{}{publishedAt}, ID: id},
+			expectedError: entity.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			condition, args, err := keysetCondition(keys, tc.keyset)
+			if tc.expectedError != nil {
+				assert.True(t, errors.Is(err, tc.expectedError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCondition, condition)
+			assert.Equal(t, tc.expectedArgs, args)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetContentTypeNames はコンテンツIDごとのコンテンツタイプ名を返します
+// 存在しないコンテンツとゴミ箱のコンテンツは結果に含めません
+func (r *contentRepository) GetContentTypeNames(ctx context.Context, contentIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	names := make(map[uuid.UUID]string, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return names, nil
+	}
+
+	var rows []struct {
+		ID   uuid.UUID
+		Name string
+	}
+	err := r.db.WithContext(ctx).Model(&ContentModel{}).
+		Select("contents.id, content_types.name").
+		Joins("JOIN content_types ON content_types.id = contents.content_type_id").
+		Where("contents.id IN ? AND contents.status <> ?", contentIDs, string(entity.ContentStatusTrash)).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("参照先のコンテンツの取得に失敗しました: %w", err)
+	}
+
+	for _, row := range rows {
+		names[row.ID] = row.Name
+	}
+	return names, nil
+}
+
+// GetContentsByIDs はIDのコンテンツをブロックと共にまとめて取得します
+// 存在しないコンテンツとゴミ箱のコンテンツは結果に含めません
+func (r *contentRepository) GetContentsByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Content, error) {
+	if len(ids) == 0 {
+		return []*entity.Content{}, nil
+	}
+
+	var contentModels []ContentModel
+	err := preloadContents(r.db.WithContext(ctx), true).
+		Where("id IN ? AND status <> ?", ids, string(entity.ContentStatusTrash)).
+		Find(&contentModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("参照先のコンテンツの取得に失敗しました: %w", err)
+	}
+
+	contents := make([]*entity.Content, len(contentModels))
+	for i, model := range contentModels {
+		content, err := model.ToContentEntity()
+		if err != nil {
+			return nil, err
+		}
+		contents[i] = content
+	}
+	return contents, nil
+}
+
+// GetReferrers は参照ブロックでコンテンツを参照しているコンテンツ（ゴミ箱のものを含む）をタイトル順に返します
+func (r *contentRepository) GetReferrers(ctx context.Context, contentID uuid.UUID) ([]entity.Referrer, error) {
+	return findReferrers(r.db.WithContext(ctx), []uuid.UUID{contentID}, true)
+}
+
+// UpdateReferencedContentStatus は参照元をpolicyに従って処理してから、コンテンツのステータス・公開日時・公開予約を更新します
+// 参照元の処理とステータスの更新は1トランザクションで行います（参照元のバージョンが競合した場合はすべて取り消す）
+func (r *contentRepository) UpdateReferencedContentStatus(ctx context.Context, content *entity.Content, policy entity.ReferrerPolicy) error {
+	err := r.changeContent(ctx, content.ID, content.Version, func(tx *gorm.DB) error {
+		if err := releaseReferrers(tx, content.ID, policy); err != nil {
+			return err
+		}
+		return updateStatus(tx, content)
+	})
+	if err != nil {
+		return err
+	}
+
+	content.Version++
+	return nil
+}
+
+// findReferrers はcontentIDsのいずれかを参照している参照元（contentIDs自身を除く）を参照ブロックと共に取得します
+func findReferrers(tx *gorm.DB, contentIDs []uuid.UUID, includeTrash bool) ([]entity.Referrer, error) {
+	var rows []struct {
+		ContentID       uuid.UUID
+		Title           string
+		Slug            string
+		Status          string
+		Version         int
+		ContentTypeName string
+		BlockID         uuid.UUID
+	}
+	query := tx.Model(&ContentBlockDataModel{}).
+		Select("contents.id AS content_id, contents.title, contents.slug, contents.status, contents.version, "+
+			"content_types.name AS content_type_name, content_blocks.id AS block_id").
+		Joins("JOIN content_blocks ON content_blocks.id = content_block_data.block_id").
+		Joins("JOIN contents ON contents.id = content_blocks.content_id").
+		Joins("JOIN content_types ON content_types.id = contents.content_type_id").
+		Where("content_block_data.referenced_content_id IN ? AND contents.id NOT IN ?", contentIDs, contentIDs)
+	if !includeTrash {
+		query = query.Where("contents.status <> ?", string(entity.ContentStatusTrash))
+	}
+	err := query.Order("contents.title ASC, contents.id ASC, content_blocks.block_order ASC").Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("参照元のコンテンツの取得に失敗しました: %w", err)
+	}
+
+	referrers := []entity.Referrer{}
+	for _, row := range rows {
+		if n := len(referrers); n > 0 && referrers[n-1].ContentID == row.ContentID {
+			referrers[n-1].BlockIDs = append(referrers[n-1].BlockIDs, row.BlockID)
+			continue
+		}
+		referrers = append(referrers, entity.Referrer{
+			ContentID:       row.ContentID,
+			Title:           row.Title,
+			Slug:            row.Slug,
+			Status:          entity.ContentStatus(row.Status),
+			ContentTypeName: row.ContentTypeName,
+			Version:         row.Version,
+			BlockIDs:        []uuid.UUID{row.BlockID},
+		})
+	}
+	return referrers, nil
+}
+
+// releaseReferrers はcontentIDの参照元（ゴミ箱のものを除く）をpolicyに従って処理します
+// policyがReferrerPolicyRestrictの場合は参照元の一覧を*entity.ReferencedErrorとして返します
+func releaseReferrers(tx *gorm.DB, contentID uuid.UUID, policy entity.ReferrerPolicy) error {
+	referrers, err := findReferrers(tx, []uuid.UUID{contentID}, false)
+	if err != nil {
+		return err
+	}
+	if len(referrers) == 0 {
+		return nil
+	}
+	if policy == entity.ReferrerPolicyRestrict {
+		return &entity.ReferencedError{Referrers: referrers}
+	}
+	return releaseReferences(tx, referrers, []uuid.UUID{contentID}, policy)
+}
+
+// releaseReferences は参照元のtargetIDsへの参照ブロックをpolicyに従って処理します
+// 参照ブロックを処理した参照元は、それぞれバージョンを進めて版を保存します（取得後に更新された参照元はErrVersionConflict）
+func releaseReferences(tx *gorm.DB, referrers []entity.Referrer, targetIDs []uuid.UUID, policy entity.ReferrerPolicy) error {
+	for _, referrer := range referrers {
+		var model ContentModel
+		err := tx.Preload("Blocks", orderBlocks).
+			Preload("Blocks.Data").
+			Where("id = ?", referrer.ContentID).
+			First(&model).Error
+		if err != nil {
+			return fmt.Errorf("参照元のコンテンツの取得に失敗しました: %w", err)
+		}
+
+		content, err := model.ToContentEntity()
+		if err != nil {
+			return err
+		}
+		released := false
+		for _, targetID := range targetIDs {
+			released = content.ReleaseReferences(targetID, policy) || released
+		}
+		if !released {
+			continue
+		}
+		if err := bumpVersion(tx, content.ID, referrer.Version); err != nil {
+			return err
+		}
+		if err := syncBlocks(tx, content); err != nil {
+			return err
+		}
+		if err := saveVersion(tx, content.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
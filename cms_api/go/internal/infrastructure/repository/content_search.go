@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// searchCondition は検索語1つに対する絞り込み条件
+// タイトル・スラッグはNFKC正規化して比較し、ブロック本文は保存時に正規化したsearch_textと比較する（いずれもトライグラム索引あり）
+// ORで結ぶと索引を使えないため、それぞれの一致をUNIONでまとめる
+const searchCondition = `id IN (
+	SELECT c.id FROM contents c WHERE normalize(c.title, NFKC) ILIKE ?
+	UNION SELECT c.id FROM contents c WHERE normalize(c.slug, NFKC) ILIKE ?
+	UNION SELECT cb.content_id FROM content_blocks cb JOIN content_block_data cbd ON cbd.block_id = cb.id
+	WHERE cb.is_visible AND cbd.search_text LIKE ?)`
+
+// searchScore は検索語1つに対する関連度
+// タイトルに含まれる場合を最も重く、タイトルとの類似度と語を含む表示中のブロック数（最大3）を加える
+const searchScore = `(CASE WHEN normalize(title, NFKC) ILIKE ? THEN 2 ELSE 0 END
+	+ similarity(normalize(title, NFKC), ?)
+	+ LEAST((SELECT COUNT(*) FROM content_blocks cb JOIN content_block_data cbd ON cbd.block_id = cb.id
+		WHERE cb.content_id = contents.id AND cb.is_visible AND cbd.search_text LIKE ?), 3) * 0.5)`
+
+// SearchContents はfilters.Termsに一致するコンテンツを関連度の高い順（同じ場合はID順）に取得します
+func (r *contentRepository) SearchContents(ctx context.Context, limit, offset int, filters ContentFilters) ([]*entity.SearchHit, int64, error) {
+	query := applyContentFilters(r.db.WithContext(ctx).Model(&ContentModel{}), filters)
+
+	// 総数を取得
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("検索結果の総数の取得に失敗しました: %w", err)
+	}
+
+	// 関連度を計算してページ内のIDを取得
+	scores := make([]string, len(filters.Terms))
+	var args []interface{}
+	for i, term := range filters.Terms {
+		pattern := likePattern(term)
+		scores[i] = searchScore
+		args = append(args, pattern, term, pattern)
+	}
+	if len(scores) == 0 {
+		scores = []string{"0"}
+	}
+
+	var rows []struct {
+		ID    uuid.UUID
+		Score float64
+	}
+	err := query.Select("id, ("+strings.Join(scores, " + ")+") AS score", args...).
+		Order("score DESC, id ASC").
+		Limit(limit).Offset(offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("コンテンツの検索に失敗しました: %w", err)
+	}
+	if len(rows) == 0 {
+		return []*entity.SearchHit{}, total, nil
+	}
+
+	// 抜粋の作成に使うブロックを含めて取得し、関連度の順に並べる
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	var contentModels []ContentModel
+	err = preloadContents(r.db.WithContext(ctx), true).
+		Where("id IN ?", ids).
+		Find(&contentModels).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("検索結果のコンテンツの取得に失敗しました: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*ContentModel, len(contentModels))
+	for i := range contentModels {
+		byID[contentModels[i].ID] = &contentModels[i]
+	}
+	hits := make([]*entity.SearchHit, 0, len(rows))
+	for _, row := range rows {
+		model, ok := byID[row.ID]
+		if !ok {
+			continue
+		}
+		content, err := model.ToContentEntity()
+		if err != nil {
+			return nil, 0, err
+		}
+		hits = append(hits, &entity.SearchHit{Content: content, Score: row.Score})
+	}
+
+	return hits, total, nil
+}
+
+// searchConditionArgs はsearchConditionに渡す引数を返します
+func searchConditionArgs(term string) []interface{} {
+	pattern := likePattern(term)
+	return []interface{}{pattern, pattern, pattern}
+}
+
+// likePattern は語を部分一致のLIKEパターンに変換します（ワイルドカード文字はエスケープする）
+func likePattern(term string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term) + "%"
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetContentBySlug はコンテンツタイプ名とスラッグでコンテンツを取得します
+// コンテンツタイプが存在しない場合はErrContentTypeNotFound、コンテンツが存在しない場合はErrContentNotFoundを返します
+func (r *contentRepository) GetContentBySlug(ctx context.Context, contentTypeName, slug string) (*entity.Content, error) {
+	var contentTypeModel ContentTypeModel
+	err := r.db.WithContext(ctx).
+		Where("name = ? AND is_active = ?", contentTypeName, true).
+		First(&contentTypeModel).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("%w: %s", entity.ErrContentTypeNotFound, contentTypeName)
+		}
+		return nil, fmt.Errorf("コンテンツタイプの取得に失敗しました: %w", err)
+	}
+
+	var contentModel ContentModel
+	err = preloadContents(r.db.WithContext(ctx), true).
+		Where("content_type_id = ? AND slug = ? AND status <> ?",
+			contentTypeModel.ID, slug, string(entity.ContentStatusTrash)).
+		First(&contentModel).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("%w: %s/%s", entity.ErrContentNotFound, contentTypeName, slug)
+		}
+		return nil, fmt.Errorf("コンテンツの取得に失敗しました: %w", err)
+	}
+
+	return contentModel.ToContentEntity()
+}
+
+// ContentSlugExists はコンテンツタイプ内でexceptID以外のコンテンツ（ゴミ箱を含む）がスラッグを使っているかを確認します
+func (r *contentRepository) ContentSlugExists(ctx context.Context, contentTypeID uuid.UUID, slug string, exceptID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&ContentModel{}).
+		Where("content_type_id = ? AND slug = ? AND id <> ?", contentTypeID, slug, exceptID).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("スラッグの重複チェックに失敗しました: %w", err)
+	}
+	return count > 0, nil
+}
+
+// GetSlugRedirect はコンテンツタイプ名と変更前のスラッグでリダイレクトを取得します
+func (r *contentRepository) GetSlugRedirect(ctx context.Context, contentTypeName, slug string) (*entity.SlugRedirect, error) {
+	var redirectModel ContentSlugRedirectModel
+	err := r.db.WithContext(ctx).
+		Where("content_type_id IN (SELECT id FROM content_types WHERE name = ? AND is_active = ?) AND slug = ?",
+			contentTypeName, true, slug).
+		First(&redirectModel).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("%w: %s/%s", entity.ErrSlugRedirectNotFound, contentTypeName, slug)
+		}
+		return nil, fmt.Errorf("スラッグのリダイレクトの取得に失敗しました: %w", err)
+	}
+	return redirectModel.ToSlugRedirectEntity(), nil
+}
+
+// GetSlugRedirects はコンテンツへのリダイレクトを記録した日時の新しい順に取得します
+func (r *contentRepository) GetSlugRedirects(ctx context.Context, contentID uuid.UUID) ([]*entity.SlugRedirect, error) {
+	var redirectModels []ContentSlugRedirectModel
+	err := r.db.WithContext(ctx).
+		Where("content_id = ?", contentID).
+		Order("created_at DESC, id ASC").
+		Find(&redirectModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("スラッグのリダイレクト一覧の取得に失敗しました: %w", err)
+	}
+
+	redirects := make([]*entity.SlugRedirect, len(redirectModels))
+	for i, model := range redirectModels {
+		redirects[i] = model.ToSlugRedirectEntity()
+	}
+	return redirects, nil
+}
+
+// DeleteSlugRedirect はコンテンツへのリダイレクトを削除します
+func (r *contentRepository) DeleteSlugRedirect(ctx context.Context, contentID, redirectID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND content_id = ?", redirectID, contentID).
+		Delete(&ContentSlugRedirectModel{})
+	if result.Error != nil {
+		return fmt.Errorf("スラッグのリダイレクトの削除に失敗しました: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", entity.ErrSlugRedirectNotFound, redirectID.String())
+	}
+	return nil
+}
+
+// recordSlugRedirect は公開中のコンテンツのスラッグ（またはコンテンツタイプ）が変わる場合に、
+// 変更前のスラッグから現在のコンテンツへのリダイレクトを記録します
+// 同じスラッグのリダイレクトが既にある場合は、このコンテンツへのリダイレクトに置き換えます
+func recordSlugRedirect(tx *gorm.DB, existing, updated *ContentModel) error {
+	if existing.Status != string(entity.ContentStatusPublished) ||
+		(existing.Slug == updated.Slug && existing.ContentTypeID == updated.ContentTypeID) {
+		return nil
+	}
+
+	if err := releaseSlugRedirect(tx, updated.ContentTypeID, updated.Slug); err != nil {
+		return err
+	}
+
+	redirect := ContentSlugRedirectModel{
+		ContentTypeID: existing.ContentTypeID,
+		Slug:          existing.Slug,
+		ContentID:     existing.ID,
+	}
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "content_type_id"}, {Name: "slug"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"content_id": existing.ID, "created_at": gorm.Expr("CURRENT_TIMESTAMP")}),
+	}).Create(&redirect).Error
+	if err != nil {
+		return fmt.Errorf("スラッグのリダイレクトの記録に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// releaseSlugRedirect はコンテンツが使うスラッグと同じリダイレクトを削除します（コンテンツのスラッグを優先する）
+func releaseSlugRedirect(tx *gorm.DB, contentTypeID uuid.UUID, slug string) error {
+	err := tx.Where("content_type_id = ? AND slug = ?", contentTypeID, slug).
+		Delete(&ContentSlugRedirectModel{}).Error
+	if err != nil {
+		return fmt.Errorf("スラッグのリダイレクトの削除に失敗しました: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"fmt"
+	"strings"
+)
+
+// SortField はコンテンツ一覧のソート対象のカラム
+type SortField string
+
+const (
+	SortFieldCreatedAt   SortField = "created_at"
+	SortFieldUpdatedAt   SortField = "updated_at"
+	SortFieldPublishedAt SortField = "published_at"
+	SortFieldTitle       SortField = "title"
+	SortFieldDeletedAt   SortField = "deleted_at"
+)
+
+// SortKey はソート条件の1つ（Descがtrueの場合は降順）
+type SortKey struct {
+	Field SortField
+	Desc  bool
+}
+
+// DefaultSort はソート条件が指定されない場合の並び順（作成日時の新しい順）
+var DefaultSort = []SortKey{{Field: SortFieldCreatedAt, Desc: true}}
+
+// IsValid はソート対象が定義済みのカラムかを確認
+func (f SortField) IsValid() bool {
+	switch f {
+	case SortFieldCreatedAt, SortFieldUpdatedAt, SortFieldPublishedAt, SortFieldTitle, SortFieldDeletedAt:
+		return true
+	}
+	return false
+}
+
+// nullable はNULLを含み得るカラムかを確認（NULLは昇順・降順とも末尾に並べる）
+func (f SortField) nullable() bool {
+	return f == SortFieldPublishedAt || f == SortFieldDeletedAt
+}
+
+// orderByClause はソート条件からORDER BY句を組み立てます
+// 定義済みのカラム以外はErrInvalidParameterを返し、最後にIDの昇順を加えて並び順を一意にします
+// reverseがtrueの場合はNULLの位置とIDを含めて並び順をすべて逆にします（前のページの取得に使う）
+func orderByClause(keys []SortKey, reverse bool) (string, error) {
+	if len(keys) == 0 {
+		keys = DefaultSort
+	}
+
+	direction := func(desc bool) string {
+		if desc != reverse {
+			return " DESC"
+		}
+		return " ASC"
+	}
+	nulls := " NULLS LAST"
+	if reverse {
+		nulls = " NULLS FIRST"
+	}
+
+	columns := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		if !key.Field.IsValid() {
+			return "", fmt.Errorf("%w: sort=%s", entity.ErrInvalidParameter, key.Field)
+		}
+		column := string(key.Field) + direction(key.Desc)
+		if key.Field.nullable() {
+			column += nulls
+		}
+		columns = append(columns, column)
+	}
+	columns = append(columns, "id"+direction(false))
+
+	return strings.Join(columns, ", "), nil
+}
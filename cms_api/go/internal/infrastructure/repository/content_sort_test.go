@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderByClause(t *testing.T) {
+	testCases := []struct {
+		name          string
+		keys          []SortKey
+		reverse       bool
+		expected      string
+		expectedError error
+	}{
+		{
+			name:     "正常系：未指定の場合は作成日時の新しい順",
+			expected: "created_at DESC, id ASC",
+		},
+		{
+			name: "正常系：複数のソート対象を指定し、NULLを含むカラムはNULLを末尾に並べる場合",
+			keys: []SortKey{
+				{Field: SortFieldPublishedAt, Desc: true},
+				{Field: SortFieldTitle},
+			},
+			expected: "published_at DESC NULLS LAST, title ASC, id ASC",
+		},
+		{
+			name:     "正常系：逆順の場合はNULLの位置とIDを含めてすべて逆にする",
+			keys:     []SortKey{{Field: SortFieldPublishedAt, Desc: true}, {Field: SortFieldTitle}},
+			reverse:  true,
+			expected: "published_at ASC NULLS FIRST, title DESC, id DESC",
+		},
+		{
+			name:          "異常系：定義されていないカラムが指定された場合",
+			keys:          []SortKey{{Field: "id; DROP TABLE contents"}},
+			expectedError: entity.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			orderBy, err := orderByClause(tc.keys, tc.reverse)
+			if tc.expectedError != nil {
+				assert.True(t, errors.Is(err, tc.expectedError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, orderBy)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PurgeContent はゴミ箱のコンテンツをブロック・ブロックデータ・版と共に物理削除します
+// 削除対象の行をロックしてからステータスを確認するため、復元と同時に実行されても復元後のコンテンツは削除しません
+// ゴミ箱にない参照元はpolicyに従って処理し、ゴミ箱の参照元の参照ブロックは参照先を外して非表示にします
+func (r *contentRepository) PurgeContent(ctx context.Context, id uuid.UUID, policy entity.ReferrerPolicy) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var contentModel ContentModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", id).
+			First(&contentModel).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("削除対象の%w: %s", entity.ErrContentNotFound, id.String())
+			}
+			return fmt.Errorf("コンテンツの存在確認に失敗しました: %w", err)
+		}
+		if contentModel.Status != string(entity.ContentStatusTrash) {
+			return fmt.Errorf("%w: %sのコンテンツは完全に削除できません（先にゴミ箱へ移動してください）",
+				entity.ErrInvalidStatusTransition, contentModel.Status)
+		}
+		if err := releaseReferrers(tx, id, policy); err != nil {
+			return err
+		}
+
+		return purgeContents(tx, []uuid.UUID{id})
+	})
+}
+
+// PurgeTrashedContents はbefore以前にゴミ箱へ移動したコンテンツを古い順に最大limit件物理削除します
+func (r *contentRepository) PurgeTrashedContents(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ContentModel{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND deleted_at <= ?", string(entity.ContentStatusTrash), before).
+			Order("deleted_at ASC").
+			Limit(limit).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("ゴミ箱のコンテンツの取得に失敗しました: %w", err)
+		}
+
+		return purgeContents(tx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// purgeContents は指定されたコンテンツとそのブロック・ブロックデータ・タグの関連付け・版を削除します
+// 残る参照元の参照ブロックは削除するコンテンツへの参照先を外して非表示にし、参照元のバージョンを進めて版を保存します
+func purgeContents(tx *gorm.DB, contentIDs []uuid.UUID) error {
+	if len(contentIDs) == 0 {
+		return nil
+	}
+
+	// 参照の解除
+	referrers, err := findReferrers(tx, contentIDs, true)
+	if err != nil {
+		return err
+	}
+	if err := releaseReferences(tx, referrers, contentIDs, entity.ReferrerPolicyDetach); err != nil {
+		return err
+	}
+
+	// ブロックデータの削除
+	if err := tx.Where("block_id IN (SELECT id FROM content_blocks WHERE content_id IN ?)", contentIDs).Delete(&ContentBlockDataModel{}).Error; err != nil {
+		return fmt.Errorf("ブロックデータの削除に失敗しました: %w", err)
+	}
+
+	// ブロックの削除
+	if err := tx.Where("content_id IN ?", contentIDs).Delete(&ContentBlockModel{}).Error; err != nil {
+		return fmt.Errorf("コンテンツブロックの削除に失敗しました: %w", err)
+	}
+
+	// タグの関連付けの削除
+	if err := tx.Where("content_id IN ?", contentIDs).Delete(&ContentTagModel{}).Error; err != nil {
+		return fmt.Errorf("コンテンツのタグの削除に失敗しました: %w", err)
+	}
+
+	// 版の削除
+	if err := tx.Where("content_id IN ?", contentIDs).Delete(&ContentVersionModel{}).Error; err != nil {
+		return fmt.Errorf("コンテンツの版の削除に失敗しました: %w", err)
+	}
+
+	// コンテンツの削除
+	if err := tx.Where("id IN ?", contentIDs).Delete(&ContentModel{}).Error; err != nil {
+		return fmt.Errorf("コンテンツの削除に失敗しました: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"bytes"
+	"cms_api/internal/domain/entity"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpdateContentType は有効なコンテンツタイプの表示名・説明・アイコン・フィールドの定義を更新します
+// 名前はURLやスラッグの検索に使うため変更しません
+// フィールドの定義が変わる場合は版を1つ進めて保存し、contentType.SchemaVersionに反映します
+func (r *contentRepository) UpdateContentType(ctx context.Context, contentType *entity.ContentType) error {
+	if err := contentType.Validate(); err != nil {
+		return fmt.Errorf("%w: コンテンツタイプのバリデーションエラー: %v", entity.ErrInvalidParameter, err)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stored, err := lockContentType(tx, contentType.ID)
+		if err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"display_name": contentType.DisplayName,
+			"description":  contentType.Description,
+			"icon":         contentType.Icon,
+		}
+		schema := marshalFieldSchema(contentType.FieldSchema)
+		changed, err := fieldSchemaChanged(stored.FieldSchema, schema)
+		if err != nil {
+			return err
+		}
+		if changed {
+			stored.FieldSchema = schema
+			stored.SchemaVersion++
+			updates["field_schema"] = stored.FieldSchema
+			updates["schema_version"] = stored.SchemaVersion
+		}
+
+		if err := tx.Model(&ContentTypeModel{}).Where("id = ?", stored.ID).Updates(updates).Error; err != nil {
+			return fmt.Errorf("コンテンツタイプの更新に失敗しました: %w", err)
+		}
+		contentType.SchemaVersion = stored.SchemaVersion
+		if !changed {
+			return nil
+		}
+		return saveSchemaVersion(tx, stored)
+	})
+}
+
+// SetContentTypeActive はコンテンツタイプを有効化・無効化します
+// 無効化してもコンテンツは削除しません（無効なコンテンツタイプのコンテンツは作成・更新できず、スラッグでも取得できない）
+func (r *contentRepository) SetContentTypeActive(ctx context.Context, id uuid.UUID, active bool) error {
+	result := r.db.WithContext(ctx).Model(&ContentTypeModel{}).
+		Where("id = ?", id).
+		Update("is_active", active)
+	if result.Error != nil {
+		return fmt.Errorf("コンテンツタイプの有効・無効の変更に失敗しました: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", entity.ErrContentTypeNotFound, id.String())
+	}
+	return nil
+}
+
+// GetContentTypeSchemaVersions はフィールドの定義の版を新しい順に取得します
+func (r *contentRepository) GetContentTypeSchemaVersions(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error) {
+	var models []ContentTypeSchemaVersionModel
+	err := r.db.WithContext(ctx).
+		Where("content_type_id = ?", contentTypeID).
+		Order("version DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("フィールドの定義の版の取得に失敗しました: %w", err)
+	}
+
+	versions := make([]*entity.ContentTypeSchemaVersion, len(models))
+	for i, model := range models {
+		version, err := model.ToContentTypeSchemaVersionEntity()
+		if err != nil {
+			return nil, err
+		}
+		versions[i] = version
+	}
+	return versions, nil
+}
+
+// GetContentsByContentType はコンテンツタイプのすべてのコンテンツ（ゴミ箱を含む）をブロックと共にID順に取得します
+func (r *contentRepository) GetContentsByContentType(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.Content, error) {
+	var contentModels []ContentModel
+	err := r.db.WithContext(ctx).
+		Preload("Blocks", orderBlocks).
+		Preload("Blocks.Data").
+		Where("content_type_id = ?", contentTypeID).
+		Order("id ASC").
+		Find(&contentModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("コンテンツタイプのコンテンツの取得に失敗しました: %w", err)
+	}
+
+	contents := make([]*entity.Content, len(contentModels))
+	for i, model := range contentModels {
+		content, err := model.ToContentEntity()
+		if err != nil {
+			return nil, err
+		}
+		contents[i] = content
+	}
+	return contents, nil
+}
+
+// MigrateContentType はフィールドの定義を移行し、書き換えたコンテンツのブロックを保存します
+// コンテンツはそれぞれバージョンを進めて版を保存します（1件でも競合した場合はすべて取り消す）
+// 成功時はcontentType.SchemaVersionと各コンテンツのVersionを移行後の値に更新します
+func (r *contentRepository) MigrateContentType(ctx context.Context, contentType *entity.ContentType, contents []*entity.Content) error {
+	if err := contentType.FieldSchema.Validate(); err != nil {
+		return fmt.Errorf("%w: フィールドの定義のバリデーションエラー: %v", entity.ErrInvalidParameter, err)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stored, err := lockContentType(tx, contentType.ID)
+		if err != nil {
+			return err
+		}
+		if stored.SchemaVersion != contentType.SchemaVersion {
+			return fmt.Errorf("%w: フィールドの定義の版が最新ではありません (schema_version=%d, current=%d)",
+				entity.ErrVersionConflict, contentType.SchemaVersion, stored.SchemaVersion)
+		}
+
+		stored.FieldSchema = marshalFieldSchema(contentType.FieldSchema)
+		stored.SchemaVersion++
+		err = tx.Model(&ContentTypeModel{}).Where("id = ?", stored.ID).Updates(map[string]interface{}{
+			"field_schema":   stored.FieldSchema,
+			"schema_version": stored.SchemaVersion,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("フィールドの定義の移行に失敗しました: %w", err)
+		}
+		if err := saveSchemaVersion(tx, stored); err != nil {
+			return err
+		}
+
+		for _, content := range contents {
+			if err := bumpVersion(tx, content.ID, content.Version); err != nil {
+				return err
+			}
+			if err := syncBlocks(tx, content); err != nil {
+				return err
+			}
+			if err := saveVersion(tx, content.ID); err != nil {
+				return err
+			}
+		}
+
+		contentType.SchemaVersion = stored.SchemaVersion
+		for _, content := range contents {
+			content.Version++
+		}
+		return nil
+	})
+}
+
+// lockContentType は有効なコンテンツタイプをトランザクション終了までロックして取得します
+func lockContentType(tx *gorm.DB, id uuid.UUID) (*ContentTypeModel, error) {
+	var model ContentTypeModel
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND is_active = ?", id, true).
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("%w: %s", entity.ErrContentTypeNotFound, id.String())
+		}
+		return nil, fmt.Errorf("コンテンツタイプの取得に失敗しました: %w", err)
+	}
+	return &model, nil
+}
+
+// saveSchemaVersion はコンテンツタイプの現在のフィールドの定義を版として保存します
+func saveSchemaVersion(tx *gorm.DB, contentType *ContentTypeModel) error {
+	version := ContentTypeSchemaVersionModel{
+		ContentTypeID: contentType.ID,
+		Version:       contentType.SchemaVersion,
+		FieldSchema:   contentType.FieldSchema,
+	}
+	if err := tx.Create(&version).Error; err != nil {
+		return fmt.Errorf("フィールドの定義の版の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// fieldSchemaChanged は保存済みのフィールドの定義（JSONB）と変更後の定義が異なるかを確認します
+// JSONBはキーの順序や空白を保持しないため、一度エンティティに戻してから比較します
+func fieldSchemaChanged(stored, updated json.RawMessage) (bool, error) {
+	schema, err := unmarshalFieldSchema(stored)
+	if err != nil {
+		return false, err
+	}
+	return !bytes.Equal(marshalFieldSchema(schema), updated), nil
+}
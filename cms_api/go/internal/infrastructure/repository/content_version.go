@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetContentVersions はコンテンツの版一覧を新しい順に取得します（スナップショットは含みません）
+func (r *contentRepository) GetContentVersions(ctx context.Context, contentID uuid.UUID) ([]*entity.ContentVersion, error) {
+	var models []ContentVersionModel
+	err := r.db.WithContext(ctx).
+		Omit("snapshot").
+		Where("content_id = ?", contentID).
+		Order("version DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("コンテンツの版一覧の取得に失敗しました: %w", err)
+	}
+
+	if len(models) == 0 {
+		var count int64
+		if err := r.db.WithContext(ctx).Model(&ContentModel{}).Where("id = ?", contentID).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("コンテンツの存在確認に失敗しました: %w", err)
+		}
+		if count == 0 {
+			return nil, fmt.Errorf("%w: %s", entity.ErrContentNotFound, contentID.String())
+		}
+	}
+
+	versions := make([]*entity.ContentVersion, len(models))
+	for i := range models {
+		version, err := models[i].ToContentVersionEntity(false)
+		if err != nil {
+			return nil, err
+		}
+		versions[i] = version
+	}
+	return versions, nil
+}
+
+// GetContentVersion はコンテンツの指定された版をスナップショット込みで取得します
+func (r *contentRepository) GetContentVersion(ctx context.Context, contentID uuid.UUID, version int) (*entity.ContentVersion, error) {
+	var model ContentVersionModel
+	err := r.db.WithContext(ctx).
+		Where("content_id = ? AND version = ?", contentID, version).
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("%w: %s (version=%d)", entity.ErrContentVersionNotFound, contentID.String(), version)
+		}
+		return nil, fmt.Errorf("コンテンツの版の取得に失敗しました: %w", err)
+	}
+
+	return model.ToContentVersionEntity(true)
+}
+
+// saveVersion はトランザクション内の最新状態からコンテンツのスナップショットを作成し、現在のバージョンの版として保存します
+// あわせて一覧用の抜粋とカバー画像を最新のブロックから作成し直します
+func saveVersion(tx *gorm.DB, contentID uuid.UUID) error {
+	var contentModel ContentModel
+	err := tx.Preload("Tags", orderTags).
+		Preload("Blocks", orderBlocks).
+		Preload("Blocks.Data").
+		Where("id = ?", contentID).
+		First(&contentModel).Error
+	if err != nil {
+		return fmt.Errorf("スナップショット対象のコンテンツの取得に失敗しました: %w", err)
+	}
+
+	content, err := contentModel.ToContentEntity()
+	if err != nil {
+		return err
+	}
+	content.Summarize()
+	err = tx.Model(&ContentModel{}).Where("id = ?", contentID).UpdateColumns(map[string]interface{}{
+		"excerpt":         content.Excerpt,
+		"cover_image_url": content.CoverImageURL,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("コンテンツの抜粋の更新に失敗しました: %w", err)
+	}
+
+	var versionModel ContentVersionModel
+	if err := versionModel.FromContentEntitySnapshot(content); err != nil {
+		return err
+	}
+
+	if err := tx.Create(&versionModel).Error; err != nil {
+		return fmt.Errorf("コンテンツの版の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// orderBlocks はブロックのプリロード時に並び順でソートします
+func orderBlocks(db *gorm.DB) *gorm.DB {
+	return db.Order("block_order ASC, created_at ASC")
+}
@@ -2,10 +2,14 @@ package repository
 
 import (
 	"cms_api/internal/domain/entity"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
 )
 
 // ToContentEntity はContentModelをドメインエンティティに変換
-func (c *ContentModel) ToContentEntity() *entity.Content {
+func (c *ContentModel) ToContentEntity() (*entity.Content, error) {
 	content := &entity.Content{
 		ID:            c.ID,
 		ContentTypeID: c.ContentTypeID,
@@ -17,11 +21,36 @@ func (c *ContentModel) ToContentEntity() *entity.Content {
 		PublishedAt:   c.PublishedAt,
 		AuthorID:      c.AuthorID,
 		Version:       c.Version,
+		ScheduledAt:   c.ScheduledAt,
+		ExpiresAt:     c.ExpiresAt,
+		DeletedAt:     c.DeletedAt,
+		CategoryID:    c.CategoryID,
+		Excerpt:       c.Excerpt,
+		CoverImageURL: c.CoverImageURL,
 	}
 
 	// コンテンツタイプの変換
 	if c.ContentType != nil {
-		content.ContentType = c.ContentType.ToContentTypeEntity()
+		contentType, err := c.ContentType.ToContentTypeEntity()
+		if err != nil {
+			return nil, err
+		}
+		content.ContentType = contentType
+	}
+
+	// カテゴリの変換
+	if c.Category != nil {
+		content.Category = c.Category.ToCategoryEntity()
+	}
+
+	// タグの変換（TagIDsはタグの名前順）
+	content.TagIDs = make([]uuid.UUID, len(c.Tags))
+	if len(c.Tags) > 0 {
+		content.Tags = make([]entity.Tag, len(c.Tags))
+		for i, tag := range c.Tags {
+			content.Tags[i] = *tag.ToTagEntity()
+			content.TagIDs[i] = tag.ID
+		}
 	}
 
 	// ブロックの変換
@@ -32,7 +61,7 @@ func (c *ContentModel) ToContentEntity() *entity.Content {
 		}
 	}
 
-	return content
+	return content, nil
 }
 
 // FromContentEntity はドメインエンティティからContentModelを作成
@@ -47,21 +76,34 @@ func (c *ContentModel) FromContentEntity(content *entity.Content) {
 	c.PublishedAt = content.PublishedAt
 	c.AuthorID = content.AuthorID
 	c.Version = content.Version
+	c.ScheduledAt = content.ScheduledAt
+	c.ExpiresAt = content.ExpiresAt
+	c.DeletedAt = content.DeletedAt
+	c.CategoryID = content.CategoryID
+	c.Excerpt = content.Excerpt
+	c.CoverImageURL = content.CoverImageURL
 }
 
 // ToContentTypeEntity はContentTypeModelをドメインエンティティに変換
-func (ct *ContentTypeModel) ToContentTypeEntity() *entity.ContentType {
-	return &entity.ContentType{
-		ID:          ct.ID,
-		Name:        ct.Name,
-		DisplayName: ct.DisplayName,
-		Description: ct.Description,
-		Icon:        ct.Icon,
-		IsActive:    ct.IsActive,
-		CreatedAt:   ct.CreatedAt,
-		UpdatedAt:   ct.UpdatedAt,
-		CreatedBy:   ct.CreatedBy,
+// フィールドの定義が読み取れない場合はエラーを返す（定義なしとして扱うとブロックの検証が行われなくなるため）
+func (ct *ContentTypeModel) ToContentTypeEntity() (*entity.ContentType, error) {
+	fieldSchema, err := unmarshalFieldSchema(ct.FieldSchema)
+	if err != nil {
+		return nil, fmt.Errorf("コンテンツタイプ %s の%w", ct.Name, err)
 	}
+	return &entity.ContentType{
+		ID:            ct.ID,
+		Name:          ct.Name,
+		DisplayName:   ct.DisplayName,
+		Description:   ct.Description,
+		Icon:          ct.Icon,
+		IsActive:      ct.IsActive,
+		CreatedAt:     ct.CreatedAt,
+		UpdatedAt:     ct.UpdatedAt,
+		CreatedBy:     ct.CreatedBy,
+		FieldSchema:   fieldSchema,
+		SchemaVersion: ct.SchemaVersion,
+	}, nil
 }
 
 // FromContentTypeEntity はドメインエンティティからContentTypeModelを作成
@@ -75,6 +117,28 @@ func (ct *ContentTypeModel) FromContentTypeEntity(contentType *entity.ContentTyp
 	ct.CreatedAt = contentType.CreatedAt
 	ct.UpdatedAt = contentType.UpdatedAt
 	ct.CreatedBy = contentType.CreatedBy
+	ct.FieldSchema = marshalFieldSchema(contentType.FieldSchema)
+	ct.SchemaVersion = contentType.SchemaVersion
+}
+
+// marshalFieldSchema はフィールドの定義をJSONに変換します
+func marshalFieldSchema(schema entity.FieldSchema) json.RawMessage {
+	if schema.Fields == nil {
+		schema.Fields = []entity.FieldDefinition{}
+	}
+	data, _ := json.Marshal(schema)
+	return data
+}
+
+// unmarshalFieldSchema はJSONをフィールドの定義に変換します
+func unmarshalFieldSchema(data json.RawMessage) (entity.FieldSchema, error) {
+	var schema entity.FieldSchema
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return entity.FieldSchema{}, fmt.Errorf("フィールドの定義の読み取りに失敗しました: %w", err)
+		}
+	}
+	return schema, nil
 }
 
 // ToContentBlockEntity はContentBlockModelをドメインエンティティに変換
@@ -138,6 +202,114 @@ func (cbd *ContentBlockDataModel) FromContentBlockDataEntity(data *entity.Conten
 	cbd.ContentJSON = data.ContentJSON
 	cbd.ReferencedContentID = data.ReferencedContentID
 	cbd.Settings = data.Settings
+	cbd.SearchText = entity.NormalizeSearchText(data.PlainText())
 	cbd.CreatedAt = data.CreatedAt
 	cbd.UpdatedAt = data.UpdatedAt
-}
\ No newline at end of file
+}
+
+// ToContentVersionEntity はContentVersionModelをドメインエンティティに変換
+// withSnapshotがtrueの場合はスナップショットを復元して含める
+func (cv *ContentVersionModel) ToContentVersionEntity(withSnapshot bool) (*entity.ContentVersion, error) {
+	version := &entity.ContentVersion{
+		ID:        cv.ID,
+		ContentID: cv.ContentID,
+		Version:   cv.Version,
+		Title:     cv.Title,
+		Status:    entity.ContentStatus(cv.Status),
+		AuthorID:  cv.AuthorID,
+		CreatedAt: cv.CreatedAt,
+	}
+
+	if withSnapshot {
+		var snapshot entity.Content
+		if err := json.Unmarshal(cv.Snapshot, &snapshot); err != nil {
+			return nil, fmt.Errorf("スナップショットの復元に失敗しました: %w", err)
+		}
+		version.Snapshot = &snapshot
+	}
+
+	return version, nil
+}
+
+// FromContentEntitySnapshot はドメインエンティティのスナップショットからContentVersionModelを作成
+func (cv *ContentVersionModel) FromContentEntitySnapshot(content *entity.Content) error {
+	snapshot, err := json.Marshal(content)
+	if err != nil {
+		return fmt.Errorf("スナップショットの作成に失敗しました: %w", err)
+	}
+
+	cv.ContentID = content.ID
+	cv.Version = content.Version
+	cv.Title = content.Title
+	cv.Status = string(content.Status)
+	cv.AuthorID = content.AuthorID
+	cv.Snapshot = snapshot
+	return nil
+}
+
+// ToCategoryEntity はCategoryModelをドメインエンティティに変換
+func (c *CategoryModel) ToCategoryEntity() *entity.Category {
+	return &entity.Category{
+		ID:          c.ID,
+		Name:        c.Name,
+		Slug:        c.Slug,
+		Description: c.Description,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// FromCategoryEntity はドメインエンティティからCategoryModelを作成
+func (c *CategoryModel) FromCategoryEntity(category *entity.Category) {
+	c.ID = category.ID
+	c.Name = category.Name
+	c.Slug = category.Slug
+	c.Description = category.Description
+	c.CreatedAt = category.CreatedAt
+	c.UpdatedAt = category.UpdatedAt
+}
+
+// ToTagEntity はTagModelをドメインエンティティに変換
+func (t *TagModel) ToTagEntity() *entity.Tag {
+	return &entity.Tag{
+		ID:        t.ID,
+		Name:      t.Name,
+		Slug:      t.Slug,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// FromTagEntity はドメインエンティティからTagModelを作成
+func (t *TagModel) FromTagEntity(tag *entity.Tag) {
+	t.ID = tag.ID
+	t.Name = tag.Name
+	t.Slug = tag.Slug
+	t.CreatedAt = tag.CreatedAt
+	t.UpdatedAt = tag.UpdatedAt
+}
+
+// ToSlugRedirectEntity はContentSlugRedirectModelをドメインエンティティに変換
+func (r *ContentSlugRedirectModel) ToSlugRedirectEntity() *entity.SlugRedirect {
+	return &entity.SlugRedirect{
+		ID:            r.ID,
+		ContentID:     r.ContentID,
+		ContentTypeID: r.ContentTypeID,
+		Slug:          r.Slug,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+// ToContentTypeSchemaVersionEntity はContentTypeSchemaVersionModelをドメインエンティティに変換
+func (v *ContentTypeSchemaVersionModel) ToContentTypeSchemaVersionEntity() (*entity.ContentTypeSchemaVersion, error) {
+	fieldSchema, err := unmarshalFieldSchema(v.FieldSchema)
+	if err != nil {
+		return nil, fmt.Errorf("版 %d の%w", v.Version, err)
+	}
+	return &entity.ContentTypeSchemaVersion{
+		ContentTypeID: v.ContentTypeID,
+		Version:       v.Version,
+		FieldSchema:   fieldSchema,
+		CreatedAt:     v.CreatedAt,
+	}, nil
+}
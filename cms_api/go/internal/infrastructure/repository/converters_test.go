@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentVersionSnapshotRoundTrip(t *testing.T) {
+	content := &entity.Content{
+		ID:            uuid.New(),
+		ContentTypeID: uuid.New(),
+		Title:         "タイトル",
+		Slug:          "title",
+		Status:        entity.ContentStatusPublished,
+		AuthorID:      "admin",
+		Version:       3,
+		Blocks: []entity.ContentBlock{
+			{
+				ID:         uuid.New(),
+				BlockType:  entity.BlockTypeRichText,
+				BlockOrder: 1,
+				IsVisible:  true,
+				Data: &entity.ContentBlockData{
+					DataType:        entity.DataTypeRichText,
+					ContentRichtext: json.RawMessage(`{"type":"doc"}`),
+				},
+			},
+		},
+	}
+
+	var model ContentVersionModel
+	assert.NoError(t, model.FromContentEntitySnapshot(content))
+	assert.Equal(t, content.ID, model.ContentID)
+	assert.Equal(t, 3, model.Version)
+	assert.Equal(t, "published", model.Status)
+
+	summary, err := model.ToContentVersionEntity(false)
+	assert.NoError(t, err)
+	assert.Nil(t, summary.Snapshot)
+
+	version, err := model.ToContentVersionEntity(true)
+	assert.NoError(t, err)
+	assert.Equal(t, content.Title, version.Snapshot.Title)
+	assert.Equal(t, content.Blocks[0].ID, version.Snapshot.Blocks[0].ID)
+	assert.JSONEq(t, `{"type":"doc"}`, string(version.Snapshot.Blocks[0].Data.ContentRichtext))
+}
+
+func TestContentModelToContentEntityTags(t *testing.T) {
+	categoryID := uuid.New()
+	tags := []TagModel{
+		{ID: uuid.New(), Name: "AWS", Slug: "aws"},
+		{ID: uuid.New(), Name: "Go", Slug: "go"},
+	}
+	model := &ContentModel{
+		ID:         uuid.New(),
+		CategoryID: &categoryID,
+		Category:   &CategoryModel{ID: categoryID, Name: "技術", Slug: "tech"},
+		Tags:       tags,
+	}
+
+	content, err := model.ToContentEntity()
+
+	assert.NoError(t, err)
+	assert.Equal(t, &categoryID, content.CategoryID)
+	assert.Equal(t, "tech", content.Category.Slug)
+	assert.Equal(t, []uuid.UUID{tags[0].ID, tags[1].ID}, content.TagIDs)
+	assert.Equal(t, "go", content.Tags[1].Slug)
+
+	// タグがない場合は空のタグID一覧になる
+	content, err = (&ContentModel{}).ToContentEntity()
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{}, content.TagIDs)
+}
+
+func TestContentBlockDataModelSearchText(t *testing.T) {
+	data := &entity.ContentBlockData{
+		DataType:        entity.DataTypeRichText,
+		ContentRichtext: json.RawMessage(`{"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"ＡＷＳ Lambdaの概要"}]}]}`),
+	}
+
+	var model ContentBlockDataModel
+	model.FromContentBlockDataEntity(data)
+
+	// リッチテキストから抽出した本文を正規化して保存する
+	assert.Equal(t, "aws lambdaの概要", model.SearchText)
+}
+
+func TestContentTypeModelFieldSchema(t *testing.T) {
+	contentType := &entity.ContentType{
+		Name:        "blog_post",
+		DisplayName: "ブログ記事",
+		CreatedBy:   "admin",
+		FieldSchema: entity.FieldSchema{
+			Fields: []entity.FieldDefinition{
+				{BlockType: entity.BlockTypeRichText, Label: "本文", Required: true, MaxLength: 10000},
+				{BlockType: entity.BlockTypeImage, Label: "画像", MaxCount: 5},
+			},
+			Ordered: true,
+		},
+	}
+
+	var model ContentTypeModel
+	model.FromContentTypeEntity(contentType)
+	assert.JSONEq(t, `{"fields":[
+		{"block_type":"richtext","label":"本文","required":true,"max_count":0,"max_length":10000},
+		{"block_type":"image","label":"画像","required":false,"max_count":5,"max_length":0}],"ordered":true}`,
+		string(model.FieldSchema))
+	converted, err := model.ToContentTypeEntity()
+	assert.NoError(t, err)
+	assert.Equal(t, contentType.FieldSchema, converted.FieldSchema)
+
+	// 定義がない場合は空の一覧として保存し、読み取れない場合はエラーになる
+	model.FromContentTypeEntity(&entity.ContentType{Name: "page"})
+	assert.JSONEq(t, `{"fields":[],"ordered":false}`, string(model.FieldSchema))
+	model.FieldSchema = json.RawMessage(`{"fields":"invalid"}`)
+	_, err = model.ToContentTypeEntity()
+	assert.Error(t, err)
+	_, err = (&ContentModel{ContentType: &model}).ToContentEntity()
+	assert.Error(t, err)
+}
@@ -3,10 +3,16 @@
 package mocks
 
 import (
-	model "cms_api/internal/domain/entity"
+	entity "cms_api/internal/domain/entity"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	repository "cms_api/internal/infrastructure/repository"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
 )
 
 // ContentRepository is an autogenerated mock type for the ContentRepository type
@@ -22,17 +28,76 @@ func (_m *ContentRepository) EXPECT() *ContentRepository_Expecter {
 	return &ContentRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateArticle provides a mock function with given fields: content
-func (_m *ContentRepository) CreateArticle(content *model.Article) error {
-	ret := _m.Called(content)
+// ContentSlugExists provides a mock function with given fields: ctx, contentTypeID, slug, exceptID
+func (_m *ContentRepository) ContentSlugExists(ctx context.Context, contentTypeID uuid.UUID, slug string, exceptID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, contentTypeID, slug, exceptID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContentSlugExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, contentTypeID, slug, exceptID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID) bool); ok {
+		r0 = rf(ctx, contentTypeID, slug, exceptID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentTypeID, slug, exceptID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_ContentSlugExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContentSlugExists'
+type ContentRepository_ContentSlugExists_Call struct {
+	*mock.Call
+}
+
+// ContentSlugExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentTypeID uuid.UUID
+//   - slug string
+//   - exceptID uuid.UUID
+func (_e *ContentRepository_Expecter) ContentSlugExists(ctx interface{}, contentTypeID interface{}, slug interface{}, exceptID interface{}) *ContentRepository_ContentSlugExists_Call {
+	return &ContentRepository_ContentSlugExists_Call{Call: _e.mock.On("ContentSlugExists", ctx, contentTypeID, slug, exceptID)}
+}
+
+func (_c *ContentRepository_ContentSlugExists_Call) Run(run func(ctx context.Context, contentTypeID uuid.UUID, slug string, exceptID uuid.UUID)) *ContentRepository_ContentSlugExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_ContentSlugExists_Call) Return(_a0 bool, _a1 error) *ContentRepository_ContentSlugExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_ContentSlugExists_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, uuid.UUID) (bool, error)) *ContentRepository_ContentSlugExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCategory provides a mock function with given fields: ctx, category
+func (_m *ContentRepository) CreateCategory(ctx context.Context, category *entity.Category) error {
+	ret := _m.Called(ctx, category)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateArticle")
+		panic("no return value specified for CreateCategory")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*model.Article) error); ok {
-		r0 = rf(content)
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Category) error); ok {
+		r0 = rf(ctx, category)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -40,45 +105,46 @@ func (_m *ContentRepository) CreateArticle(content *model.Article) error {
 	return r0
 }
 
-// ContentRepository_CreateArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateArticle'
-type ContentRepository_CreateArticle_Call struct {
+// ContentRepository_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type ContentRepository_CreateCategory_Call struct {
 	*mock.Call
 }
 
-// CreateArticle is a helper method to define mock.On call
-//   - content *model.Article
-func (_e *ContentRepository_Expecter) CreateArticle(content interface{}) *ContentRepository_CreateArticle_Call {
-	return &ContentRepository_CreateArticle_Call{Call: _e.mock.On("CreateArticle", content)}
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *entity.Category
+func (_e *ContentRepository_Expecter) CreateCategory(ctx interface{}, category interface{}) *ContentRepository_CreateCategory_Call {
+	return &ContentRepository_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, category)}
 }
 
-func (_c *ContentRepository_CreateArticle_Call) Run(run func(content *model.Article)) *ContentRepository_CreateArticle_Call {
+func (_c *ContentRepository_CreateCategory_Call) Run(run func(ctx context.Context, category *entity.Category)) *ContentRepository_CreateCategory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*model.Article))
+		run(args[0].(context.Context), args[1].(*entity.Category))
 	})
 	return _c
 }
 
-func (_c *ContentRepository_CreateArticle_Call) Return(_a0 error) *ContentRepository_CreateArticle_Call {
+func (_c *ContentRepository_CreateCategory_Call) Return(_a0 error) *ContentRepository_CreateCategory_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ContentRepository_CreateArticle_Call) RunAndReturn(run func(*model.Article) error) *ContentRepository_CreateArticle_Call {
+func (_c *ContentRepository_CreateCategory_Call) RunAndReturn(run func(context.Context, *entity.Category) error) *ContentRepository_CreateCategory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteArticle provides a mock function with given fields: id
-func (_m *ContentRepository) DeleteArticle(id string) error {
-	ret := _m.Called(id)
+// CreateContent provides a mock function with given fields: ctx, content
+func (_m *ContentRepository) CreateContent(ctx context.Context, content *entity.Content) error {
+	ret := _m.Called(ctx, content)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteArticle")
+		panic("no return value specified for CreateContent")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Content) error); ok {
+		r0 = rf(ctx, content)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -86,57 +152,343 @@ func (_m *ContentRepository) DeleteArticle(id string) error {
 	return r0
 }
 
-// ContentRepository_DeleteArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticle'
-type ContentRepository_DeleteArticle_Call struct {
+// ContentRepository_CreateContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateContent'
+type ContentRepository_CreateContent_Call struct {
 	*mock.Call
 }
 
-// DeleteArticle is a helper method to define mock.On call
-//   - id string
-func (_e *ContentRepository_Expecter) DeleteArticle(id interface{}) *ContentRepository_DeleteArticle_Call {
-	return &ContentRepository_DeleteArticle_Call{Call: _e.mock.On("DeleteArticle", id)}
+// CreateContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content *entity.Content
+func (_e *ContentRepository_Expecter) CreateContent(ctx interface{}, content interface{}) *ContentRepository_CreateContent_Call {
+	return &ContentRepository_CreateContent_Call{Call: _e.mock.On("CreateContent", ctx, content)}
 }
 
-func (_c *ContentRepository_DeleteArticle_Call) Run(run func(id string)) *ContentRepository_DeleteArticle_Call {
+func (_c *ContentRepository_CreateContent_Call) Run(run func(ctx context.Context, content *entity.Content)) *ContentRepository_CreateContent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(*entity.Content))
 	})
 	return _c
 }
 
-func (_c *ContentRepository_DeleteArticle_Call) Return(_a0 error) *ContentRepository_DeleteArticle_Call {
+func (_c *ContentRepository_CreateContent_Call) Return(_a0 error) *ContentRepository_CreateContent_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ContentRepository_DeleteArticle_Call) RunAndReturn(run func(string) error) *ContentRepository_DeleteArticle_Call {
+func (_c *ContentRepository_CreateContent_Call) RunAndReturn(run func(context.Context, *entity.Content) error) *ContentRepository_CreateContent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetArticles provides a mock function with given fields: ctx
-func (_m *ContentRepository) GetArticles(ctx context.Context) ([]model.Article, error) {
-	ret := _m.Called(ctx)
+// CreateContentType provides a mock function with given fields: ctx, contentType
+func (_m *ContentRepository) CreateContentType(ctx context.Context, contentType *entity.ContentType) error {
+	ret := _m.Called(ctx, contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateContentType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentType) error); ok {
+		r0 = rf(ctx, contentType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_CreateContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateContentType'
+type ContentRepository_CreateContentType_Call struct {
+	*mock.Call
+}
+
+// CreateContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentType *entity.ContentType
+func (_e *ContentRepository_Expecter) CreateContentType(ctx interface{}, contentType interface{}) *ContentRepository_CreateContentType_Call {
+	return &ContentRepository_CreateContentType_Call{Call: _e.mock.On("CreateContentType", ctx, contentType)}
+}
+
+func (_c *ContentRepository_CreateContentType_Call) Run(run func(ctx context.Context, contentType *entity.ContentType)) *ContentRepository_CreateContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ContentType))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_CreateContentType_Call) Return(_a0 error) *ContentRepository_CreateContentType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_CreateContentType_Call) RunAndReturn(run func(context.Context, *entity.ContentType) error) *ContentRepository_CreateContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: ctx, tag
+func (_m *ContentRepository) CreateTag(ctx context.Context, tag *entity.Tag) error {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Tag) error); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type ContentRepository_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag *entity.Tag
+func (_e *ContentRepository_Expecter) CreateTag(ctx interface{}, tag interface{}) *ContentRepository_CreateTag_Call {
+	return &ContentRepository_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, tag)}
+}
+
+func (_c *ContentRepository_CreateTag_Call) Run(run func(ctx context.Context, tag *entity.Tag)) *ContentRepository_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Tag))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_CreateTag_Call) Return(_a0 error) *ContentRepository_CreateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_CreateTag_Call) RunAndReturn(run func(context.Context, *entity.Tag) error) *ContentRepository_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBlock provides a mock function with given fields: ctx, contentID, expectedVersion, blockID
+func (_m *ContentRepository) DeleteBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID) error {
+	ret := _m.Called(ctx, contentID, expectedVersion, blockID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, uuid.UUID) error); ok {
+		r0 = rf(ctx, contentID, expectedVersion, blockID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_DeleteBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlock'
+type ContentRepository_DeleteBlock_Call struct {
+	*mock.Call
+}
+
+// DeleteBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - expectedVersion int
+//   - blockID uuid.UUID
+func (_e *ContentRepository_Expecter) DeleteBlock(ctx interface{}, contentID interface{}, expectedVersion interface{}, blockID interface{}) *ContentRepository_DeleteBlock_Call {
+	return &ContentRepository_DeleteBlock_Call{Call: _e.mock.On("DeleteBlock", ctx, contentID, expectedVersion, blockID)}
+}
+
+func (_c *ContentRepository_DeleteBlock_Call) Run(run func(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID)) *ContentRepository_DeleteBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_DeleteBlock_Call) Return(_a0 error) *ContentRepository_DeleteBlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_DeleteBlock_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, uuid.UUID) error) *ContentRepository_DeleteBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, id
+func (_m *ContentRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type ContentRepository_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentRepository_Expecter) DeleteCategory(ctx interface{}, id interface{}) *ContentRepository_DeleteCategory_Call {
+	return &ContentRepository_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, id)}
+}
+
+func (_c *ContentRepository_DeleteCategory_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentRepository_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_DeleteCategory_Call) Return(_a0 error) *ContentRepository_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_DeleteCategory_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ContentRepository_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSlugRedirect provides a mock function with given fields: ctx, contentID, redirectID
+func (_m *ContentRepository) DeleteSlugRedirect(ctx context.Context, contentID uuid.UUID, redirectID uuid.UUID) error {
+	ret := _m.Called(ctx, contentID, redirectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSlugRedirect")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, contentID, redirectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_DeleteSlugRedirect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSlugRedirect'
+type ContentRepository_DeleteSlugRedirect_Call struct {
+	*mock.Call
+}
+
+// DeleteSlugRedirect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - redirectID uuid.UUID
+func (_e *ContentRepository_Expecter) DeleteSlugRedirect(ctx interface{}, contentID interface{}, redirectID interface{}) *ContentRepository_DeleteSlugRedirect_Call {
+	return &ContentRepository_DeleteSlugRedirect_Call{Call: _e.mock.On("DeleteSlugRedirect", ctx, contentID, redirectID)}
+}
+
+func (_c *ContentRepository_DeleteSlugRedirect_Call) Run(run func(ctx context.Context, contentID uuid.UUID, redirectID uuid.UUID)) *ContentRepository_DeleteSlugRedirect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_DeleteSlugRedirect_Call) Return(_a0 error) *ContentRepository_DeleteSlugRedirect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_DeleteSlugRedirect_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ContentRepository_DeleteSlugRedirect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, id
+func (_m *ContentRepository) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type ContentRepository_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentRepository_Expecter) DeleteTag(ctx interface{}, id interface{}) *ContentRepository_DeleteTag_Call {
+	return &ContentRepository_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, id)}
+}
+
+func (_c *ContentRepository_DeleteTag_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentRepository_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_DeleteTag_Call) Return(_a0 error) *ContentRepository_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_DeleteTag_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ContentRepository_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArchiveCounts provides a mock function with given fields: ctx, visibleAt, timeZone
+func (_m *ContentRepository) GetArchiveCounts(ctx context.Context, visibleAt time.Time, timeZone string) ([]*entity.ArchiveCount, error) {
+	ret := _m.Called(ctx, visibleAt, timeZone)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetArticles")
+		panic("no return value specified for GetArchiveCounts")
 	}
 
-	var r0 []model.Article
+	var r0 []*entity.ArchiveCount
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Article, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string) ([]*entity.ArchiveCount, error)); ok {
+		return rf(ctx, visibleAt, timeZone)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []model.Article); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string) []*entity.ArchiveCount); ok {
+		r0 = rf(ctx, visibleAt, timeZone)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Article)
+			r0 = ret.Get(0).([]*entity.ArchiveCount)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, string) error); ok {
+		r1 = rf(ctx, visibleAt, timeZone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -144,76 +496,2055 @@ func (_m *ContentRepository) GetArticles(ctx context.Context) ([]model.Article,
 	return r0, r1
 }
 
-// ContentRepository_GetArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticles'
-type ContentRepository_GetArticles_Call struct {
+// ContentRepository_GetArchiveCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArchiveCounts'
+type ContentRepository_GetArchiveCounts_Call struct {
 	*mock.Call
 }
 
-// GetArticles is a helper method to define mock.On call
+// GetArchiveCounts is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *ContentRepository_Expecter) GetArticles(ctx interface{}) *ContentRepository_GetArticles_Call {
-	return &ContentRepository_GetArticles_Call{Call: _e.mock.On("GetArticles", ctx)}
+//   - visibleAt time.Time
+//   - timeZone string
+func (_e *ContentRepository_Expecter) GetArchiveCounts(ctx interface{}, visibleAt interface{}, timeZone interface{}) *ContentRepository_GetArchiveCounts_Call {
+	return &ContentRepository_GetArchiveCounts_Call{Call: _e.mock.On("GetArchiveCounts", ctx, visibleAt, timeZone)}
 }
 
-func (_c *ContentRepository_GetArticles_Call) Run(run func(ctx context.Context)) *ContentRepository_GetArticles_Call {
+func (_c *ContentRepository_GetArchiveCounts_Call) Run(run func(ctx context.Context, visibleAt time.Time, timeZone string)) *ContentRepository_GetArchiveCounts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *ContentRepository_GetArticles_Call) Return(_a0 []model.Article, _a1 error) *ContentRepository_GetArticles_Call {
+func (_c *ContentRepository_GetArchiveCounts_Call) Return(_a0 []*entity.ArchiveCount, _a1 error) *ContentRepository_GetArchiveCounts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ContentRepository_GetArticles_Call) RunAndReturn(run func(context.Context) ([]model.Article, error)) *ContentRepository_GetArticles_Call {
+func (_c *ContentRepository_GetArchiveCounts_Call) RunAndReturn(run func(context.Context, time.Time, string) ([]*entity.ArchiveCount, error)) *ContentRepository_GetArchiveCounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateArticle provides a mock function with given fields: content
-func (_m *ContentRepository) UpdateArticle(content *model.Article) error {
-	ret := _m.Called(content)
+// GetCategories provides a mock function with given fields: ctx
+func (_m *ContentRepository) GetCategories(ctx context.Context) ([]*entity.Category, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateArticle")
+		panic("no return value specified for GetCategories")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*model.Article) error); ok {
-		r0 = rf(content)
+	var r0 []*entity.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Category, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Category); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Category)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ContentRepository_UpdateArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateArticle'
-type ContentRepository_UpdateArticle_Call struct {
+// ContentRepository_GetCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategories'
+type ContentRepository_GetCategories_Call struct {
 	*mock.Call
 }
 
-// UpdateArticle is a helper method to define mock.On call
-//   - content *model.Article
-func (_e *ContentRepository_Expecter) UpdateArticle(content interface{}) *ContentRepository_UpdateArticle_Call {
-	return &ContentRepository_UpdateArticle_Call{Call: _e.mock.On("UpdateArticle", content)}
+// GetCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentRepository_Expecter) GetCategories(ctx interface{}) *ContentRepository_GetCategories_Call {
+	return &ContentRepository_GetCategories_Call{Call: _e.mock.On("GetCategories", ctx)}
+}
+
+func (_c *ContentRepository_GetCategories_Call) Run(run func(ctx context.Context)) *ContentRepository_GetCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetCategories_Call) Return(_a0 []*entity.Category, _a1 error) *ContentRepository_GetCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetCategories_Call) RunAndReturn(run func(context.Context) ([]*entity.Category, error)) *ContentRepository_GetCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryByID provides a mock function with given fields: ctx, id
+func (_m *ContentRepository) GetCategoryByID(ctx context.Context, id uuid.UUID) (*entity.Category, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryByID")
+	}
+
+	var r0 *entity.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Category, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Category); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetCategoryByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryByID'
+type ContentRepository_GetCategoryByID_Call struct {
+	*mock.Call
+}
+
+// GetCategoryByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentRepository_Expecter) GetCategoryByID(ctx interface{}, id interface{}) *ContentRepository_GetCategoryByID_Call {
+	return &ContentRepository_GetCategoryByID_Call{Call: _e.mock.On("GetCategoryByID", ctx, id)}
+}
+
+func (_c *ContentRepository_GetCategoryByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentRepository_GetCategoryByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetCategoryByID_Call) Return(_a0 *entity.Category, _a1 error) *ContentRepository_GetCategoryByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetCategoryByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Category, error)) *ContentRepository_GetCategoryByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryCounts provides a mock function with given fields: ctx, visibleAt
+func (_m *ContentRepository) GetCategoryCounts(ctx context.Context, visibleAt time.Time) ([]*entity.CategoryCount, error) {
+	ret := _m.Called(ctx, visibleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryCounts")
+	}
+
+	var r0 []*entity.CategoryCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*entity.CategoryCount, error)); ok {
+		return rf(ctx, visibleAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*entity.CategoryCount); ok {
+		r0 = rf(ctx, visibleAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.CategoryCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, visibleAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetCategoryCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryCounts'
+type ContentRepository_GetCategoryCounts_Call struct {
+	*mock.Call
+}
+
+// GetCategoryCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - visibleAt time.Time
+func (_e *ContentRepository_Expecter) GetCategoryCounts(ctx interface{}, visibleAt interface{}) *ContentRepository_GetCategoryCounts_Call {
+	return &ContentRepository_GetCategoryCounts_Call{Call: _e.mock.On("GetCategoryCounts", ctx, visibleAt)}
+}
+
+func (_c *ContentRepository_GetCategoryCounts_Call) Run(run func(ctx context.Context, visibleAt time.Time)) *ContentRepository_GetCategoryCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetCategoryCounts_Call) Return(_a0 []*entity.CategoryCount, _a1 error) *ContentRepository_GetCategoryCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetCategoryCounts_Call) RunAndReturn(run func(context.Context, time.Time) ([]*entity.CategoryCount, error)) *ContentRepository_GetCategoryCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentByID provides a mock function with given fields: ctx, id
+func (_m *ContentRepository) GetContentByID(ctx context.Context, id uuid.UUID) (*entity.Content, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentByID")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Content, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Content); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentByID'
+type ContentRepository_GetContentByID_Call struct {
+	*mock.Call
+}
+
+// GetContentByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentRepository_Expecter) GetContentByID(ctx interface{}, id interface{}) *ContentRepository_GetContentByID_Call {
+	return &ContentRepository_GetContentByID_Call{Call: _e.mock.On("GetContentByID", ctx, id)}
+}
+
+func (_c *ContentRepository_GetContentByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentRepository_GetContentByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentByID_Call) Return(_a0 *entity.Content, _a1 error) *ContentRepository_GetContentByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Content, error)) *ContentRepository_GetContentByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentBySlug provides a mock function with given fields: ctx, contentTypeName, slug
+func (_m *ContentRepository) GetContentBySlug(ctx context.Context, contentTypeName string, slug string) (*entity.Content, error) {
+	ret := _m.Called(ctx, contentTypeName, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentBySlug")
+	}
+
+	var r0 *entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.Content, error)); ok {
+		return rf(ctx, contentTypeName, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entity.Content); ok {
+		r0 = rf(ctx, contentTypeName, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, contentTypeName, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentBySlug'
+type ContentRepository_GetContentBySlug_Call struct {
+	*mock.Call
+}
+
+// GetContentBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentTypeName string
+//   - slug string
+func (_e *ContentRepository_Expecter) GetContentBySlug(ctx interface{}, contentTypeName interface{}, slug interface{}) *ContentRepository_GetContentBySlug_Call {
+	return &ContentRepository_GetContentBySlug_Call{Call: _e.mock.On("GetContentBySlug", ctx, contentTypeName, slug)}
+}
+
+func (_c *ContentRepository_GetContentBySlug_Call) Run(run func(ctx context.Context, contentTypeName string, slug string)) *ContentRepository_GetContentBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentBySlug_Call) Return(_a0 *entity.Content, _a1 error) *ContentRepository_GetContentBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentBySlug_Call) RunAndReturn(run func(context.Context, string, string) (*entity.Content, error)) *ContentRepository_GetContentBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentTypeByID provides a mock function with given fields: ctx, id
+func (_m *ContentRepository) GetContentTypeByID(ctx context.Context, id uuid.UUID) (*entity.ContentType, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentTypeByID")
+	}
+
+	var r0 *entity.ContentType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ContentType, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ContentType); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentTypeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentTypeByID'
+type ContentRepository_GetContentTypeByID_Call struct {
+	*mock.Call
+}
+
+// GetContentTypeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentRepository_Expecter) GetContentTypeByID(ctx interface{}, id interface{}) *ContentRepository_GetContentTypeByID_Call {
+	return &ContentRepository_GetContentTypeByID_Call{Call: _e.mock.On("GetContentTypeByID", ctx, id)}
+}
+
+func (_c *ContentRepository_GetContentTypeByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentRepository_GetContentTypeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentTypeByID_Call) Return(_a0 *entity.ContentType, _a1 error) *ContentRepository_GetContentTypeByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentTypeByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.ContentType, error)) *ContentRepository_GetContentTypeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentTypeNames provides a mock function with given fields: ctx, contentIDs
+func (_m *ContentRepository) GetContentTypeNames(ctx context.Context, contentIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	ret := _m.Called(ctx, contentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentTypeNames")
+	}
+
+	var r0 map[uuid.UUID]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]string, error)); ok {
+		return rf(ctx, contentIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]string); ok {
+		r0 = rf(ctx, contentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, contentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentTypeNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentTypeNames'
+type ContentRepository_GetContentTypeNames_Call struct {
+	*mock.Call
+}
+
+// GetContentTypeNames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentIDs []uuid.UUID
+func (_e *ContentRepository_Expecter) GetContentTypeNames(ctx interface{}, contentIDs interface{}) *ContentRepository_GetContentTypeNames_Call {
+	return &ContentRepository_GetContentTypeNames_Call{Call: _e.mock.On("GetContentTypeNames", ctx, contentIDs)}
+}
+
+func (_c *ContentRepository_GetContentTypeNames_Call) Run(run func(ctx context.Context, contentIDs []uuid.UUID)) *ContentRepository_GetContentTypeNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentTypeNames_Call) Return(_a0 map[uuid.UUID]string, _a1 error) *ContentRepository_GetContentTypeNames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentTypeNames_Call) RunAndReturn(run func(context.Context, []uuid.UUID) (map[uuid.UUID]string, error)) *ContentRepository_GetContentTypeNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentTypeSchemaVersions provides a mock function with given fields: ctx, contentTypeID
+func (_m *ContentRepository) GetContentTypeSchemaVersions(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error) {
+	ret := _m.Called(ctx, contentTypeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentTypeSchemaVersions")
+	}
+
+	var r0 []*entity.ContentTypeSchemaVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error)); ok {
+		return rf(ctx, contentTypeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.ContentTypeSchemaVersion); ok {
+		r0 = rf(ctx, contentTypeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ContentTypeSchemaVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentTypeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentTypeSchemaVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentTypeSchemaVersions'
+type ContentRepository_GetContentTypeSchemaVersions_Call struct {
+	*mock.Call
+}
+
+// GetContentTypeSchemaVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentTypeID uuid.UUID
+func (_e *ContentRepository_Expecter) GetContentTypeSchemaVersions(ctx interface{}, contentTypeID interface{}) *ContentRepository_GetContentTypeSchemaVersions_Call {
+	return &ContentRepository_GetContentTypeSchemaVersions_Call{Call: _e.mock.On("GetContentTypeSchemaVersions", ctx, contentTypeID)}
+}
+
+func (_c *ContentRepository_GetContentTypeSchemaVersions_Call) Run(run func(ctx context.Context, contentTypeID uuid.UUID)) *ContentRepository_GetContentTypeSchemaVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentTypeSchemaVersions_Call) Return(_a0 []*entity.ContentTypeSchemaVersion, _a1 error) *ContentRepository_GetContentTypeSchemaVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentTypeSchemaVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error)) *ContentRepository_GetContentTypeSchemaVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentTypes provides a mock function with given fields: ctx, includeInactive
+func (_m *ContentRepository) GetContentTypes(ctx context.Context, includeInactive bool) ([]*entity.ContentType, error) {
+	ret := _m.Called(ctx, includeInactive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentTypes")
+	}
+
+	var r0 []*entity.ContentType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]*entity.ContentType, error)); ok {
+		return rf(ctx, includeInactive)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*entity.ContentType); ok {
+		r0 = rf(ctx, includeInactive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ContentType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, includeInactive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentTypes'
+type ContentRepository_GetContentTypes_Call struct {
+	*mock.Call
+}
+
+// GetContentTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeInactive bool
+func (_e *ContentRepository_Expecter) GetContentTypes(ctx interface{}, includeInactive interface{}) *ContentRepository_GetContentTypes_Call {
+	return &ContentRepository_GetContentTypes_Call{Call: _e.mock.On("GetContentTypes", ctx, includeInactive)}
+}
+
+func (_c *ContentRepository_GetContentTypes_Call) Run(run func(ctx context.Context, includeInactive bool)) *ContentRepository_GetContentTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentTypes_Call) Return(_a0 []*entity.ContentType, _a1 error) *ContentRepository_GetContentTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentTypes_Call) RunAndReturn(run func(context.Context, bool) ([]*entity.ContentType, error)) *ContentRepository_GetContentTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentVersion provides a mock function with given fields: ctx, contentID, version
+func (_m *ContentRepository) GetContentVersion(ctx context.Context, contentID uuid.UUID, version int) (*entity.ContentVersion, error) {
+	ret := _m.Called(ctx, contentID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentVersion")
+	}
+
+	var r0 *entity.ContentVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (*entity.ContentVersion, error)); ok {
+		return rf(ctx, contentID, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) *entity.ContentVersion); ok {
+		r0 = rf(ctx, contentID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ContentVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, contentID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentVersion'
+type ContentRepository_GetContentVersion_Call struct {
+	*mock.Call
+}
+
+// GetContentVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - version int
+func (_e *ContentRepository_Expecter) GetContentVersion(ctx interface{}, contentID interface{}, version interface{}) *ContentRepository_GetContentVersion_Call {
+	return &ContentRepository_GetContentVersion_Call{Call: _e.mock.On("GetContentVersion", ctx, contentID, version)}
+}
+
+func (_c *ContentRepository_GetContentVersion_Call) Run(run func(ctx context.Context, contentID uuid.UUID, version int)) *ContentRepository_GetContentVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentVersion_Call) Return(_a0 *entity.ContentVersion, _a1 error) *ContentRepository_GetContentVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (*entity.ContentVersion, error)) *ContentRepository_GetContentVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentVersions provides a mock function with given fields: ctx, contentID
+func (_m *ContentRepository) GetContentVersions(ctx context.Context, contentID uuid.UUID) ([]*entity.ContentVersion, error) {
+	ret := _m.Called(ctx, contentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentVersions")
+	}
+
+	var r0 []*entity.ContentVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.ContentVersion, error)); ok {
+		return rf(ctx, contentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.ContentVersion); ok {
+		r0 = rf(ctx, contentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ContentVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentVersions'
+type ContentRepository_GetContentVersions_Call struct {
+	*mock.Call
+}
+
+// GetContentVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+func (_e *ContentRepository_Expecter) GetContentVersions(ctx interface{}, contentID interface{}) *ContentRepository_GetContentVersions_Call {
+	return &ContentRepository_GetContentVersions_Call{Call: _e.mock.On("GetContentVersions", ctx, contentID)}
+}
+
+func (_c *ContentRepository_GetContentVersions_Call) Run(run func(ctx context.Context, contentID uuid.UUID)) *ContentRepository_GetContentVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentVersions_Call) Return(_a0 []*entity.ContentVersion, _a1 error) *ContentRepository_GetContentVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.ContentVersion, error)) *ContentRepository_GetContentVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContents provides a mock function with given fields: ctx, limit, offset, filters
+func (_m *ContentRepository) GetContents(ctx context.Context, limit int, offset int, filters repository.ContentFilters) ([]*entity.Content, int64, error) {
+	ret := _m.Called(ctx, limit, offset, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContents")
+	}
+
+	var r0 []*entity.Content
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, repository.ContentFilters) ([]*entity.Content, int64, error)); ok {
+		return rf(ctx, limit, offset, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, repository.ContentFilters) []*entity.Content); ok {
+		r0 = rf(ctx, limit, offset, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, repository.ContentFilters) int64); ok {
+		r1 = rf(ctx, limit, offset, filters)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int, repository.ContentFilters) error); ok {
+		r2 = rf(ctx, limit, offset, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ContentRepository_GetContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContents'
+type ContentRepository_GetContents_Call struct {
+	*mock.Call
+}
+
+// GetContents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+//   - filters repository.ContentFilters
+func (_e *ContentRepository_Expecter) GetContents(ctx interface{}, limit interface{}, offset interface{}, filters interface{}) *ContentRepository_GetContents_Call {
+	return &ContentRepository_GetContents_Call{Call: _e.mock.On("GetContents", ctx, limit, offset, filters)}
+}
+
+func (_c *ContentRepository_GetContents_Call) Run(run func(ctx context.Context, limit int, offset int, filters repository.ContentFilters)) *ContentRepository_GetContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(repository.ContentFilters))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContents_Call) Return(_a0 []*entity.Content, _a1 int64, _a2 error) *ContentRepository_GetContents_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ContentRepository_GetContents_Call) RunAndReturn(run func(context.Context, int, int, repository.ContentFilters) ([]*entity.Content, int64, error)) *ContentRepository_GetContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentsByContentType provides a mock function with given fields: ctx, contentTypeID
+func (_m *ContentRepository) GetContentsByContentType(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.Content, error) {
+	ret := _m.Called(ctx, contentTypeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentsByContentType")
+	}
+
+	var r0 []*entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.Content, error)); ok {
+		return rf(ctx, contentTypeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.Content); ok {
+		r0 = rf(ctx, contentTypeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentTypeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentsByContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentsByContentType'
+type ContentRepository_GetContentsByContentType_Call struct {
+	*mock.Call
+}
+
+// GetContentsByContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentTypeID uuid.UUID
+func (_e *ContentRepository_Expecter) GetContentsByContentType(ctx interface{}, contentTypeID interface{}) *ContentRepository_GetContentsByContentType_Call {
+	return &ContentRepository_GetContentsByContentType_Call{Call: _e.mock.On("GetContentsByContentType", ctx, contentTypeID)}
+}
+
+func (_c *ContentRepository_GetContentsByContentType_Call) Run(run func(ctx context.Context, contentTypeID uuid.UUID)) *ContentRepository_GetContentsByContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentsByContentType_Call) Return(_a0 []*entity.Content, _a1 error) *ContentRepository_GetContentsByContentType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentsByContentType_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.Content, error)) *ContentRepository_GetContentsByContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentsByIDs provides a mock function with given fields: ctx, ids
+func (_m *ContentRepository) GetContentsByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Content, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentsByIDs")
+	}
+
+	var r0 []*entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*entity.Content, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*entity.Content); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetContentsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentsByIDs'
+type ContentRepository_GetContentsByIDs_Call struct {
+	*mock.Call
+}
+
+// GetContentsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *ContentRepository_Expecter) GetContentsByIDs(ctx interface{}, ids interface{}) *ContentRepository_GetContentsByIDs_Call {
+	return &ContentRepository_GetContentsByIDs_Call{Call: _e.mock.On("GetContentsByIDs", ctx, ids)}
+}
+
+func (_c *ContentRepository_GetContentsByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *ContentRepository_GetContentsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentsByIDs_Call) Return(_a0 []*entity.Content, _a1 error) *ContentRepository_GetContentsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentsByIDs_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*entity.Content, error)) *ContentRepository_GetContentsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContentsByKeyset provides a mock function with given fields: ctx, limit, keyset, filters
+func (_m *ContentRepository) GetContentsByKeyset(ctx context.Context, limit int, keyset repository.Keyset, filters repository.ContentFilters) ([]*entity.Content, bool, error) {
+	ret := _m.Called(ctx, limit, keyset, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContentsByKeyset")
+	}
+
+	var r0 []*entity.Content
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, repository.Keyset, repository.ContentFilters) ([]*entity.Content, bool, error)); ok {
+		return rf(ctx, limit, keyset, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, repository.Keyset, repository.ContentFilters) []*entity.Content); ok {
+		r0 = rf(ctx, limit, keyset, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, repository.Keyset, repository.ContentFilters) bool); ok {
+		r1 = rf(ctx, limit, keyset, filters)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, repository.Keyset, repository.ContentFilters) error); ok {
+		r2 = rf(ctx, limit, keyset, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ContentRepository_GetContentsByKeyset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContentsByKeyset'
+type ContentRepository_GetContentsByKeyset_Call struct {
+	*mock.Call
+}
+
+// GetContentsByKeyset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - keyset repository.Keyset
+//   - filters repository.ContentFilters
+func (_e *ContentRepository_Expecter) GetContentsByKeyset(ctx interface{}, limit interface{}, keyset interface{}, filters interface{}) *ContentRepository_GetContentsByKeyset_Call {
+	return &ContentRepository_GetContentsByKeyset_Call{Call: _e.mock.On("GetContentsByKeyset", ctx, limit, keyset, filters)}
+}
+
+func (_c *ContentRepository_GetContentsByKeyset_Call) Run(run func(ctx context.Context, limit int, keyset repository.Keyset, filters repository.ContentFilters)) *ContentRepository_GetContentsByKeyset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(repository.Keyset), args[3].(repository.ContentFilters))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetContentsByKeyset_Call) Return(_a0 []*entity.Content, _a1 bool, _a2 error) *ContentRepository_GetContentsByKeyset_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ContentRepository_GetContentsByKeyset_Call) RunAndReturn(run func(context.Context, int, repository.Keyset, repository.ContentFilters) ([]*entity.Content, bool, error)) *ContentRepository_GetContentsByKeyset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDueContents provides a mock function with given fields: ctx, now, limit
+func (_m *ContentRepository) GetDueContents(ctx context.Context, now time.Time, limit int) ([]*entity.Content, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueContents")
+	}
+
+	var r0 []*entity.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entity.Content, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entity.Content); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetDueContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDueContents'
+type ContentRepository_GetDueContents_Call struct {
+	*mock.Call
+}
+
+// GetDueContents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *ContentRepository_Expecter) GetDueContents(ctx interface{}, now interface{}, limit interface{}) *ContentRepository_GetDueContents_Call {
+	return &ContentRepository_GetDueContents_Call{Call: _e.mock.On("GetDueContents", ctx, now, limit)}
+}
+
+func (_c *ContentRepository_GetDueContents_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *ContentRepository_GetDueContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetDueContents_Call) Return(_a0 []*entity.Content, _a1 error) *ContentRepository_GetDueContents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetDueContents_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*entity.Content, error)) *ContentRepository_GetDueContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferrers provides a mock function with given fields: ctx, contentID
+func (_m *ContentRepository) GetReferrers(ctx context.Context, contentID uuid.UUID) ([]entity.Referrer, error) {
+	ret := _m.Called(ctx, contentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferrers")
+	}
+
+	var r0 []entity.Referrer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]entity.Referrer, error)); ok {
+		return rf(ctx, contentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []entity.Referrer); ok {
+		r0 = rf(ctx, contentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Referrer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetReferrers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferrers'
+type ContentRepository_GetReferrers_Call struct {
+	*mock.Call
+}
+
+// GetReferrers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+func (_e *ContentRepository_Expecter) GetReferrers(ctx interface{}, contentID interface{}) *ContentRepository_GetReferrers_Call {
+	return &ContentRepository_GetReferrers_Call{Call: _e.mock.On("GetReferrers", ctx, contentID)}
+}
+
+func (_c *ContentRepository_GetReferrers_Call) Run(run func(ctx context.Context, contentID uuid.UUID)) *ContentRepository_GetReferrers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetReferrers_Call) Return(_a0 []entity.Referrer, _a1 error) *ContentRepository_GetReferrers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetReferrers_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]entity.Referrer, error)) *ContentRepository_GetReferrers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSlugRedirect provides a mock function with given fields: ctx, contentTypeName, slug
+func (_m *ContentRepository) GetSlugRedirect(ctx context.Context, contentTypeName string, slug string) (*entity.SlugRedirect, error) {
+	ret := _m.Called(ctx, contentTypeName, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlugRedirect")
+	}
+
+	var r0 *entity.SlugRedirect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.SlugRedirect, error)); ok {
+		return rf(ctx, contentTypeName, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entity.SlugRedirect); ok {
+		r0 = rf(ctx, contentTypeName, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.SlugRedirect)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, contentTypeName, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetSlugRedirect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSlugRedirect'
+type ContentRepository_GetSlugRedirect_Call struct {
+	*mock.Call
+}
+
+// GetSlugRedirect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentTypeName string
+//   - slug string
+func (_e *ContentRepository_Expecter) GetSlugRedirect(ctx interface{}, contentTypeName interface{}, slug interface{}) *ContentRepository_GetSlugRedirect_Call {
+	return &ContentRepository_GetSlugRedirect_Call{Call: _e.mock.On("GetSlugRedirect", ctx, contentTypeName, slug)}
+}
+
+func (_c *ContentRepository_GetSlugRedirect_Call) Run(run func(ctx context.Context, contentTypeName string, slug string)) *ContentRepository_GetSlugRedirect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetSlugRedirect_Call) Return(_a0 *entity.SlugRedirect, _a1 error) *ContentRepository_GetSlugRedirect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetSlugRedirect_Call) RunAndReturn(run func(context.Context, string, string) (*entity.SlugRedirect, error)) *ContentRepository_GetSlugRedirect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSlugRedirects provides a mock function with given fields: ctx, contentID
+func (_m *ContentRepository) GetSlugRedirects(ctx context.Context, contentID uuid.UUID) ([]*entity.SlugRedirect, error) {
+	ret := _m.Called(ctx, contentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlugRedirects")
+	}
+
+	var r0 []*entity.SlugRedirect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.SlugRedirect, error)); ok {
+		return rf(ctx, contentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.SlugRedirect); ok {
+		r0 = rf(ctx, contentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SlugRedirect)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetSlugRedirects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSlugRedirects'
+type ContentRepository_GetSlugRedirects_Call struct {
+	*mock.Call
+}
+
+// GetSlugRedirects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+func (_e *ContentRepository_Expecter) GetSlugRedirects(ctx interface{}, contentID interface{}) *ContentRepository_GetSlugRedirects_Call {
+	return &ContentRepository_GetSlugRedirects_Call{Call: _e.mock.On("GetSlugRedirects", ctx, contentID)}
+}
+
+func (_c *ContentRepository_GetSlugRedirects_Call) Run(run func(ctx context.Context, contentID uuid.UUID)) *ContentRepository_GetSlugRedirects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetSlugRedirects_Call) Return(_a0 []*entity.SlugRedirect, _a1 error) *ContentRepository_GetSlugRedirects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetSlugRedirects_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.SlugRedirect, error)) *ContentRepository_GetSlugRedirects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagByID provides a mock function with given fields: ctx, id
+func (_m *ContentRepository) GetTagByID(ctx context.Context, id uuid.UUID) (*entity.Tag, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagByID")
+	}
+
+	var r0 *entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Tag, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Tag); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetTagByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagByID'
+type ContentRepository_GetTagByID_Call struct {
+	*mock.Call
+}
+
+// GetTagByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ContentRepository_Expecter) GetTagByID(ctx interface{}, id interface{}) *ContentRepository_GetTagByID_Call {
+	return &ContentRepository_GetTagByID_Call{Call: _e.mock.On("GetTagByID", ctx, id)}
+}
+
+func (_c *ContentRepository_GetTagByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ContentRepository_GetTagByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetTagByID_Call) Return(_a0 *entity.Tag, _a1 error) *ContentRepository_GetTagByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetTagByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Tag, error)) *ContentRepository_GetTagByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagCounts provides a mock function with given fields: ctx, visibleAt
+func (_m *ContentRepository) GetTagCounts(ctx context.Context, visibleAt time.Time) ([]*entity.TagCount, error) {
+	ret := _m.Called(ctx, visibleAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagCounts")
+	}
+
+	var r0 []*entity.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*entity.TagCount, error)); ok {
+		return rf(ctx, visibleAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*entity.TagCount); ok {
+		r0 = rf(ctx, visibleAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, visibleAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetTagCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagCounts'
+type ContentRepository_GetTagCounts_Call struct {
+	*mock.Call
+}
+
+// GetTagCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - visibleAt time.Time
+func (_e *ContentRepository_Expecter) GetTagCounts(ctx interface{}, visibleAt interface{}) *ContentRepository_GetTagCounts_Call {
+	return &ContentRepository_GetTagCounts_Call{Call: _e.mock.On("GetTagCounts", ctx, visibleAt)}
+}
+
+func (_c *ContentRepository_GetTagCounts_Call) Run(run func(ctx context.Context, visibleAt time.Time)) *ContentRepository_GetTagCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetTagCounts_Call) Return(_a0 []*entity.TagCount, _a1 error) *ContentRepository_GetTagCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetTagCounts_Call) RunAndReturn(run func(context.Context, time.Time) ([]*entity.TagCount, error)) *ContentRepository_GetTagCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: ctx
+func (_m *ContentRepository) GetTags(ctx context.Context) ([]*entity.Tag, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []*entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Tag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type ContentRepository_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContentRepository_Expecter) GetTags(ctx interface{}) *ContentRepository_GetTags_Call {
+	return &ContentRepository_GetTags_Call{Call: _e.mock.On("GetTags", ctx)}
+}
+
+func (_c *ContentRepository_GetTags_Call) Run(run func(ctx context.Context)) *ContentRepository_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_GetTags_Call) Return(_a0 []*entity.Tag, _a1 error) *ContentRepository_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_GetTags_Call) RunAndReturn(run func(context.Context) ([]*entity.Tag, error)) *ContentRepository_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertBlock provides a mock function with given fields: ctx, contentID, expectedVersion, block, position
+func (_m *ContentRepository) InsertBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, block *entity.ContentBlock, position int) error {
+	ret := _m.Called(ctx, contentID, expectedVersion, block, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertBlock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, *entity.ContentBlock, int) error); ok {
+		r0 = rf(ctx, contentID, expectedVersion, block, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_InsertBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBlock'
+type ContentRepository_InsertBlock_Call struct {
+	*mock.Call
+}
+
+// InsertBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - expectedVersion int
+//   - block *entity.ContentBlock
+//   - position int
+func (_e *ContentRepository_Expecter) InsertBlock(ctx interface{}, contentID interface{}, expectedVersion interface{}, block interface{}, position interface{}) *ContentRepository_InsertBlock_Call {
+	return &ContentRepository_InsertBlock_Call{Call: _e.mock.On("InsertBlock", ctx, contentID, expectedVersion, block, position)}
+}
+
+func (_c *ContentRepository_InsertBlock_Call) Run(run func(ctx context.Context, contentID uuid.UUID, expectedVersion int, block *entity.ContentBlock, position int)) *ContentRepository_InsertBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(*entity.ContentBlock), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_InsertBlock_Call) Return(_a0 error) *ContentRepository_InsertBlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_InsertBlock_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, *entity.ContentBlock, int) error) *ContentRepository_InsertBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrateContentType provides a mock function with given fields: ctx, contentType, contents
+func (_m *ContentRepository) MigrateContentType(ctx context.Context, contentType *entity.ContentType, contents []*entity.Content) error {
+	ret := _m.Called(ctx, contentType, contents)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateContentType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentType, []*entity.Content) error); ok {
+		r0 = rf(ctx, contentType, contents)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_MigrateContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateContentType'
+type ContentRepository_MigrateContentType_Call struct {
+	*mock.Call
+}
+
+// MigrateContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentType *entity.ContentType
+//   - contents []*entity.Content
+func (_e *ContentRepository_Expecter) MigrateContentType(ctx interface{}, contentType interface{}, contents interface{}) *ContentRepository_MigrateContentType_Call {
+	return &ContentRepository_MigrateContentType_Call{Call: _e.mock.On("MigrateContentType", ctx, contentType, contents)}
+}
+
+func (_c *ContentRepository_MigrateContentType_Call) Run(run func(ctx context.Context, contentType *entity.ContentType, contents []*entity.Content)) *ContentRepository_MigrateContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ContentType), args[2].([]*entity.Content))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_MigrateContentType_Call) Return(_a0 error) *ContentRepository_MigrateContentType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_MigrateContentType_Call) RunAndReturn(run func(context.Context, *entity.ContentType, []*entity.Content) error) *ContentRepository_MigrateContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveBlock provides a mock function with given fields: ctx, contentID, expectedVersion, blockID, position
+func (_m *ContentRepository) MoveBlock(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID, position int) error {
+	ret := _m.Called(ctx, contentID, expectedVersion, blockID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveBlock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, contentID, expectedVersion, blockID, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_MoveBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveBlock'
+type ContentRepository_MoveBlock_Call struct {
+	*mock.Call
+}
+
+// MoveBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - expectedVersion int
+//   - blockID uuid.UUID
+//   - position int
+func (_e *ContentRepository_Expecter) MoveBlock(ctx interface{}, contentID interface{}, expectedVersion interface{}, blockID interface{}, position interface{}) *ContentRepository_MoveBlock_Call {
+	return &ContentRepository_MoveBlock_Call{Call: _e.mock.On("MoveBlock", ctx, contentID, expectedVersion, blockID, position)}
+}
+
+func (_c *ContentRepository_MoveBlock_Call) Run(run func(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID, position int)) *ContentRepository_MoveBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(uuid.UUID), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_MoveBlock_Call) Return(_a0 error) *ContentRepository_MoveBlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_MoveBlock_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, uuid.UUID, int) error) *ContentRepository_MoveBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeContent provides a mock function with given fields: ctx, id, policy
+func (_m *ContentRepository) PurgeContent(ctx context.Context, id uuid.UUID, policy entity.ReferrerPolicy) error {
+	ret := _m.Called(ctx, id, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.ReferrerPolicy) error); ok {
+		r0 = rf(ctx, id, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_PurgeContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeContent'
+type ContentRepository_PurgeContent_Call struct {
+	*mock.Call
+}
+
+// PurgeContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - policy entity.ReferrerPolicy
+func (_e *ContentRepository_Expecter) PurgeContent(ctx interface{}, id interface{}, policy interface{}) *ContentRepository_PurgeContent_Call {
+	return &ContentRepository_PurgeContent_Call{Call: _e.mock.On("PurgeContent", ctx, id, policy)}
+}
+
+func (_c *ContentRepository_PurgeContent_Call) Run(run func(ctx context.Context, id uuid.UUID, policy entity.ReferrerPolicy)) *ContentRepository_PurgeContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.ReferrerPolicy))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_PurgeContent_Call) Return(_a0 error) *ContentRepository_PurgeContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_PurgeContent_Call) RunAndReturn(run func(context.Context, uuid.UUID, entity.ReferrerPolicy) error) *ContentRepository_PurgeContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTrashedContents provides a mock function with given fields: ctx, before, limit
+func (_m *ContentRepository) PurgeTrashedContents(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrashedContents")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]uuid.UUID, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []uuid.UUID); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentRepository_PurgeTrashedContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrashedContents'
+type ContentRepository_PurgeTrashedContents_Call struct {
+	*mock.Call
+}
+
+// PurgeTrashedContents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *ContentRepository_Expecter) PurgeTrashedContents(ctx interface{}, before interface{}, limit interface{}) *ContentRepository_PurgeTrashedContents_Call {
+	return &ContentRepository_PurgeTrashedContents_Call{Call: _e.mock.On("PurgeTrashedContents", ctx, before, limit)}
+}
+
+func (_c *ContentRepository_PurgeTrashedContents_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *ContentRepository_PurgeTrashedContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_PurgeTrashedContents_Call) Return(_a0 []uuid.UUID, _a1 error) *ContentRepository_PurgeTrashedContents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentRepository_PurgeTrashedContents_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]uuid.UUID, error)) *ContentRepository_PurgeTrashedContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchContents provides a mock function with given fields: ctx, limit, offset, filters
+func (_m *ContentRepository) SearchContents(ctx context.Context, limit int, offset int, filters repository.ContentFilters) ([]*entity.SearchHit, int64, error) {
+	ret := _m.Called(ctx, limit, offset, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchContents")
+	}
+
+	var r0 []*entity.SearchHit
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, repository.ContentFilters) ([]*entity.SearchHit, int64, error)); ok {
+		return rf(ctx, limit, offset, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, repository.ContentFilters) []*entity.SearchHit); ok {
+		r0 = rf(ctx, limit, offset, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, repository.ContentFilters) int64); ok {
+		r1 = rf(ctx, limit, offset, filters)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int, repository.ContentFilters) error); ok {
+		r2 = rf(ctx, limit, offset, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ContentRepository_SearchContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchContents'
+type ContentRepository_SearchContents_Call struct {
+	*mock.Call
+}
+
+// SearchContents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+//   - filters repository.ContentFilters
+func (_e *ContentRepository_Expecter) SearchContents(ctx interface{}, limit interface{}, offset interface{}, filters interface{}) *ContentRepository_SearchContents_Call {
+	return &ContentRepository_SearchContents_Call{Call: _e.mock.On("SearchContents", ctx, limit, offset, filters)}
+}
+
+func (_c *ContentRepository_SearchContents_Call) Run(run func(ctx context.Context, limit int, offset int, filters repository.ContentFilters)) *ContentRepository_SearchContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(repository.ContentFilters))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_SearchContents_Call) Return(_a0 []*entity.SearchHit, _a1 int64, _a2 error) *ContentRepository_SearchContents_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ContentRepository_SearchContents_Call) RunAndReturn(run func(context.Context, int, int, repository.ContentFilters) ([]*entity.SearchHit, int64, error)) *ContentRepository_SearchContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBlockVisibility provides a mock function with given fields: ctx, contentID, expectedVersion, blockID, visible
+func (_m *ContentRepository) SetBlockVisibility(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID, visible bool) error {
+	ret := _m.Called(ctx, contentID, expectedVersion, blockID, visible)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBlockVisibility")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, contentID, expectedVersion, blockID, visible)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_SetBlockVisibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBlockVisibility'
+type ContentRepository_SetBlockVisibility_Call struct {
+	*mock.Call
+}
+
+// SetBlockVisibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentID uuid.UUID
+//   - expectedVersion int
+//   - blockID uuid.UUID
+//   - visible bool
+func (_e *ContentRepository_Expecter) SetBlockVisibility(ctx interface{}, contentID interface{}, expectedVersion interface{}, blockID interface{}, visible interface{}) *ContentRepository_SetBlockVisibility_Call {
+	return &ContentRepository_SetBlockVisibility_Call{Call: _e.mock.On("SetBlockVisibility", ctx, contentID, expectedVersion, blockID, visible)}
+}
+
+func (_c *ContentRepository_SetBlockVisibility_Call) Run(run func(ctx context.Context, contentID uuid.UUID, expectedVersion int, blockID uuid.UUID, visible bool)) *ContentRepository_SetBlockVisibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(uuid.UUID), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_SetBlockVisibility_Call) Return(_a0 error) *ContentRepository_SetBlockVisibility_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_SetBlockVisibility_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, uuid.UUID, bool) error) *ContentRepository_SetBlockVisibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetContentTypeActive provides a mock function with given fields: ctx, id, active
+func (_m *ContentRepository) SetContentTypeActive(ctx context.Context, id uuid.UUID, active bool) error {
+	ret := _m.Called(ctx, id, active)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetContentTypeActive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, id, active)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_SetContentTypeActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetContentTypeActive'
+type ContentRepository_SetContentTypeActive_Call struct {
+	*mock.Call
+}
+
+// SetContentTypeActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - active bool
+func (_e *ContentRepository_Expecter) SetContentTypeActive(ctx interface{}, id interface{}, active interface{}) *ContentRepository_SetContentTypeActive_Call {
+	return &ContentRepository_SetContentTypeActive_Call{Call: _e.mock.On("SetContentTypeActive", ctx, id, active)}
+}
+
+func (_c *ContentRepository_SetContentTypeActive_Call) Run(run func(ctx context.Context, id uuid.UUID, active bool)) *ContentRepository_SetContentTypeActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_SetContentTypeActive_Call) Return(_a0 error) *ContentRepository_SetContentTypeActive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_SetContentTypeActive_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool) error) *ContentRepository_SetContentTypeActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, category
+func (_m *ContentRepository) UpdateCategory(ctx context.Context, category *entity.Category) error {
+	ret := _m.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Category) error); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type ContentRepository_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *entity.Category
+func (_e *ContentRepository_Expecter) UpdateCategory(ctx interface{}, category interface{}) *ContentRepository_UpdateCategory_Call {
+	return &ContentRepository_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, category)}
+}
+
+func (_c *ContentRepository_UpdateCategory_Call) Run(run func(ctx context.Context, category *entity.Category)) *ContentRepository_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Category))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_UpdateCategory_Call) Return(_a0 error) *ContentRepository_UpdateCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_UpdateCategory_Call) RunAndReturn(run func(context.Context, *entity.Category) error) *ContentRepository_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateContent provides a mock function with given fields: ctx, content
+func (_m *ContentRepository) UpdateContent(ctx context.Context, content *entity.Content) error {
+	ret := _m.Called(ctx, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Content) error); ok {
+		r0 = rf(ctx, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_UpdateContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateContent'
+type ContentRepository_UpdateContent_Call struct {
+	*mock.Call
+}
+
+// UpdateContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content *entity.Content
+func (_e *ContentRepository_Expecter) UpdateContent(ctx interface{}, content interface{}) *ContentRepository_UpdateContent_Call {
+	return &ContentRepository_UpdateContent_Call{Call: _e.mock.On("UpdateContent", ctx, content)}
+}
+
+func (_c *ContentRepository_UpdateContent_Call) Run(run func(ctx context.Context, content *entity.Content)) *ContentRepository_UpdateContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Content))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_UpdateContent_Call) Return(_a0 error) *ContentRepository_UpdateContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_UpdateContent_Call) RunAndReturn(run func(context.Context, *entity.Content) error) *ContentRepository_UpdateContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateContentStatus provides a mock function with given fields: ctx, content
+func (_m *ContentRepository) UpdateContentStatus(ctx context.Context, content *entity.Content) error {
+	ret := _m.Called(ctx, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContentStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Content) error); ok {
+		r0 = rf(ctx, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_UpdateContentStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateContentStatus'
+type ContentRepository_UpdateContentStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateContentStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content *entity.Content
+func (_e *ContentRepository_Expecter) UpdateContentStatus(ctx interface{}, content interface{}) *ContentRepository_UpdateContentStatus_Call {
+	return &ContentRepository_UpdateContentStatus_Call{Call: _e.mock.On("UpdateContentStatus", ctx, content)}
+}
+
+func (_c *ContentRepository_UpdateContentStatus_Call) Run(run func(ctx context.Context, content *entity.Content)) *ContentRepository_UpdateContentStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Content))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_UpdateContentStatus_Call) Return(_a0 error) *ContentRepository_UpdateContentStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_UpdateContentStatus_Call) RunAndReturn(run func(context.Context, *entity.Content) error) *ContentRepository_UpdateContentStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateContentType provides a mock function with given fields: ctx, contentType
+func (_m *ContentRepository) UpdateContentType(ctx context.Context, contentType *entity.ContentType) error {
+	ret := _m.Called(ctx, contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContentType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ContentType) error); ok {
+		r0 = rf(ctx, contentType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_UpdateContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateContentType'
+type ContentRepository_UpdateContentType_Call struct {
+	*mock.Call
+}
+
+// UpdateContentType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentType *entity.ContentType
+func (_e *ContentRepository_Expecter) UpdateContentType(ctx interface{}, contentType interface{}) *ContentRepository_UpdateContentType_Call {
+	return &ContentRepository_UpdateContentType_Call{Call: _e.mock.On("UpdateContentType", ctx, contentType)}
+}
+
+func (_c *ContentRepository_UpdateContentType_Call) Run(run func(ctx context.Context, contentType *entity.ContentType)) *ContentRepository_UpdateContentType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ContentType))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_UpdateContentType_Call) Return(_a0 error) *ContentRepository_UpdateContentType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_UpdateContentType_Call) RunAndReturn(run func(context.Context, *entity.ContentType) error) *ContentRepository_UpdateContentType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReferencedContentStatus provides a mock function with given fields: ctx, content, policy
+func (_m *ContentRepository) UpdateReferencedContentStatus(ctx context.Context, content *entity.Content, policy entity.ReferrerPolicy) error {
+	ret := _m.Called(ctx, content, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReferencedContentStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Content, entity.ReferrerPolicy) error); ok {
+		r0 = rf(ctx, content, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_UpdateReferencedContentStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReferencedContentStatus'
+type ContentRepository_UpdateReferencedContentStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateReferencedContentStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content *entity.Content
+//   - policy entity.ReferrerPolicy
+func (_e *ContentRepository_Expecter) UpdateReferencedContentStatus(ctx interface{}, content interface{}, policy interface{}) *ContentRepository_UpdateReferencedContentStatus_Call {
+	return &ContentRepository_UpdateReferencedContentStatus_Call{Call: _e.mock.On("UpdateReferencedContentStatus", ctx, content, policy)}
+}
+
+func (_c *ContentRepository_UpdateReferencedContentStatus_Call) Run(run func(ctx context.Context, content *entity.Content, policy entity.ReferrerPolicy)) *ContentRepository_UpdateReferencedContentStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Content), args[2].(entity.ReferrerPolicy))
+	})
+	return _c
+}
+
+func (_c *ContentRepository_UpdateReferencedContentStatus_Call) Return(_a0 error) *ContentRepository_UpdateReferencedContentStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContentRepository_UpdateReferencedContentStatus_Call) RunAndReturn(run func(context.Context, *entity.Content, entity.ReferrerPolicy) error) *ContentRepository_UpdateReferencedContentStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, tag
+func (_m *ContentRepository) UpdateTag(ctx context.Context, tag *entity.Tag) error {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Tag) error); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContentRepository_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type ContentRepository_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag *entity.Tag
+func (_e *ContentRepository_Expecter) UpdateTag(ctx interface{}, tag interface{}) *ContentRepository_UpdateTag_Call {
+	return &ContentRepository_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, tag)}
 }
 
-func (_c *ContentRepository_UpdateArticle_Call) Run(run func(content *model.Article)) *ContentRepository_UpdateArticle_Call {
+func (_c *ContentRepository_UpdateTag_Call) Run(run func(ctx context.Context, tag *entity.Tag)) *ContentRepository_UpdateTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*model.Article))
+		run(args[0].(context.Context), args[1].(*entity.Tag))
 	})
 	return _c
 }
 
-func (_c *ContentRepository_UpdateArticle_Call) Return(_a0 error) *ContentRepository_UpdateArticle_Call {
+func (_c *ContentRepository_UpdateTag_Call) Return(_a0 error) *ContentRepository_UpdateTag_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ContentRepository_UpdateArticle_Call) RunAndReturn(run func(*model.Article) error) *ContentRepository_UpdateArticle_Call {
+func (_c *ContentRepository_UpdateTag_Call) RunAndReturn(run func(context.Context, *entity.Tag) error) *ContentRepository_UpdateTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
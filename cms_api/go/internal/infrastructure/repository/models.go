@@ -12,18 +12,26 @@ import (
 // ContentModel はGorm用のコンテンツモデル
 type ContentModel struct {
 	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	ContentTypeID uuid.UUID `gorm:"type:uuid;not null"`
-	Title         string    `gorm:"size:255;not null"`
-	Slug          string    `gorm:"size:255;not null;unique"`
+	ContentTypeID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:uq_contents_content_type_slug"`
+	Title         string    `gorm:"size:500;not null"`
+	Slug          string    `gorm:"size:200;not null;uniqueIndex:uq_contents_content_type_slug"`
 	Status        string    `gorm:"type:varchar(20);not null;default:'draft'"`
 	CreatedAt     time.Time `gorm:"autoCreateTime"`
 	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
 	PublishedAt   *time.Time
 	AuthorID      string `gorm:"size:255;not null"`
 	Version       int    `gorm:"default:1"`
+	ScheduledAt   *time.Time
+	ExpiresAt     *time.Time
+	DeletedAt     *time.Time
+	CategoryID    *uuid.UUID `gorm:"type:uuid"`
+	Excerpt       string     `gorm:"type:text"`
+	CoverImageURL string     `gorm:"size:1000"`
 	
 	// リレーション
 	ContentType *ContentTypeModel   `gorm:"foreignKey:ContentTypeID"`
+	Category    *CategoryModel      `gorm:"foreignKey:CategoryID"`
+	Tags        []TagModel          `gorm:"many2many:content_tags;joinForeignKey:ContentID;joinReferences:TagID"`
 	Blocks      []ContentBlockModel `gorm:"foreignKey:ContentID"`
 }
 
@@ -51,6 +59,9 @@ type ContentTypeModel struct {
 	CreatedAt   time.Time `gorm:"autoCreateTime"`
 	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
 	CreatedBy   string    `gorm:"size:255;not null"`
+	// FieldSchemaはentity.FieldSchemaのJSON、SchemaVersionはその版
+	FieldSchema   json.RawMessage `gorm:"type:jsonb"`
+	SchemaVersion int             `gorm:"not null;default:1"`
 }
 
 // TableName はテーブル名を指定
@@ -106,8 +117,10 @@ type ContentBlockDataModel struct {
 	ContentJSON         json.RawMessage  `gorm:"type:jsonb"`
 	ReferencedContentID *uuid.UUID       `gorm:"type:uuid"`
 	Settings            json.RawMessage  `gorm:"type:jsonb"`
-	CreatedAt           time.Time        `gorm:"autoCreateTime"`
-	UpdatedAt           time.Time        `gorm:"autoUpdateTime"`
+	// SearchTextは全文検索用に正規化した本文（リッチテキストから抽出したテキストを含む）
+	SearchText string    `gorm:"type:text"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
 	
 	// リレーション
 	Block             *ContentBlockModel `gorm:"foreignKey:BlockID"`
@@ -125,4 +138,130 @@ func (cbd *ContentBlockDataModel) BeforeCreate(tx *gorm.DB) error {
 		cbd.ID = uuid.New()
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// ContentVersionModel はGorm用のコンテンツ版モデル
+type ContentVersionModel struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ContentID uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:uq_content_versions_content_version"`
+	Version   int             `gorm:"not null;uniqueIndex:uq_content_versions_content_version"`
+	Title     string          `gorm:"size:500;not null"`
+	Status    string          `gorm:"type:varchar(20);not null"`
+	AuthorID  string          `gorm:"size:255;not null"`
+	Snapshot  json.RawMessage `gorm:"type:jsonb;not null"`
+	CreatedAt time.Time       `gorm:"autoCreateTime"`
+}
+
+// TableName はテーブル名を指定
+func (ContentVersionModel) TableName() string {
+	return "content_versions"
+}
+
+// BeforeCreate はレコード作成前のフック
+func (cv *ContentVersionModel) BeforeCreate(tx *gorm.DB) error {
+	if cv.ID == uuid.Nil {
+		cv.ID = uuid.New()
+	}
+	return nil
+}
+
+// ContentTypeSchemaVersionModel はGorm用のコンテンツタイプのフィールドの定義の版モデル
+type ContentTypeSchemaVersionModel struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ContentTypeID uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:uq_content_type_schema_versions_type_version"`
+	Version       int             `gorm:"not null;uniqueIndex:uq_content_type_schema_versions_type_version"`
+	FieldSchema   json.RawMessage `gorm:"type:jsonb;not null"`
+	CreatedAt     time.Time       `gorm:"autoCreateTime"`
+}
+
+// TableName はテーブル名を指定
+func (ContentTypeSchemaVersionModel) TableName() string {
+	return "content_type_schema_versions"
+}
+
+// BeforeCreate はレコード作成前のフック
+func (v *ContentTypeSchemaVersionModel) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
+
+// ContentSlugRedirectModel はGorm用のスラッグのリダイレクトモデル（公開中に変更される前のスラッグ）
+type ContentSlugRedirectModel struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ContentTypeID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:uq_content_slug_redirects_type_slug"`
+	Slug          string    `gorm:"size:200;not null;uniqueIndex:uq_content_slug_redirects_type_slug"`
+	ContentID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName はテーブル名を指定
+func (ContentSlugRedirectModel) TableName() string {
+	return "content_slug_redirects"
+}
+
+// BeforeCreate はレコード作成前のフック
+func (r *ContentSlugRedirectModel) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// CategoryModel はGorm用のカテゴリモデル
+type CategoryModel struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name        string    `gorm:"size:100;not null"`
+	Slug        string    `gorm:"size:200;not null;unique"`
+	Description string    `gorm:"type:text"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName はテーブル名を指定
+func (CategoryModel) TableName() string {
+	return "categories"
+}
+
+// BeforeCreate はレコード作成前のフック
+func (c *CategoryModel) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// TagModel はGorm用のタグモデル
+type TagModel struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name      string    `gorm:"size:100;not null"`
+	Slug      string    `gorm:"size:200;not null;unique"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName はテーブル名を指定
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// BeforeCreate はレコード作成前のフック
+func (t *TagModel) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// ContentTagModel はGorm用のコンテンツとタグの関連モデル
+type ContentTagModel struct {
+	ContentID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TagID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName はテーブル名を指定
+func (ContentTagModel) TableName() string {
+	return "content_tags"
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetCategories はカテゴリ一覧を名前順に取得します
+func (r *contentRepository) GetCategories(ctx context.Context) ([]*entity.Category, error) {
+	var categoryModels []CategoryModel
+	if err := r.db.WithContext(ctx).Order("name ASC, id ASC").Find(&categoryModels).Error; err != nil {
+		return nil, fmt.Errorf("カテゴリ一覧の取得に失敗しました: %w", err)
+	}
+
+	categories := make([]*entity.Category, len(categoryModels))
+	for i, model := range categoryModels {
+		categories[i] = model.ToCategoryEntity()
+	}
+	return categories, nil
+}
+
+// GetCategoryByID はIDでカテゴリを取得します
+func (r *contentRepository) GetCategoryByID(ctx context.Context, id uuid.UUID) (*entity.Category, error) {
+	var categoryModel CategoryModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&categoryModel).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("%w: %s", entity.ErrCategoryNotFound, id.String())
+		}
+		return nil, fmt.Errorf("カテゴリの取得に失敗しました: %w", err)
+	}
+	return categoryModel.ToCategoryEntity(), nil
+}
+
+// CreateCategory は新しいカテゴリを作成します
+func (r *contentRepository) CreateCategory(ctx context.Context, category *entity.Category) error {
+	if err := category.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+	if err := checkSlugAvailable(r.db.WithContext(ctx), &CategoryModel{}, category.Slug, uuid.Nil); err != nil {
+		return err
+	}
+
+	var categoryModel CategoryModel
+	categoryModel.FromCategoryEntity(category)
+	if err := r.db.WithContext(ctx).Create(&categoryModel).Error; err != nil {
+		return fmt.Errorf("カテゴリの作成に失敗しました: %w", err)
+	}
+
+	*category = *categoryModel.ToCategoryEntity()
+	return nil
+}
+
+// UpdateCategory はカテゴリの名前・スラッグ・説明を更新します
+func (r *contentRepository) UpdateCategory(ctx context.Context, category *entity.Category) error {
+	if err := category.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+	if err := checkSlugAvailable(r.db.WithContext(ctx), &CategoryModel{}, category.Slug, category.ID); err != nil {
+		return err
+	}
+
+	result := r.db.WithContext(ctx).Model(&CategoryModel{}).
+		Where("id = ?", category.ID).
+		Updates(map[string]interface{}{
+			"name":        category.Name,
+			"slug":        category.Slug,
+			"description": category.Description,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("カテゴリの更新に失敗しました: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", entity.ErrCategoryNotFound, category.ID.String())
+	}
+	return nil
+}
+
+// DeleteCategory はカテゴリを削除し、そのカテゴリに属していたコンテンツをカテゴリなしにします
+func (r *contentRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&ContentModel{}).Where("category_id = ?", id).Update("category_id", nil).Error; err != nil {
+			return fmt.Errorf("コンテンツのカテゴリの解除に失敗しました: %w", err)
+		}
+
+		result := tx.Where("id = ?", id).Delete(&CategoryModel{})
+		if result.Error != nil {
+			return fmt.Errorf("カテゴリの削除に失敗しました: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("削除対象の%w: %s", entity.ErrCategoryNotFound, id.String())
+		}
+		return nil
+	})
+}
+
+// GetTags はタグ一覧を名前順に取得します
+func (r *contentRepository) GetTags(ctx context.Context) ([]*entity.Tag, error) {
+	var tagModels []TagModel
+	if err := r.db.WithContext(ctx).Scopes(orderTags).Find(&tagModels).Error; err != nil {
+		return nil, fmt.Errorf("タグ一覧の取得に失敗しました: %w", err)
+	}
+
+	tags := make([]*entity.Tag, len(tagModels))
+	for i, model := range tagModels {
+		tags[i] = model.ToTagEntity()
+	}
+	return tags, nil
+}
+
+// GetTagByID はIDでタグを取得します
+func (r *contentRepository) GetTagByID(ctx context.Context, id uuid.UUID) (*entity.Tag, error) {
+	var tagModel TagModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&tagModel).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("%w: %s", entity.ErrTagNotFound, id.String())
+		}
+		return nil, fmt.Errorf("タグの取得に失敗しました: %w", err)
+	}
+	return tagModel.ToTagEntity(), nil
+}
+
+// CreateTag は新しいタグを作成します
+func (r *contentRepository) CreateTag(ctx context.Context, tag *entity.Tag) error {
+	if err := tag.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+	if err := checkSlugAvailable(r.db.WithContext(ctx), &TagModel{}, tag.Slug, uuid.Nil); err != nil {
+		return err
+	}
+
+	var tagModel TagModel
+	tagModel.FromTagEntity(tag)
+	if err := r.db.WithContext(ctx).Create(&tagModel).Error; err != nil {
+		return fmt.Errorf("タグの作成に失敗しました: %w", err)
+	}
+
+	*tag = *tagModel.ToTagEntity()
+	return nil
+}
+
+// UpdateTag はタグの名前・スラッグを更新します
+func (r *contentRepository) UpdateTag(ctx context.Context, tag *entity.Tag) error {
+	if err := tag.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+	if err := checkSlugAvailable(r.db.WithContext(ctx), &TagModel{}, tag.Slug, tag.ID); err != nil {
+		return err
+	}
+
+	result := r.db.WithContext(ctx).Model(&TagModel{}).
+		Where("id = ?", tag.ID).
+		Updates(map[string]interface{}{
+			"name": tag.Name,
+			"slug": tag.Slug,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("タグの更新に失敗しました: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", entity.ErrTagNotFound, tag.ID.String())
+	}
+	return nil
+}
+
+// DeleteTag はタグを削除し、コンテンツとの関連付けを解除します
+func (r *contentRepository) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&ContentTagModel{}).Error; err != nil {
+			return fmt.Errorf("コンテンツのタグの解除に失敗しました: %w", err)
+		}
+
+		result := tx.Where("id = ?", id).Delete(&TagModel{})
+		if result.Error != nil {
+			return fmt.Errorf("タグの削除に失敗しました: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("削除対象の%w: %s", entity.ErrTagNotFound, id.String())
+		}
+		return nil
+	})
+}
+
+// checkSlugAvailable はスラッグがexceptID以外のレコードで使われていないかを確認します
+func checkSlugAvailable(db *gorm.DB, model interface{}, slug string, exceptID uuid.UUID) error {
+	var count int64
+	if err := db.Model(model).Where("slug = ? AND id <> ?", slug, exceptID).Count(&count).Error; err != nil {
+		return fmt.Errorf("スラッグの重複チェックに失敗しました: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("スラッグが%w: %s", entity.ErrAlreadyExists, slug)
+	}
+	return nil
+}
+
+// checkCategory はコンテンツに関連付けるカテゴリが存在するかを確認します
+func checkCategory(tx *gorm.DB, categoryID *uuid.UUID) error {
+	if categoryID == nil {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&CategoryModel{}).Where("id = ?", *categoryID).Count(&count).Error; err != nil {
+		return fmt.Errorf("カテゴリの存在確認に失敗しました: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: %v: %s", entity.ErrInvalidParameter, entity.ErrCategoryNotFound, categoryID.String())
+	}
+	return nil
+}
+
+// syncTags はコンテンツのタグをtagIDsの内容に置き換えます（重複したIDは1つにまとめます）
+func syncTags(tx *gorm.DB, contentID uuid.UUID, tagIDs []uuid.UUID) error {
+	unique := make([]uuid.UUID, 0, len(tagIDs))
+	seen := make(map[uuid.UUID]bool, len(tagIDs))
+	for _, id := range tagIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	if len(unique) > 0 {
+		var found []uuid.UUID
+		if err := tx.Model(&TagModel{}).Where("id IN ?", unique).Pluck("id", &found).Error; err != nil {
+			return fmt.Errorf("タグの存在確認に失敗しました: %w", err)
+		}
+		if len(found) != len(unique) {
+			exists := make(map[uuid.UUID]bool, len(found))
+			for _, id := range found {
+				exists[id] = true
+			}
+			for _, id := range unique {
+				if !exists[id] {
+					return fmt.Errorf("%w: %v: %s", entity.ErrInvalidParameter, entity.ErrTagNotFound, id.String())
+				}
+			}
+		}
+	}
+
+	if err := tx.Where("content_id = ?", contentID).Delete(&ContentTagModel{}).Error; err != nil {
+		return fmt.Errorf("コンテンツのタグの削除に失敗しました: %w", err)
+	}
+	if len(unique) == 0 {
+		return nil
+	}
+
+	rows := make([]ContentTagModel, len(unique))
+	for i, id := range unique {
+		rows[i] = ContentTagModel{ContentID: contentID, TagID: id}
+	}
+	if err := tx.Create(&rows).Error; err != nil {
+		return fmt.Errorf("コンテンツのタグの保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// orderTags はタグを名前順でソートします
+func orderTags(db *gorm.DB) *gorm.DB {
+	return db.Order("tags.name ASC, tags.id ASC")
+}
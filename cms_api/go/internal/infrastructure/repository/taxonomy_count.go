@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"time"
+)
+
+// visibleContentsJoin は公開中のコンテンツのみを結合する条件（プレースホルダーには公開判定の日時を2回渡す）
+const visibleContentsJoin = "c.status = 'published' AND c.published_at <= ? AND (c.expires_at IS NULL OR c.expires_at > ?)"
+
+// categoryCountRow はカテゴリ別件数の集計結果
+type categoryCountRow struct {
+	CategoryModel `gorm:"embedded"`
+	Count         int64
+}
+
+// tagCountRow はタグ別件数の集計結果
+type tagCountRow struct {
+	TagModel `gorm:"embedded"`
+	Count    int64
+}
+
+// GetCategoryCounts はすべてのカテゴリと、visibleAtの時点で公開中のコンテンツ数を名前順に取得します
+func (r *contentRepository) GetCategoryCounts(ctx context.Context, visibleAt time.Time) ([]*entity.CategoryCount, error) {
+	var rows []categoryCountRow
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT cat.*, COUNT(c.id) AS count
+		FROM categories cat
+		LEFT JOIN contents c ON c.category_id = cat.id AND `+visibleContentsJoin+`
+		GROUP BY cat.id
+		ORDER BY cat.name ASC, cat.id ASC`, visibleAt, visibleAt).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("カテゴリ別のコンテンツ数の取得に失敗しました: %w", err)
+	}
+
+	counts := make([]*entity.CategoryCount, len(rows))
+	for i, row := range rows {
+		counts[i] = &entity.CategoryCount{Category: *row.ToCategoryEntity(), Count: row.Count}
+	}
+	return counts, nil
+}
+
+// GetTagCounts はすべてのタグと、visibleAtの時点で公開中のコンテンツ数を名前順に取得します
+func (r *contentRepository) GetTagCounts(ctx context.Context, visibleAt time.Time) ([]*entity.TagCount, error) {
+	var rows []tagCountRow
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT t.*, COUNT(c.id) AS count
+		FROM tags t
+		LEFT JOIN content_tags ct ON ct.tag_id = t.id
+		LEFT JOIN contents c ON c.id = ct.content_id AND `+visibleContentsJoin+`
+		GROUP BY t.id
+		ORDER BY t.name ASC, t.id ASC`, visibleAt, visibleAt).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("タグ別のコンテンツ数の取得に失敗しました: %w", err)
+	}
+
+	counts := make([]*entity.TagCount, len(rows))
+	for i, row := range rows {
+		counts[i] = &entity.TagCount{Tag: *row.ToTagEntity(), Count: row.Count}
+	}
+	return counts, nil
+}
+
+// GetArchiveCounts はvisibleAtの時点で公開中のコンテンツを、timeZoneでの公開年月ごとに新しい順で集計します
+func (r *contentRepository) GetArchiveCounts(ctx context.Context, visibleAt time.Time, timeZone string) ([]*entity.ArchiveCount, error) {
+	var counts []*entity.ArchiveCount
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			EXTRACT(YEAR FROM c.published_at AT TIME ZONE ?)::int AS year,
+			EXTRACT(MONTH FROM c.published_at AT TIME ZONE ?)::int AS month,
+			COUNT(*) AS count
+		FROM contents c
+		WHERE `+visibleContentsJoin+`
+		GROUP BY year, month
+		ORDER BY year DESC, month DESC`, timeZone, timeZone, visibleAt, visibleAt).
+		Scan(&counts).Error
+	if err != nil {
+		return nil, fmt.Errorf("年月別のコンテンツ数の取得に失敗しました: %w", err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	usecase "cms_api/internal/usecase/content"
+)
+
+// ContentScheduler is an autogenerated mock type for the contentScheduler type
+type ContentScheduler struct {
+	mock.Mock
+}
+
+type ContentScheduler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ContentScheduler) EXPECT() *ContentScheduler_Expecter {
+	return &ContentScheduler_Expecter{mock: &_m.Mock}
+}
+
+// PurgeTrash provides a mock function with given fields: ctx, olderThan
+func (_m *ContentScheduler) PurgeTrash(ctx context.Context, olderThan time.Duration) (*usecase.PurgeResult, error) {
+	ret := _m.Called(ctx, olderThan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 *usecase.PurgeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (*usecase.PurgeResult, error)); ok {
+		return rf(ctx, olderThan)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) *usecase.PurgeResult); ok {
+		r0 = rf(ctx, olderThan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.PurgeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, olderThan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentScheduler_PurgeTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrash'
+type ContentScheduler_PurgeTrash_Call struct {
+	*mock.Call
+}
+
+// PurgeTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - olderThan time.Duration
+func (_e *ContentScheduler_Expecter) PurgeTrash(ctx interface{}, olderThan interface{}) *ContentScheduler_PurgeTrash_Call {
+	return &ContentScheduler_PurgeTrash_Call{Call: _e.mock.On("PurgeTrash", ctx, olderThan)}
+}
+
+func (_c *ContentScheduler_PurgeTrash_Call) Run(run func(ctx context.Context, olderThan time.Duration)) *ContentScheduler_PurgeTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ContentScheduler_PurgeTrash_Call) Return(_a0 *usecase.PurgeResult, _a1 error) *ContentScheduler_PurgeTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentScheduler_PurgeTrash_Call) RunAndReturn(run func(context.Context, time.Duration) (*usecase.PurgeResult, error)) *ContentScheduler_PurgeTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunSchedule provides a mock function with given fields: ctx, now
+func (_m *ContentScheduler) RunSchedule(ctx context.Context, now time.Time) (*usecase.ScheduleResult, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunSchedule")
+	}
+
+	var r0 *usecase.ScheduleResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*usecase.ScheduleResult, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *usecase.ScheduleResult); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ScheduleResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContentScheduler_RunSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunSchedule'
+type ContentScheduler_RunSchedule_Call struct {
+	*mock.Call
+}
+
+// RunSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *ContentScheduler_Expecter) RunSchedule(ctx interface{}, now interface{}) *ContentScheduler_RunSchedule_Call {
+	return &ContentScheduler_RunSchedule_Call{Call: _e.mock.On("RunSchedule", ctx, now)}
+}
+
+func (_c *ContentScheduler_RunSchedule_Call) Run(run func(ctx context.Context, now time.Time)) *ContentScheduler_RunSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ContentScheduler_RunSchedule_Call) Return(_a0 *usecase.ScheduleResult, _a1 error) *ContentScheduler_RunSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContentScheduler_RunSchedule_Call) RunAndReturn(run func(context.Context, time.Time) (*usecase.ScheduleResult, error)) *ContentScheduler_RunSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewContentScheduler creates a new instance of ContentScheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewContentScheduler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ContentScheduler {
+	mock := &ContentScheduler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	usecase "cms_api/internal/usecase/content"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+type contentScheduler interface {
+	RunSchedule(ctx context.Context, now time.Time) (*usecase.ScheduleResult, error)
+	PurgeTrash(ctx context.Context, olderThan time.Duration) (*usecase.PurgeResult, error)
+}
+
+// Result は1回の実行結果
+type Result struct {
+	Schedule *usecase.ScheduleResult `json:"schedule"`
+	// Purgeはゴミ箱の自動削除の結果（自動削除が無効の場合はnil）
+	Purge *usecase.PurgeResult `json:"purge"`
+}
+
+// Scheduler は予約済みの公開・公開終了と、保持期間を過ぎたゴミ箱の完全削除を定期的に実行します
+type Scheduler struct {
+	contentScheduler contentScheduler
+	interval         time.Duration
+	// trashRetentionを過ぎたゴミ箱のコンテンツを完全に削除する（0の場合は削除しない）
+	trashRetention time.Duration
+	now            func() time.Time
+}
+
+// NewScheduler は新しいSchedulerインスタンスを作成します
+func NewScheduler(cs contentScheduler, interval, trashRetention time.Duration) *Scheduler {
+	return &Scheduler{
+		contentScheduler: cs,
+		interval:         interval,
+		trashRetention:   trashRetention,
+		now:              time.Now,
+	}
+}
+
+// Start はctxがキャンセルされるまで一定間隔で予約を実行します（ブロッキング）
+func (s *Scheduler) Start(ctx context.Context) {
+	log.Printf("公開予約スケジューラーを開始します: interval=%s, trashRetention=%s", s.interval, s.trashRetention)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		// 実行時のエラーはログに出力し、次回の実行で再試行する
+		_, _ = s.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Printf("公開予約スケジューラーを停止します")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce は現在時刻の時点で予約日時を過ぎたコンテンツと、保持期間を過ぎたゴミ箱のコンテンツを1回だけ処理します
+// 一方が失敗してももう一方は実行し、エラーはまとめて返します
+func (s *Scheduler) RunOnce(ctx context.Context) (*Result, error) {
+	result := &Result{}
+	var errs []error
+
+	schedule, err := s.contentScheduler.RunSchedule(ctx, s.now())
+	if err != nil {
+		log.Printf("公開予約の実行中にエラーが発生しました: %v", err)
+		errs = append(errs, err)
+	}
+	if schedule != nil && len(schedule.Published)+len(schedule.Archived)+len(schedule.Skipped)+len(schedule.Blocked) > 0 {
+		log.Printf("公開予約を実行しました: published=%d, archived=%d, skipped=%d, blocked=%d",
+			len(schedule.Published), len(schedule.Archived), len(schedule.Skipped), len(schedule.Blocked))
+	}
+	result.Schedule = schedule
+
+	if s.trashRetention > 0 {
+		purge, err := s.contentScheduler.PurgeTrash(ctx, s.trashRetention)
+		if err != nil {
+			log.Printf("ゴミ箱の自動削除中にエラーが発生しました: %v", err)
+			errs = append(errs, err)
+		}
+		if purge != nil && len(purge.Purged) > 0 {
+			log.Printf("ゴミ箱のコンテンツを完全に削除しました: purged=%d", len(purge.Purged))
+		}
+		result.Purge = purge
+	}
+
+	return result, errors.Join(errs...)
+}
@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cms_api/internal/infrastructure/scheduler/mocks"
+	usecase "cms_api/internal/usecase/content"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedulerRunOnce(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	retention := 30 * 24 * time.Hour
+
+	t.Run("正常系：現在時刻で予約を実行し、保持期間を過ぎたゴミ箱を削除する場合", func(t *testing.T) {
+		cs := mocks.NewContentScheduler(t)
+		schedule := &usecase.ScheduleResult{Published: []uuid.UUID{uuid.New()}}
+		purge := &usecase.PurgeResult{Purged: []uuid.UUID{uuid.New()}}
+		cs.EXPECT().RunSchedule(context.Background(), now).Return(schedule, nil)
+		cs.EXPECT().PurgeTrash(context.Background(), retention).Return(purge, nil)
+
+		s := NewScheduler(cs, time.Minute, retention)
+		s.now = func() time.Time { return now }
+
+		result, err := s.RunOnce(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, &Result{Schedule: schedule, Purge: purge}, result)
+	})
+
+	t.Run("正常系：保持期間が0の場合はゴミ箱を削除しない場合", func(t *testing.T) {
+		cs := mocks.NewContentScheduler(t)
+		cs.EXPECT().RunSchedule(context.Background(), now).Return(&usecase.ScheduleResult{}, nil)
+
+		s := NewScheduler(cs, time.Minute, 0)
+		s.now = func() time.Time { return now }
+
+		result, err := s.RunOnce(context.Background())
+		assert.NoError(t, err)
+		assert.Nil(t, result.Purge)
+	})
+
+	t.Run("異常系：予約の実行に失敗してもゴミ箱の削除は実行する場合", func(t *testing.T) {
+		cs := mocks.NewContentScheduler(t)
+		runErr := errors.New("db error")
+		purge := &usecase.PurgeResult{Purged: []uuid.UUID{}}
+		cs.EXPECT().RunSchedule(context.Background(), now).Return(nil, runErr)
+		cs.EXPECT().PurgeTrash(context.Background(), retention).Return(purge, nil)
+
+		s := NewScheduler(cs, time.Minute, retention)
+		s.now = func() time.Time { return now }
+
+		result, err := s.RunOnce(context.Background())
+		assert.ErrorIs(t, err, runErr)
+		assert.Equal(t, purge, result.Purge)
+	})
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// InsertBlockInput はブロック挿入の入力パラメータ
+type InsertBlockInput struct {
+	Version int `json:"version"`
+	// Positionは挿入位置（1始まり）。0の場合は末尾に追加する
+	Position int                 `json:"position"`
+	Block    entity.ContentBlock `json:"block"`
+}
+
+// MoveBlockInput はブロック移動の入力パラメータ
+type MoveBlockInput struct {
+	Version  int `json:"version"`
+	Position int `json:"position"`
+}
+
+// BlockVisibilityInput はブロック表示切り替えの入力パラメータ
+type BlockVisibilityInput struct {
+	Version   int  `json:"version"`
+	IsVisible bool `json:"is_visible"`
+}
+
+// InsertBlock はブロックを指定位置に挿入し、保存後のコンテンツを返します
+func (u *contentUsecase) InsertBlock(ctx context.Context, contentID uuid.UUID, input InsertBlockInput) (*entity.Content, error) {
+	if err := requireVersion(input.Version); err != nil {
+		return nil, err
+	}
+	if !input.Block.BlockType.IsValid() {
+		return nil, fmt.Errorf("%w: block_type=%s", entity.ErrInvalidParameter, input.Block.BlockType)
+	}
+	if input.Position < 0 {
+		return nil, fmt.Errorf("%w: position=%d", entity.ErrInvalidParameter, input.Position)
+	}
+
+	block := input.Block
+	err := u.validateBlockChange(ctx, contentID, func(blocks []entity.ContentBlock) ([]entity.ContentBlock, bool) {
+		position := input.Position
+		if position == 0 {
+			position = len(blocks) + 1
+		}
+		if position > len(blocks)+1 {
+			return nil, false
+		}
+		return slices.Insert(blocks, position-1, block), true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.contentRepository.InsertBlock(ctx, contentID, input.Version, &block, input.Position); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, contentID)
+}
+
+// MoveBlock はブロックを指定位置へ移動し、保存後のコンテンツを返します
+func (u *contentUsecase) MoveBlock(ctx context.Context, contentID, blockID uuid.UUID, input MoveBlockInput) (*entity.Content, error) {
+	if err := requireVersion(input.Version); err != nil {
+		return nil, err
+	}
+	if input.Position < 1 {
+		return nil, fmt.Errorf("%w: position=%d", entity.ErrInvalidParameter, input.Position)
+	}
+
+	err := u.validateBlockChange(ctx, contentID, func(blocks []entity.ContentBlock) ([]entity.ContentBlock, bool) {
+		index := findBlock(blocks, blockID)
+		if index < 0 || input.Position > len(blocks) {
+			return nil, false
+		}
+		moved := blocks[index]
+		return slices.Insert(slices.Delete(blocks, index, index+1), input.Position-1, moved), true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.contentRepository.MoveBlock(ctx, contentID, input.Version, blockID, input.Position); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, contentID)
+}
+
+// SetBlockVisibility はブロックの表示・非表示を切り替え、保存後のコンテンツを返します
+func (u *contentUsecase) SetBlockVisibility(ctx context.Context, contentID, blockID uuid.UUID, input BlockVisibilityInput) (*entity.Content, error) {
+	if err := requireVersion(input.Version); err != nil {
+		return nil, err
+	}
+
+	err := u.validateBlockChange(ctx, contentID, func(blocks []entity.ContentBlock) ([]entity.ContentBlock, bool) {
+		index := findBlock(blocks, blockID)
+		if index < 0 {
+			return nil, false
+		}
+		blocks[index].IsVisible = input.IsVisible
+		return blocks, true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.contentRepository.SetBlockVisibility(ctx, contentID, input.Version, blockID, input.IsVisible); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, contentID)
+}
+
+// DeleteBlock はブロックを削除し、保存後のコンテンツを返します
+func (u *contentUsecase) DeleteBlock(ctx context.Context, contentID, blockID uuid.UUID, version int) (*entity.Content, error) {
+	if err := requireVersion(version); err != nil {
+		return nil, err
+	}
+
+	err := u.validateBlockChange(ctx, contentID, func(blocks []entity.ContentBlock) ([]entity.ContentBlock, bool) {
+		index := findBlock(blocks, blockID)
+		if index < 0 {
+			return nil, false
+		}
+		return slices.Delete(blocks, index, index+1), true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.contentRepository.DeleteBlock(ctx, contentID, version, blockID); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, contentID)
+}
+
+// validateBlockChange は変更後のブロックがコンテンツタイプのフィールドの定義に沿っているかを検証します
+// changeは現在のブロックの複製から変更後のブロックを作ります。対象のブロックや位置が不正でfalseを返した場合は、
+// リポジトリが返すエラーに任せるため検証しません
+func (u *contentUsecase) validateBlockChange(ctx context.Context, contentID uuid.UUID, change func([]entity.ContentBlock) ([]entity.ContentBlock, bool)) error {
+	content, err := u.contentRepository.GetContentByID(ctx, contentID)
+	if err != nil {
+		return err
+	}
+
+	blocks, ok := change(slices.Clone(content.Blocks))
+	if !ok {
+		return nil
+	}
+	return u.validateBlocks(ctx, content.ContentTypeID, blocks)
+}
+
+// findBlock はブロックの位置を返します（存在しない場合は-1）
+func findBlock(blocks []entity.ContentBlock, blockID uuid.UUID) int {
+	return slices.IndexFunc(blocks, func(block entity.ContentBlock) bool {
+		return block.ID == blockID
+	})
+}
+
+// requireVersion は楽観的排他制御のための更新元バージョンが指定されているかを検証します
+func requireVersion(version int) error {
+	if version <= 0 {
+		return fmt.Errorf("%w: versionは必須です", entity.ErrInvalidParameter)
+	}
+	return nil
+}
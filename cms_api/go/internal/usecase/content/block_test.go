@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// InsertBlockのテスト
+func (s *contentsUsecaseTestSuite) TestInsertBlock() {
+	contentID := uuid.New()
+	contentTypeID := uuid.New()
+	current := &entity.Content{ID: contentID, ContentTypeID: contentTypeID, Version: 3,
+		Blocks: []entity.ContentBlock{{ID: uuid.New(), BlockType: entity.BlockTypeImage, IsVisible: true}}}
+	contentType := &entity.ContentType{ID: contentTypeID, FieldSchema: entity.FieldSchema{Fields: []entity.FieldDefinition{
+		{BlockType: entity.BlockTypeText},
+		{BlockType: entity.BlockTypeImage, MaxCount: 1},
+	}}}
+	testCases := []struct {
+		name          string
+		input         InsertBlockInput
+		setup         func()
+		expectedError error
+	}{
+		{
+			name:  "正常系：ブロックを挿入して最新のコンテンツを返す場合",
+			input: InsertBlockInput{Version: 2, Position: 1, Block: entity.ContentBlock{BlockType: entity.BlockTypeText}},
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(current, nil)
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).Return(contentType, nil)
+				s.mockRepository.EXPECT().InsertBlock(context.Background(), contentID, 2, mock.AnythingOfType("*entity.ContentBlock"), 1).Return(nil)
+			},
+		},
+		{
+			name:  "異常系：フィールドの定義の上限を超える場合",
+			input: InsertBlockInput{Version: 2, Block: entity.ContentBlock{BlockType: entity.BlockTypeImage}},
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(current, nil)
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).Return(contentType, nil)
+			},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：versionが指定されていない場合",
+			input:         InsertBlockInput{Block: entity.ContentBlock{BlockType: entity.BlockTypeText}},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：ブロックの種類が不正な場合",
+			input:         InsertBlockInput{Version: 1, Block: entity.ContentBlock{BlockType: "unknown"}},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:  "異常系：バージョンが競合した場合",
+			input: InsertBlockInput{Version: 1, Block: entity.ContentBlock{BlockType: entity.BlockTypeText}},
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(current, nil)
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).Return(contentType, nil)
+				s.mockRepository.EXPECT().InsertBlock(context.Background(), contentID, 1, mock.Anything, 0).
+					Return(fmt.Errorf("%w: %s", entity.ErrVersionConflict, contentID))
+			},
+			expectedError: entity.ErrVersionConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.setup()
+			result, err := s.usecase.InsertBlock(context.Background(), contentID, tc.input)
+			if tc.expectedError != nil {
+				assert.True(s.T(), errors.Is(err, tc.expectedError))
+				assert.Nil(s.T(), result)
+				return
+			}
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), 3, result.Version)
+		})
+	}
+}
+
+// MoveBlockのテスト
+func (s *contentsUsecaseTestSuite) TestMoveBlock() {
+	contentID := uuid.New()
+	blockID := uuid.New()
+
+	s.Run("正常系：ブロックを移動できる場合", func() {
+		s.mockRepository.EXPECT().MoveBlock(context.Background(), contentID, 1, blockID, 2).Return(nil)
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(&entity.Content{ID: contentID, Version: 2}, nil)
+
+		result, err := s.usecase.MoveBlock(context.Background(), contentID, blockID, MoveBlockInput{Version: 1, Position: 2})
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), 2, result.Version)
+	})
+
+	s.Run("異常系：移動先の位置が不正な場合", func() {
+		_, err := s.usecase.MoveBlock(context.Background(), contentID, blockID, MoveBlockInput{Version: 1, Position: 0})
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+}
+
+// SetBlockVisibilityのテスト
+func (s *contentsUsecaseTestSuite) TestSetBlockVisibility() {
+	contentID := uuid.New()
+	blockID := uuid.New()
+
+	s.Run("異常系：必須のブロックをすべて非表示にする場合", func() {
+		contentTypeID := uuid.New()
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(&entity.Content{ID: contentID, ContentTypeID: contentTypeID,
+			Blocks: []entity.ContentBlock{{ID: blockID, BlockType: entity.BlockTypeText, IsVisible: true}}}, nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).Return(&entity.ContentType{ID: contentTypeID,
+			FieldSchema: entity.FieldSchema{Fields: []entity.FieldDefinition{{BlockType: entity.BlockTypeText, Required: true}}}}, nil)
+
+		_, err := s.usecase.SetBlockVisibility(context.Background(), contentID, blockID, BlockVisibilityInput{Version: 1})
+		var violations entity.ValidationErrors
+		assert.True(s.T(), errors.As(err, &violations))
+		assert.Equal(s.T(), entity.ViolationRequired, violations[0].Code)
+	})
+
+	s.Run("異常系：ブロックが存在しない場合", func() {
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(&entity.Content{ID: contentID}, nil)
+		s.mockRepository.EXPECT().SetBlockVisibility(context.Background(), contentID, 1, blockID, false).
+			Return(fmt.Errorf("%w: %s", entity.ErrBlockNotFound, blockID))
+
+		_, err := s.usecase.SetBlockVisibility(context.Background(), contentID, blockID, BlockVisibilityInput{Version: 1})
+		assert.True(s.T(), errors.Is(err, entity.ErrBlockNotFound))
+	})
+}
+
+// DeleteBlockのテスト
+func (s *contentsUsecaseTestSuite) TestDeleteBlock() {
+	contentID := uuid.New()
+	blockID := uuid.New()
+
+	s.Run("正常系：ブロックを削除できる場合", func() {
+		s.mockRepository.EXPECT().DeleteBlock(context.Background(), contentID, 4, blockID).Return(nil)
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(&entity.Content{ID: contentID, Version: 5}, nil)
+
+		result, err := s.usecase.DeleteBlock(context.Background(), contentID, blockID, 4)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), 5, result.Version)
+	})
+
+	s.Run("異常系：versionが指定されていない場合", func() {
+		_, err := s.usecase.DeleteBlock(context.Background(), contentID, blockID, 0)
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+}
@@ -1,32 +1,589 @@
 package usecase
 
 import (
-	model "cms_api/internal/domain/entity"
+	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/repository"
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultLimit は一覧取得時のデフォルト取得件数
+	DefaultLimit = 20
+	// MaxLimit は一覧取得時の最大取得件数
+	MaxLimit = 100
 )
 
-type getContents interface {
-	GetArticles(ctx context.Context) ([]model.Article, error)
+const (
+	// ViewSummary は一覧でメタデータと抜粋・カバー画像のみを返す表示形式（デフォルト）
+	ViewSummary = "summary"
+	// ViewFull は一覧でブロックとブロックデータも返す表示形式
+	ViewFull = "full"
+)
+
+// sortColumns はAPIのソート対象名とカラムの対応表
+var sortColumns = map[string]repository.SortField{
+	"createdAt":   repository.SortFieldCreatedAt,
+	"updatedAt":   repository.SortFieldUpdatedAt,
+	"publishedAt": repository.SortFieldPublishedAt,
+	"title":       repository.SortFieldTitle,
+	"deletedAt":   repository.SortFieldDeletedAt,
+}
+
+// GetContentsInput はコンテンツ一覧取得の入力パラメータ
+type GetContentsInput struct {
+	Limit  int
+	Offset int
+	Status string
+	// CategoryはカテゴリのスラッグまたはID、TagsはタグのスラッグまたはID（すべてのタグが付いたコンテンツに絞り込む）
+	Category string
+	Tags     []string
+	// 日時の範囲（RFC3339または日付）。Fromはその日時を含み、Toは含まない（日付のみの場合はその日の終わりまで）
+	PublishedFrom string
+	PublishedTo   string
+	CreatedFrom   string
+	CreatedTo     string
+	UpdatedFrom   string
+	UpdatedTo     string
+	// Searchは空白区切りの検索キーワード（すべての語をタイトル・スラッグ・本文のいずれかに含むコンテンツに絞り込む）
+	Search string
+	// Sortはカンマ区切りのソート対象（「publishedAt:asc」のように個別に順序を指定できる）
+	// Orderは順序を指定しないソート対象に適用する（デフォルトは降順）
+	Sort  string
+	Order string
+	// Cursorを指定した場合はOffsetを無視し、カーソルの位置から取得する（総件数は数えない）
+	Cursor string
+	// Viewは表示形式（ViewSummaryまたはViewFull。空の場合はViewSummary）
+	View string
+}
+
+// ContentList はコンテンツ一覧とページネーション情報
+type ContentList struct {
+	Contents   []*entity.Content `json:"contents"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+// Pagination はページネーション情報
+type Pagination struct {
+	CurrentPage int   `json:"currentPage"`
+	PerPage     int   `json:"perPage"`
+	TotalCount  int64 `json:"totalCount"`
+	TotalPages  int   `json:"totalPages"`
+	HasPrev     bool  `json:"hasPrev"`
+	HasNext     bool  `json:"hasNext"`
+	PrevPage    *int  `json:"prevPage"`
+	NextPage    *int  `json:"nextPage"`
+	// PrevCursor・NextCursorは前後のページを取得するカーソル（ページがない場合はnull）
+	PrevCursor *string `json:"prevCursor"`
+	NextCursor *string `json:"nextCursor"`
+}
+
+// PatchContentInput はコンテンツ部分更新の入力パラメータ
+// nilのフィールドは更新しない（Versionは楽観的排他制御のため必須）
+type PatchContentInput struct {
+	Version       *int       `json:"version"`
+	ContentTypeID *uuid.UUID `json:"content_type_id"`
+	Title         *string    `json:"title"`
+	Slug          *string    `json:"slug"`
+	AuthorID      *string    `json:"author_id"`
+	// Statusを変更する場合は状態遷移のルールに従い、公開日時もあわせて更新する
+	Status *entity.ContentStatus `json:"status"`
+	// CategoryIDにゼロ値のUUIDを指定した場合はカテゴリを解除し、TagIDsを指定した場合はタグを送信内容で置き換える
+	CategoryID *uuid.UUID   `json:"category_id"`
+	TagIDs     *[]uuid.UUID `json:"tag_ids"`
+	// Blocksを指定した場合はブロック一覧を送信内容で置き換える
+	Blocks *[]entity.ContentBlock `json:"blocks"`
 }
 
 type contentUsecase struct {
-	contentRepository getContents
+	contentRepository repository.ContentRepository
+	// nowは現在時刻を返す（テスト時に差し替える）
+	now func() time.Time
 }
 
 // NewContentUsecase は新しいContentUsecaseインスタンスを作成します
-func NewContentUsecase(getContents getContents) *contentUsecase {
+func NewContentUsecase(contentRepository repository.ContentRepository) *contentUsecase {
 	return &contentUsecase{
-		contentRepository: getContents,
+		contentRepository: contentRepository,
+		now:               time.Now,
+	}
+}
+
+// GetContents はコンテンツ一覧を取得します
+// カーソルを指定した場合は、総件数を数えずにカーソルの位置から取得します
+func (u *contentUsecase) GetContents(ctx context.Context, input GetContentsInput) (*ContentList, error) {
+	limit, offset := pageRange(input)
+	filters, err := buildContentFilters(input, u.now())
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Cursor != "" {
+		return u.getContentsByCursor(ctx, limit, input.Cursor, filters)
+	}
+
+	contents, total, err := u.contentRepository.GetContents(ctx, limit, offset, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := newPagination(limit, offset, total)
+	setCursors(&pagination, contents, filters.Sort)
+	return &ContentList{
+		Contents:   contents,
+		Pagination: pagination,
+	}, nil
+}
+
+// getContentsByCursor はカーソルの次（前のページのカーソルの場合は前）のコンテンツを取得します
+// カーソルで移動してきた側には常にページがあるものとして扱います
+func (u *contentUsecase) getContentsByCursor(ctx context.Context, limit int, cursor string, filters repository.ContentFilters) (*ContentList, error) {
+	keyset, err := decodeCursor(cursor, filters.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, hasMore, err := u.contentRepository.GetContentsByKeyset(ctx, limit, keyset, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	// 前後のページのカーソルは取得したコンテンツから作るため、コンテンツがない場合は前後のページもないものとする
+	pagination := Pagination{PerPage: limit}
+	if len(contents) > 0 {
+		pagination.HasPrev = !keyset.Backward || hasMore
+		pagination.HasNext = keyset.Backward || hasMore
+		setCursors(&pagination, contents, filters.Sort)
+	}
+	return &ContentList{
+		Contents:   contents,
+		Pagination: pagination,
+	}, nil
+}
+
+// GetContentByID はIDでコンテンツを取得します
+func (u *contentUsecase) GetContentByID(ctx context.Context, id uuid.UUID) (*entity.Content, error) {
+	return u.contentRepository.GetContentByID(ctx, id)
+}
+
+// CreateContent はコンテンツをブロックと共に作成し、保存後の状態を返します
+func (u *contentUsecase) CreateContent(ctx context.Context, content *entity.Content) (*entity.Content, error) {
+	content.ID = uuid.Nil
+	content.Version = 1
+
+	// 下書きとして作成し、公開が指定された場合は状態遷移のルールに従って公開する
+	// アーカイブ・ゴミ箱のコンテンツは作成できない
+	requested := content.Status
+	content.Status = entity.ContentStatusDraft
+	content.PublishedAt = nil
+	content.Unschedule()
+	content.DeletedAt = nil
+	switch requested {
+	case "", entity.ContentStatusDraft:
+	case entity.ContentStatusPublished:
+		if err := content.ChangeStatus(requested, u.now()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: 作成時のステータスはdraftまたはpublishedを指定してください: %s", entity.ErrInvalidParameter, requested)
 	}
+	normalizeBlocks(content)
+
+	if err := u.validateContent(ctx, content, nil); err != nil {
+		return nil, err
+	}
+
+	if err := u.contentRepository.CreateContent(ctx, content); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, content.ID)
+}
+
+// UpdateContent はコンテンツを置き換え、保存後の状態を返します
+// content.Versionには更新元となったバージョンを指定する必要があります
+func (u *contentUsecase) UpdateContent(ctx context.Context, id uuid.UUID, content *entity.Content) (*entity.Content, error) {
+	if err := requireVersion(content.Version); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.contentRepository.GetContentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	content.ID = id
+	content.CreatedAt = existing.CreatedAt
+
+	// 公開日時はステータスの変更に応じてのみ更新する
+	requested := content.Status
+	content.Status = existing.Status
+	content.PublishedAt = existing.PublishedAt
+	content.ScheduledAt = existing.ScheduledAt
+	content.ExpiresAt = existing.ExpiresAt
+	content.DeletedAt = existing.DeletedAt
+	if requested != "" {
+		if err := u.changeStatus(content, requested); err != nil {
+			return nil, err
+		}
+	}
+	normalizeBlocks(content)
+
+	return u.saveContent(ctx, content, existing)
+}
+
+// PatchContent は指定されたフィールドのみを更新し、保存後の状態を返します
+func (u *contentUsecase) PatchContent(ctx context.Context, id uuid.UUID, input PatchContentInput) (*entity.Content, error) {
+	if input.Version == nil || *input.Version <= 0 {
+		return nil, fmt.Errorf("%w: versionは必須です", entity.ErrInvalidParameter)
+	}
+
+	content, err := u.contentRepository.GetContentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	current := *content
+
+	content.Version = *input.Version
+	if input.ContentTypeID != nil {
+		content.ContentTypeID = *input.ContentTypeID
+	}
+	if input.Title != nil {
+		content.Title = *input.Title
+	}
+	if input.Slug != nil {
+		content.Slug = *input.Slug
+	}
+	if input.AuthorID != nil {
+		content.AuthorID = *input.AuthorID
+	}
+	if input.Status != nil {
+		if err := u.changeStatus(content, *input.Status); err != nil {
+			return nil, err
+		}
+	}
+	if input.CategoryID != nil {
+		content.CategoryID = input.CategoryID
+		if *input.CategoryID == uuid.Nil {
+			content.CategoryID = nil
+		}
+	}
+	if input.TagIDs != nil {
+		content.TagIDs = *input.TagIDs
+	}
+	if input.Blocks != nil {
+		content.Blocks = *input.Blocks
+		normalizeBlocks(content)
+	}
+
+	return u.saveContent(ctx, content, &current)
+}
+
+// changeStatus は更新で指定されたステータスへ状態遷移のルールに従って変更します
+// アーカイブ・ゴミ箱への移動は参照元の確認が必要なため、状態遷移の操作（TransitionContent・DeleteContent）でのみ受け付けます
+func (u *contentUsecase) changeStatus(content *entity.Content, status entity.ContentStatus) error {
+	if status != content.Status && (status == entity.ContentStatusArchived || status == entity.ContentStatusTrash) {
+		return fmt.Errorf("%w: %sへの変更はアーカイブ・ゴミ箱への移動の操作で行ってください", entity.ErrInvalidStatusTransition, status)
+	}
+	return content.ChangeStatus(status, u.now())
 }
 
-// GetContent はコンテンツを取得します
-func (u *contentUsecase) GetArticles(ctx context.Context) ([]model.Article, error) {
-	contents, err := u.contentRepository.GetArticles(ctx)
+// TransitionContent は公開・非公開・アーカイブ・ゴミ箱への移動・復元の操作を適用し、保存後の状態を返します
+// アーカイブ・ゴミ箱への移動は、他のコンテンツから参照されている場合にpolicyに従って参照元を処理します
+// policyはアーカイブ・ゴミ箱への移動でのみ指定できます
+func (u *contentUsecase) TransitionContent(ctx context.Context, id uuid.UUID, action entity.ContentAction, expectedVersion int, policy entity.ReferrerPolicy) (*entity.Content, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+	if !action.IsValid() {
+		return nil, fmt.Errorf("%w: action=%s", entity.ErrInvalidParameter, action)
+	}
+	if err := requireReferrerPolicy(policy); err != nil {
+		return nil, err
+	}
+	releases := action == entity.ContentActionArchive || action == entity.ContentActionTrash
+	if !releases && policy != entity.ReferrerPolicyRestrict {
+		return nil, fmt.Errorf("%w: forceはアーカイブ・ゴミ箱への移動でのみ指定できます: action=%s", entity.ErrInvalidParameter, action)
+	}
+
+	content, err := u.contentRepository.GetContentByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	result := contents
-	return result, nil
+	content.Version = expectedVersion
+	if err := content.Apply(action, u.now()); err != nil {
+		return nil, err
+	}
+
+	if releases {
+		err = u.contentRepository.UpdateReferencedContentStatus(ctx, content, policy)
+	} else {
+		err = u.contentRepository.UpdateContentStatus(ctx, content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, id)
+}
+
+// saveContent は検証後にコンテンツを更新し、保存後の状態を返します（currentは更新前の状態）
+func (u *contentUsecase) saveContent(ctx context.Context, content, current *entity.Content) (*entity.Content, error) {
+	if err := u.validateContent(ctx, content, current); err != nil {
+		return nil, err
+	}
+
+	if err := u.contentRepository.UpdateContent(ctx, content); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, content.ID)
+}
+
+// validateContent はコンテンツの妥当性と、コンテンツタイプのフィールドの定義に沿ったブロックかを検証します
+// スラッグが空の場合はタイトルから作成し、コンテンツタイプ内で重複しないことを確認します（currentは更新前の状態で、作成時はnil）
+func (u *contentUsecase) validateContent(ctx context.Context, content, current *entity.Content) error {
+	generated := generateSlug(content)
+	if err := content.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+	if !content.Status.IsValid() {
+		return fmt.Errorf("%w: status=%s", entity.ErrInvalidParameter, content.Status)
+	}
+
+	if err := u.validateBlocks(ctx, content.ContentTypeID, content.Blocks); err != nil {
+		return err
+	}
+	return u.resolveSlug(ctx, content, current, generated)
+}
+
+// validateBlocks はブロックをコンテンツタイプのフィールドの定義で検証します
+// 違反はすべてentity.ValidationErrorsとして返します（コンテンツタイプが有効でない場合はErrInvalidParameter）
+func (u *contentUsecase) validateBlocks(ctx context.Context, contentTypeID uuid.UUID, blocks []entity.ContentBlock) error {
+	contentType, err := u.contentRepository.GetContentTypeByID(ctx, contentTypeID)
+	if err != nil {
+		if errors.Is(err, entity.ErrContentTypeNotFound) {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+		}
+		return err
+	}
+
+	var referencedTypes map[uuid.UUID]string
+	if referencedIDs := referencedContentIDs(blocks); len(referencedIDs) > 0 {
+		if referencedTypes, err = u.contentRepository.GetContentTypeNames(ctx, referencedIDs); err != nil {
+			return err
+		}
+	}
+
+	if errs := contentType.FieldSchema.ValidateBlocks(blocks, referencedTypes); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// referencedContentIDs は参照ブロックの参照先のコンテンツIDを返します
+func referencedContentIDs(blocks []entity.ContentBlock) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, block := range blocks {
+		if block.BlockType == entity.BlockTypeReference && block.Data != nil && block.Data.ReferencedContentID != nil {
+			ids = append(ids, *block.Data.ReferencedContentID)
+		}
+	}
+	return ids
+}
+
+// normalizeBlocks はブロックの並び順を送信順に振り直します
+func normalizeBlocks(content *entity.Content) {
+	for i := range content.Blocks {
+		content.Blocks[i].ContentID = content.ID
+		content.Blocks[i].BlockOrder = i + 1
+	}
+}
+
+// pageRange は取得件数とオフセットを許容範囲に補正します
+func pageRange(input GetContentsInput) (limit, offset int) {
+	limit = input.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	offset = input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// buildContentFilters は入力パラメータを検証してリポジトリのフィルター条件に変換します
+// 公開中のコンテンツを指定された場合は、nowの時点で公開期間内のものに絞り込みます
+// ゴミ箱のコンテンツを指定された場合は、デフォルトでゴミ箱へ移動した日時の新しい順に並べます
+func buildContentFilters(input GetContentsInput, now time.Time) (repository.ContentFilters, error) {
+	filters := repository.ContentFilters{
+		Category: input.Category,
+		Tags:     input.Tags,
+		Sort:     repository.DefaultSort,
+	}
+
+	terms, err := entity.ParseSearchTerms(input.Search)
+	if err != nil {
+		return filters, err
+	}
+	filters.Terms = terms
+
+	switch input.View {
+	case "", ViewSummary:
+	case ViewFull:
+		filters.WithBlocks = true
+	default:
+		return filters, fmt.Errorf("%w: view=%s", entity.ErrInvalidParameter, input.View)
+	}
+
+	if input.Status != "" {
+		status := entity.ContentStatus(input.Status)
+		if !status.IsValid() {
+			return filters, fmt.Errorf("%w: status=%s", entity.ErrInvalidParameter, input.Status)
+		}
+		filters.Status = &status
+		switch status {
+		case entity.ContentStatusPublished:
+			filters.VisibleAt = &now
+		case entity.ContentStatusTrash:
+			filters.Sort = []repository.SortKey{{Field: repository.SortFieldDeletedAt, Desc: true}}
+		}
+	}
+
+	ranges := []struct {
+		fromName, toName string
+		from, to         string
+		fromDst, toDst   **time.Time
+	}{
+		{"publishedFrom", "publishedTo", input.PublishedFrom, input.PublishedTo, &filters.PublishedFrom, &filters.PublishedTo},
+		{"createdFrom", "createdTo", input.CreatedFrom, input.CreatedTo, &filters.CreatedFrom, &filters.CreatedTo},
+		{"updatedFrom", "updatedTo", input.UpdatedFrom, input.UpdatedTo, &filters.UpdatedFrom, &filters.UpdatedTo},
+	}
+	for _, r := range ranges {
+		from, err := parseTimeParam(r.fromName, r.from, false)
+		if err != nil {
+			return filters, err
+		}
+		to, err := parseTimeParam(r.toName, r.to, true)
+		if err != nil {
+			return filters, err
+		}
+		if from != nil && to != nil && !from.Before(*to) {
+			return filters, fmt.Errorf("%w: %sは%sより前の日時を指定してください", entity.ErrInvalidParameter, r.fromName, r.toName)
+		}
+		*r.fromDst, *r.toDst = from, to
+	}
+
+	sort, err := parseSort(input.Sort, input.Order, filters.Sort)
+	if err != nil {
+		return filters, err
+	}
+	filters.Sort = sort
+
+	return filters, nil
+}
+
+// parseSort はカンマ区切りのソート指定をソート条件に変換します
+// 各ソート対象には「:asc」「:desc」で順序を指定でき、指定がない場合はorder（デフォルトは降順）に従います
+// sortが空の場合はdefaultsの並び順で、orderが指定されていればその順序に揃えます
+func parseSort(sort, order string, defaults []repository.SortKey) ([]repository.SortKey, error) {
+	desc := true
+	switch strings.ToLower(order) {
+	case "":
+	case "asc":
+		desc = false
+	case "desc":
+	default:
+		return nil, fmt.Errorf("%w: order=%s", entity.ErrInvalidParameter, order)
+	}
+
+	if sort == "" {
+		keys := make([]repository.SortKey, len(defaults))
+		for i, key := range defaults {
+			keys[i] = key
+			if order != "" {
+				keys[i].Desc = desc
+			}
+		}
+		return keys, nil
+	}
+
+	var keys []repository.SortKey
+	seen := make(map[repository.SortField]bool)
+	for _, item := range strings.Split(sort, ",") {
+		name, direction, hasDirection := strings.Cut(strings.TrimSpace(item), ":")
+		field, ok := sortColumns[name]
+		if !ok || seen[field] {
+			return nil, fmt.Errorf("%w: sort=%s", entity.ErrInvalidParameter, sort)
+		}
+		seen[field] = true
+
+		key := repository.SortKey{Field: field, Desc: desc}
+		if hasDirection {
+			switch strings.ToLower(direction) {
+			case "asc":
+				key.Desc = false
+			case "desc":
+				key.Desc = true
+			default:
+				return nil, fmt.Errorf("%w: sort=%s", entity.ErrInvalidParameter, sort)
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// parseTimeParam は日時の範囲指定をRFC3339または日付（日本時間）として解釈します
+// endがtrueで日付のみが指定された場合は、その日の終わり（翌日の0時）を返します
+func parseTimeParam(name, raw string, end bool) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.ParseInLocation(time.DateOnly, raw, archiveLocation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s=%s", entity.ErrInvalidParameter, name, raw)
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+// newPagination は取得件数・オフセット・総件数からページネーション情報を計算します
+func newPagination(limit, offset int, total int64) Pagination {
+	currentPage := offset/limit + 1
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+
+	p := Pagination{
+		CurrentPage: currentPage,
+		PerPage:     limit,
+		TotalCount:  total,
+		TotalPages:  totalPages,
+		HasPrev:     currentPage > 1,
+		HasNext:     currentPage < totalPages,
+	}
+	if p.HasPrev {
+		prev := currentPage - 1
+		p.PrevPage = &prev
+	}
+	if p.HasNext {
+		next := currentPage + 1
+		p.NextPage = &next
+	}
+	return p
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/repository"
 	"context"
 	"errors"
 	"fmt"
@@ -9,69 +10,258 @@ import (
 	"testing"
 	"time"
 
-	"cms_api/internal/usecase/content/mocks"
+	"cms_api/internal/infrastructure/repository/mocks"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 type contentsUsecaseTestSuite struct {
 	suite.Suite
 	usecase        *contentUsecase
-	mockRepository *mocks.GetContents
+	mockRepository *mocks.ContentRepository
+	now            time.Time
 }
 
-func randomContent(timeInt int64) *model.Article {
-	return &model.Article{
-		ID:        uuid.New().String(),
-		Title:     fmt.Sprintf("test title %d", timeInt),
-		Body:      fmt.Sprintf("test body %d", timeInt),
-		CreatedAt: time.Unix(timeInt, 0),
-		UpdatedAt: time.Unix(timeInt, 0),
+func randomContent(timeInt int64) *entity.Content {
+	return &entity.Content{
+		ID:            uuid.New(),
+		ContentTypeID: uuid.New(),
+		Title:         fmt.Sprintf("test title %d", timeInt),
+		Slug:          fmt.Sprintf("test-slug-%d", timeInt),
+		Status:        entity.ContentStatusDraft,
+		AuthorID:      "admin",
+		Version:       1,
+		CreatedAt:     time.Unix(timeInt, 0),
+		UpdatedAt:     time.Unix(timeInt, 0),
 	}
 }
 
-// TestContentsControllerを実行（テストメインエントリーポイント）
+// TestContentsUsecaseを実行（テストメインエントリーポイント）
 func TestContentsUsecase(t *testing.T) {
 	suite.Run(t, new(contentsUsecaseTestSuite))
 }
 
 // 各テスト実行前のセットアップ
 func (s *contentsUsecaseTestSuite) SetupSubTest() {
-	s.mockRepository = mocks.NewGetContents(s.T())
+	s.mockRepository = mocks.NewContentRepository(s.T())
 	s.usecase = NewContentUsecase(s.mockRepository)
+	s.now = time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	s.usecase.now = func() time.Time { return s.now }
 }
 
 // GetContentsのテスト
 func (s *contentsUsecaseTestSuite) TestGetContents() {
-	randomInt := rand.Int64()
+	randomInt := rand.Int64N(1 << 32)
 	content1 := randomContent(randomInt)
 	content2 := randomContent(randomInt + 1)
+	published := entity.ContentStatusPublished
 	testCases := []struct {
-		name          string
-		setup         func()
-		expectedData  []model.Article
-		expectedError error
+		name               string
+		input              GetContentsInput
+		setup              func()
+		expectedData       []*entity.Content
+		expectedPagination Pagination
+		expectedError      error
 	}{
 		{
-			name: "正常系：コンテンツが正常に取得できる場合",
+			name:  "正常系：デフォルトのパラメータで取得できる場合",
+			input: GetContentsInput{},
 			setup: func() {
-				contents := []model.Article{*content1, *content2}
-				s.mockRepository.EXPECT().GetArticles(context.Background()).Return(contents, nil)
+				filters := repository.ContentFilters{Sort: repository.DefaultSort}
+				s.mockRepository.EXPECT().GetContents(context.Background(), DefaultLimit, 0, filters).
+					Return([]*entity.Content{content1, content2}, 2, nil)
 			},
-			expectedData: []model.Article{
-				*content1,
-				*content2,
+			expectedData: []*entity.Content{content1, content2},
+			expectedPagination: Pagination{
+				CurrentPage: 1,
+				PerPage:     DefaultLimit,
+				TotalCount:  2,
+				TotalPages:  1,
 			},
-			expectedError: nil,
 		},
 		{
-			name: "異常系：コンテンツ取得でエラーが発生する場合",
+			name: "正常系：フィルター・ソート・ページ指定で取得できる場合",
+			input: GetContentsInput{
+				Limit:  10,
+				Offset: 10,
+				Status: "published",
+				Search: "AWS",
+				Sort:   "publishedAt",
+				Order:  "asc",
+			},
+			setup: func() {
+				filters := repository.ContentFilters{
+					Status:    &published,
+					VisibleAt: &s.now,
+					Terms:     []string{"aws"},
+					Sort:      []repository.SortKey{{Field: repository.SortFieldPublishedAt}},
+				}
+				s.mockRepository.EXPECT().GetContents(context.Background(), 10, 10, filters).
+					Return([]*entity.Content{content1}, 25, nil)
+			},
+			expectedData: []*entity.Content{content1},
+			expectedPagination: Pagination{
+				CurrentPage: 2,
+				PerPage:     10,
+				TotalCount:  25,
+				TotalPages:  3,
+				HasPrev:     true,
+				HasNext:     true,
+				PrevPage:    intPtr(1),
+				NextPage:    intPtr(3),
+				PrevCursor:  encodeCursor(content1, []repository.SortKey{{Field: repository.SortFieldPublishedAt}}, true),
+				NextCursor:  encodeCursor(content1, []repository.SortKey{{Field: repository.SortFieldPublishedAt}}, false),
+			},
+		},
+		{
+			name:  "正常系：カテゴリとタグで絞り込む場合",
+			input: GetContentsInput{Category: "aws", Tags: []string{"go", "lambda"}},
+			setup: func() {
+				filters := repository.ContentFilters{
+					Category: "aws",
+					Tags:     []string{"go", "lambda"},
+					Sort:     repository.DefaultSort,
+				}
+				s.mockRepository.EXPECT().GetContents(context.Background(), DefaultLimit, 0, filters).
+					Return([]*entity.Content{content1}, 1, nil)
+			},
+			expectedData: []*entity.Content{content1},
+			expectedPagination: Pagination{
+				CurrentPage: 1,
+				PerPage:     DefaultLimit,
+				TotalCount:  1,
+				TotalPages:  1,
+			},
+		},
+		{
+			name: "正常系：日時の範囲で絞り込む場合（日付のみの終了日はその日の終わりまで）",
+			input: GetContentsInput{
+				PublishedFrom: "2025-01-01",
+				PublishedTo:   "2025-01-31",
+				UpdatedFrom:   "2025-01-10T00:00:00Z",
+			},
 			setup: func() {
-				s.mockRepository.EXPECT().GetArticles(context.Background()).Return(nil, errors.New("取得エラー"))
+				publishedFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, archiveLocation)
+				publishedTo := time.Date(2025, 2, 1, 0, 0, 0, 0, archiveLocation)
+				updatedFrom := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+				// 日付のみの指定は日本時間で解釈されるため、時刻の同一性で比較する
+				s.mockRepository.EXPECT().GetContents(context.Background(), DefaultLimit, 0, mock.MatchedBy(func(f repository.ContentFilters) bool {
+					return f.PublishedFrom.Equal(publishedFrom) && f.PublishedTo.Equal(publishedTo) &&
+						f.UpdatedFrom.Equal(updatedFrom) && f.UpdatedTo == nil && f.CreatedFrom == nil && f.CreatedTo == nil
+				})).Return([]*entity.Content{content1}, 1, nil)
+			},
+			expectedData: []*entity.Content{content1},
+			expectedPagination: Pagination{
+				CurrentPage: 1,
+				PerPage:     DefaultLimit,
+				TotalCount:  1,
+				TotalPages:  1,
+			},
+		},
+		{
+			name:          "異常系：日時の形式が不正な場合",
+			input:         GetContentsInput{CreatedFrom: "2025/01/01"},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：開始日時が終了日時より後の場合",
+			input:         GetContentsInput{PublishedFrom: "2025-02-01", PublishedTo: "2025-01-01"},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:  "正常系：範囲外のlimitとoffsetは補正される場合",
+			input: GetContentsInput{Limit: 1000, Offset: -1},
+			setup: func() {
+				filters := repository.ContentFilters{Sort: repository.DefaultSort}
+				s.mockRepository.EXPECT().GetContents(context.Background(), MaxLimit, 0, filters).
+					Return([]*entity.Content{}, 0, nil)
+			},
+			expectedData: []*entity.Content{},
+			expectedPagination: Pagination{
+				CurrentPage: 1,
+				PerPage:     MaxLimit,
+			},
+		},
+		{
+			name:          "異常系：不正なステータスが指定された場合",
+			input:         GetContentsInput{Status: "unknown"},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：不正なソート対象が指定された場合",
+			input:         GetContentsInput{Sort: "id; DROP TABLE contents"},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:  "正常系：複数のソート対象を個別の順序で指定する場合",
+			input: GetContentsInput{Sort: "publishedAt:desc, title", Order: "asc"},
+			setup: func() {
+				filters := repository.ContentFilters{Sort: []repository.SortKey{
+					{Field: repository.SortFieldPublishedAt, Desc: true},
+					{Field: repository.SortFieldTitle},
+				}}
+				s.mockRepository.EXPECT().GetContents(context.Background(), DefaultLimit, 0, filters).
+					Return([]*entity.Content{}, 0, nil)
+			},
+			expectedData: []*entity.Content{},
+			expectedPagination: Pagination{
+				CurrentPage: 1,
+				PerPage:     DefaultLimit,
+			},
+		},
+		{
+			name:  "正常系：表示形式にfullを指定した場合はブロックも取得する",
+			input: GetContentsInput{View: ViewFull},
+			setup: func() {
+				filters := repository.ContentFilters{Sort: repository.DefaultSort, WithBlocks: true}
+				s.mockRepository.EXPECT().GetContents(context.Background(), DefaultLimit, 0, filters).
+					Return([]*entity.Content{}, 0, nil)
+			},
+			expectedData: []*entity.Content{},
+			expectedPagination: Pagination{
+				CurrentPage: 1,
+				PerPage:     DefaultLimit,
+			},
+		},
+		{
+			name:          "異常系：不正な表示形式が指定された場合",
+			input:         GetContentsInput{View: "detail"},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：同じソート対象が重複して指定された場合",
+			input:         GetContentsInput{Sort: "title,title:asc"},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：ソート対象の順序が不正な場合",
+			input:         GetContentsInput{Sort: "title:up"},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：不正なソート順が指定された場合",
+			input:         GetContentsInput{Order: "random"},
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:  "異常系：コンテンツ取得でエラーが発生する場合",
+			input: GetContentsInput{},
+			setup: func() {
+				filters := repository.ContentFilters{Sort: repository.DefaultSort}
+				s.mockRepository.EXPECT().GetContents(context.Background(), DefaultLimit, 0, filters).
+					Return(nil, 0, errors.New("取得エラー"))
 			},
-			expectedData:  nil,
 			expectedError: errors.New("取得エラー"),
 		},
 	}
@@ -82,20 +272,581 @@ func (s *contentsUsecaseTestSuite) TestGetContents() {
 			tc.setup()
 
 			// テスト対象のメソッドを実行
-			contents, err := s.usecase.GetArticles(context.Background())
+			list, err := s.usecase.GetContents(context.Background(), tc.input)
 
 			// エラーのアサーション
-			assert.Equal(s.T(), tc.expectedError, err)
+			if tc.expectedError != nil {
+				assert.ErrorContains(s.T(), err, tc.expectedError.Error())
+				assert.Nil(s.T(), list)
+				return
+			}
+			assert.NoError(s.T(), err)
 
 			// データのアサーション
-			assert.Equal(s.T(), len(tc.expectedData), len(contents))
-			for i, content := range contents {
-				assert.Equal(s.T(), tc.expectedData[i].ID, content.ID)
-				assert.Equal(s.T(), tc.expectedData[i].Title, content.Title)
-				assert.Equal(s.T(), tc.expectedData[i].Body, content.Body)
-				assert.True(s.T(), content.CreatedAt.Equal(tc.expectedData[i].CreatedAt))
-				assert.True(s.T(), content.UpdatedAt.Equal(tc.expectedData[i].UpdatedAt))
+			assert.Equal(s.T(), tc.expectedData, list.Contents)
+			assert.Equal(s.T(), tc.expectedPagination, list.Pagination)
+		})
+	}
+}
+
+// GetContentByIDのテスト
+func (s *contentsUsecaseTestSuite) TestGetContentByID() {
+	content := randomContent(rand.Int64N(1 << 32))
+	testCases := []struct {
+		name          string
+		setup         func()
+		expectedData  *entity.Content
+		expectedError error
+	}{
+		{
+			name: "正常系：コンテンツが取得できる場合",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), content.ID).Return(content, nil)
+			},
+			expectedData: content,
+		},
+		{
+			name: "異常系：コンテンツが存在しない場合",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), content.ID).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrContentNotFound, content.ID))
+			},
+			expectedError: entity.ErrContentNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := s.usecase.GetContentByID(context.Background(), content.ID)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(s.T(), err, tc.expectedError)
+				assert.Nil(s.T(), result)
+				return
+			}
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), tc.expectedData, result)
+		})
+	}
+}
+
+// CreateContentのテスト
+func (s *contentsUsecaseTestSuite) TestCreateContent() {
+	newInput := func() *entity.Content {
+		return &entity.Content{
+			ContentTypeID: uuid.New(),
+			Title:         "新規記事",
+			Slug:          "new-article",
+			AuthorID:      "admin",
+			Blocks: []entity.ContentBlock{
+				{BlockType: entity.BlockTypeText, BlockOrder: 5, Data: &entity.ContentBlockData{DataType: entity.DataTypeText, ContentText: "本文1"}},
+				{BlockType: entity.BlockTypeText, BlockOrder: 2, Data: &entity.ContentBlockData{DataType: entity.DataTypeText, ContentText: "本文2"}},
+			},
+		}
+	}
+	testCases := []struct {
+		name          string
+		input         func() *entity.Content
+		setup         func(input *entity.Content)
+		expectedError error
+	}{
+		{
+			name:  "正常系：ブロックと共に作成できる場合",
+			input: newInput,
+			setup: func(input *entity.Content) {
+				createdID := uuid.New()
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), input.ContentTypeID).
+					Return(&entity.ContentType{ID: input.ContentTypeID}, nil)
+				s.mockRepository.EXPECT().ContentSlugExists(context.Background(), input.ContentTypeID, "new-article", uuid.Nil).
+					Return(false, nil)
+				s.mockRepository.EXPECT().CreateContent(context.Background(), input).
+					RunAndReturn(func(_ context.Context, c *entity.Content) error {
+						// 送信順に並び順が振り直され、初期値が設定されていること
+						assert.Equal(s.T(), 1, c.Blocks[0].BlockOrder)
+						assert.Equal(s.T(), 2, c.Blocks[1].BlockOrder)
+						assert.Equal(s.T(), entity.ContentStatusDraft, c.Status)
+						assert.Equal(s.T(), 1, c.Version)
+						c.ID = createdID
+						return nil
+					})
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), createdID).
+					Return(&entity.Content{ID: createdID, Title: input.Title}, nil)
+			},
+		},
+		{
+			name: "正常系：スラッグが空の場合はタイトルから作成し、重複する場合は連番を付ける場合",
+			input: func() *entity.Content {
+				c := newInput()
+				c.Title = "AWS Lambda入門"
+				c.Slug = ""
+				return c
+			},
+			setup: func(input *entity.Content) {
+				createdID := uuid.New()
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), input.ContentTypeID).
+					Return(&entity.ContentType{ID: input.ContentTypeID}, nil)
+				s.mockRepository.EXPECT().ContentSlugExists(context.Background(), input.ContentTypeID, "aws-lambda入門", uuid.Nil).
+					Return(true, nil)
+				s.mockRepository.EXPECT().ContentSlugExists(context.Background(), input.ContentTypeID, "aws-lambda入門-2", uuid.Nil).
+					Return(false, nil)
+				s.mockRepository.EXPECT().CreateContent(context.Background(), mock.MatchedBy(func(c *entity.Content) bool {
+					return c.Slug == "aws-lambda入門-2"
+				})).RunAndReturn(func(_ context.Context, c *entity.Content) error {
+					c.ID = createdID
+					return nil
+				})
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), createdID).
+					Return(&entity.Content{ID: createdID, Slug: "aws-lambda入門-2"}, nil)
+			},
+		},
+		{
+			name:  "異常系：指定したスラッグがコンテンツタイプ内で重複する場合",
+			input: newInput,
+			setup: func(input *entity.Content) {
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), input.ContentTypeID).
+					Return(&entity.ContentType{ID: input.ContentTypeID}, nil)
+				s.mockRepository.EXPECT().ContentSlugExists(context.Background(), input.ContentTypeID, "new-article", uuid.Nil).
+					Return(true, nil)
+			},
+			expectedError: entity.ErrAlreadyExists,
+		},
+		{
+			name: "異常系：スラッグの形式が不正な場合",
+			input: func() *entity.Content {
+				c := newInput()
+				c.Slug = "New Article"
+				return c
+			},
+			setup: func(input *entity.Content) {
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), input.ContentTypeID).
+					Return(&entity.ContentType{ID: input.ContentTypeID}, nil)
+			},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name: "異常系：タイトルが空の場合",
+			input: func() *entity.Content {
+				c := newInput()
+				c.Title = ""
+				return c
+			},
+			setup:         func(input *entity.Content) {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name: "異常系：不正なステータスが指定された場合",
+			input: func() *entity.Content {
+				c := newInput()
+				c.Status = "unknown"
+				return c
+			},
+			setup:         func(input *entity.Content) {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name: "異常系：ゴミ箱のステータスで作成しようとした場合",
+			input: func() *entity.Content {
+				c := newInput()
+				c.Status = entity.ContentStatusTrash
+				return c
+			},
+			setup:         func(input *entity.Content) {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name: "異常系：アーカイブのステータスで作成しようとした場合",
+			input: func() *entity.Content {
+				c := newInput()
+				c.Status = entity.ContentStatusArchived
+				return c
+			},
+			setup:         func(input *entity.Content) {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:  "異常系：コンテンツタイプが存在しない場合",
+			input: newInput,
+			setup: func(input *entity.Content) {
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), input.ContentTypeID).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrContentTypeNotFound, input.ContentTypeID))
+			},
+			expectedError: entity.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			input := tc.input()
+			tc.setup(input)
+
+			result, err := s.usecase.CreateContent(context.Background(), input)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(s.T(), err, tc.expectedError)
+				assert.Nil(s.T(), result)
+				return
 			}
+			assert.NoError(s.T(), err)
+			assert.NotEqual(s.T(), uuid.Nil, result.ID)
 		})
 	}
 }
+
+// CreateContentでフィールドの定義に沿わないブロックを検証するテスト
+func (s *contentsUsecaseTestSuite) TestCreateContentFieldSchema() {
+	s.Run("異常系：違反をすべてdetailsとして返す場合", func() {
+		contentTypeID, productID, missingID := uuid.New(), uuid.New(), uuid.New()
+		input := &entity.Content{
+			ContentTypeID: contentTypeID,
+			Title:         "新規記事",
+			Slug:          "new-article",
+			AuthorID:      "admin",
+			Blocks: []entity.ContentBlock{
+				{BlockType: entity.BlockTypeText, IsVisible: true, Data: &entity.ContentBlockData{DataType: entity.DataTypeText, ContentText: "長すぎる本文"}},
+				{BlockType: entity.BlockTypeReference, IsVisible: true, Data: &entity.ContentBlockData{DataType: entity.DataTypeReference, ReferencedContentID: &productID}},
+				{BlockType: entity.BlockTypeReference, IsVisible: true, Data: &entity.ContentBlockData{DataType: entity.DataTypeReference, ReferencedContentID: &missingID}},
+				{BlockType: entity.BlockTypeVideo, IsVisible: true},
+			},
+		}
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).Return(&entity.ContentType{
+			ID: contentTypeID,
+			FieldSchema: entity.FieldSchema{Fields: []entity.FieldDefinition{
+				{BlockType: entity.BlockTypeText, MaxLength: 3},
+				{BlockType: entity.BlockTypeReference, ReferenceTypes: []string{"blog_post"}},
+				{BlockType: entity.BlockTypeImage, Label: "アイキャッチ", Required: true},
+			}},
+		}, nil)
+		s.mockRepository.EXPECT().GetContentTypeNames(context.Background(), []uuid.UUID{productID, missingID}).
+			Return(map[uuid.UUID]string{productID: "product"}, nil)
+
+		result, err := s.usecase.CreateContent(context.Background(), input)
+		assert.Nil(s.T(), result)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		var violations entity.ValidationErrors
+		assert.True(s.T(), errors.As(err, &violations))
+		assert.Equal(s.T(), entity.ValidationErrors{
+			{Field: "blocks[0].data", Code: entity.ViolationTooLong, Message: "本文は3文字以内にしてください（6文字）"},
+			{Field: "blocks[1].data.referenced_content_id", Code: entity.ViolationInvalidReference, Message: "参照できるコンテンツタイプはblog_postです: product"},
+			{Field: "blocks[2].data.referenced_content_id", Code: entity.ViolationInvalidReference, Message: "参照先のコンテンツが存在しません: " + missingID.String()},
+			{Field: "blocks[3].block_type", Code: entity.ViolationNotAllowed, Message: "このコンテンツタイプでは使えないブロックの種類です: video"},
+			{Field: "blocks", Code: entity.ViolationRequired, Message: "アイキャッチのブロックが必要です"},
+		}, violations)
+	})
+}
+
+// UpdateContentのテスト
+func (s *contentsUsecaseTestSuite) TestUpdateContent() {
+	existing := randomContent(rand.Int64N(1 << 32))
+	testCases := []struct {
+		name          string
+		setup         func(input *entity.Content)
+		expectedError error
+	}{
+		{
+			name: "正常系：既存の作成日時を維持して更新できる場合",
+			setup: func(input *entity.Content) {
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil).Once()
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), input.ContentTypeID).
+					Return(&entity.ContentType{ID: input.ContentTypeID}, nil)
+				s.mockRepository.EXPECT().UpdateContent(context.Background(), input).
+					RunAndReturn(func(_ context.Context, c *entity.Content) error {
+						assert.Equal(s.T(), existing.ID, c.ID)
+						assert.Equal(s.T(), existing.CreatedAt, c.CreatedAt)
+						assert.Equal(s.T(), existing.Status, c.Status)
+						return nil
+					})
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(input, nil).Once()
+			},
+		},
+		{
+			name: "異常系：更新対象が存在しない場合",
+			setup: func(input *entity.Content) {
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).
+					Return(nil, fmt.Errorf("%w: %s", entity.ErrContentNotFound, existing.ID))
+			},
+			expectedError: entity.ErrContentNotFound,
+		},
+		{
+			name: "異常系：バージョンが指定されていない場合",
+			setup: func(input *entity.Content) {
+				input.Version = 0
+			},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name: "異常系：他の更新と競合した場合",
+			setup: func(input *entity.Content) {
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), input.ContentTypeID).
+					Return(&entity.ContentType{ID: input.ContentTypeID}, nil)
+				s.mockRepository.EXPECT().UpdateContent(context.Background(), input).
+					Return(fmt.Errorf("%w: %s", entity.ErrVersionConflict, existing.ID))
+			},
+			expectedError: entity.ErrVersionConflict,
+		},
+		{
+			name: "正常系：ステータスの変更に合わせて公開日時が設定される場合",
+			setup: func(input *entity.Content) {
+				input.Status = entity.ContentStatusPublished
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil).Once()
+				s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), input.ContentTypeID).
+					Return(&entity.ContentType{ID: input.ContentTypeID}, nil)
+				s.mockRepository.EXPECT().UpdateContent(context.Background(), input).
+					RunAndReturn(func(_ context.Context, c *entity.Content) error {
+						assert.Equal(s.T(), entity.ContentStatusPublished, c.Status)
+						assert.NotNil(s.T(), c.PublishedAt)
+						return nil
+					})
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(input, nil).Once()
+			},
+		},
+		{
+			name: "異常系：許可されていないステータスへ変更する場合",
+			setup: func(input *entity.Content) {
+				input.Status = entity.ContentStatusArchived
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+			},
+			expectedError: entity.ErrInvalidStatusTransition,
+		},
+		{
+			name: "異常系：ゴミ箱へ移動する場合は参照元を確認する操作を使う必要がある場合",
+			setup: func(input *entity.Content) {
+				input.Status = entity.ContentStatusTrash
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+			},
+			expectedError: entity.ErrInvalidStatusTransition,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			input := &entity.Content{
+				ContentTypeID: existing.ContentTypeID,
+				Title:         "更新後タイトル",
+				Slug:          existing.Slug,
+				AuthorID:      existing.AuthorID,
+				Version:       existing.Version,
+			}
+			tc.setup(input)
+
+			result, err := s.usecase.UpdateContent(context.Background(), existing.ID, input)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(s.T(), err, tc.expectedError)
+				assert.Nil(s.T(), result)
+				return
+			}
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), "更新後タイトル", result.Title)
+		})
+	}
+}
+
+// TransitionContentのテスト
+func (s *contentsUsecaseTestSuite) TestTransitionContent() {
+	content := randomContent(rand.Int64N(1 << 32))
+	testCases := []struct {
+		name          string
+		action        entity.ContentAction
+		version       int
+		policy        entity.ReferrerPolicy
+		setup         func()
+		expectedError error
+	}{
+		{
+			name:    "正常系：下書きを公開できる場合",
+			action:  entity.ContentActionPublish,
+			version: 1,
+			setup: func() {
+				draft := *content
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), content.ID).Return(&draft, nil).Once()
+				s.mockRepository.EXPECT().UpdateContentStatus(context.Background(), mock.MatchedBy(func(c *entity.Content) bool {
+					return c.Status == entity.ContentStatusPublished && c.PublishedAt != nil && c.Version == 1
+				})).Return(nil)
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), content.ID).Return(content, nil).Once()
+			},
+		},
+		{
+			name:    "正常系：参照元の参照ブロックを削除してアーカイブする場合",
+			action:  entity.ContentActionArchive,
+			version: 2,
+			policy:  entity.ReferrerPolicyRemove,
+			setup: func() {
+				published := *content
+				published.Status = entity.ContentStatusPublished
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), content.ID).Return(&published, nil).Once()
+				s.mockRepository.EXPECT().UpdateReferencedContentStatus(context.Background(), mock.MatchedBy(func(c *entity.Content) bool {
+					return c.Status == entity.ContentStatusArchived && c.Version == 2
+				}), entity.ReferrerPolicyRemove).Return(nil)
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), content.ID).Return(content, nil).Once()
+			},
+		},
+		{
+			name:    "異常系：参照元がある場合はゴミ箱へ移動しない場合",
+			action:  entity.ContentActionTrash,
+			version: 1,
+			setup: func() {
+				draft := *content
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), content.ID).Return(&draft, nil)
+				s.mockRepository.EXPECT().UpdateReferencedContentStatus(context.Background(), mock.Anything, entity.ReferrerPolicyRestrict).
+					Return(&entity.ReferencedError{Referrers: []entity.Referrer{{ContentID: uuid.New()}}})
+			},
+			expectedError: entity.ErrContentReferenced,
+		},
+		{
+			name:          "異常系：アーカイブ・ゴミ箱への移動以外でforceを指定した場合",
+			action:        entity.ContentActionPublish,
+			version:       1,
+			policy:        entity.ReferrerPolicyDetach,
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:    "異常系：下書きをアーカイブする場合",
+			action:  entity.ContentActionArchive,
+			version: 1,
+			setup: func() {
+				draft := *content
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), content.ID).Return(&draft, nil)
+			},
+			expectedError: entity.ErrInvalidStatusTransition,
+		},
+		{
+			name:          "異常系：未定義の操作の場合",
+			action:        "delete",
+			version:       1,
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+		{
+			name:          "異常系：バージョンが指定されていない場合",
+			action:        entity.ContentActionPublish,
+			setup:         func() {},
+			expectedError: entity.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := s.usecase.TransitionContent(context.Background(), content.ID, tc.action, tc.version, tc.policy)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(s.T(), err, tc.expectedError)
+				assert.Nil(s.T(), result)
+				return
+			}
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), content, result)
+		})
+	}
+}
+
+// PatchContentのテスト
+func (s *contentsUsecaseTestSuite) TestPatchContent() {
+	s.Run("正常系：指定したフィールドのみ更新される場合", func() {
+		existing := randomContent(rand.Int64N(1 << 32))
+		originalSlug := existing.Slug
+		title := "部分更新タイトル"
+
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), existing.ContentTypeID).
+			Return(&entity.ContentType{ID: existing.ContentTypeID}, nil)
+		s.mockRepository.EXPECT().UpdateContent(context.Background(), existing).Return(nil)
+
+		result, err := s.usecase.PatchContent(context.Background(), existing.ID, PatchContentInput{Version: &existing.Version, Title: &title})
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), title, result.Title)
+		assert.Equal(s.T(), originalSlug, result.Slug)
+	})
+
+	s.Run("正常系：ブロック一覧を指定した場合は置き換えて並び順を振り直す場合", func() {
+		existing := randomContent(rand.Int64N(1 << 32))
+		existing.Blocks = []entity.ContentBlock{{ID: uuid.New(), BlockType: entity.BlockTypeText, BlockOrder: 1}}
+		blocks := []entity.ContentBlock{
+			{ID: uuid.New(), BlockType: entity.BlockTypeImage, BlockOrder: 9},
+			{BlockType: entity.BlockTypeText, BlockOrder: 3},
+		}
+
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), existing.ContentTypeID).
+			Return(&entity.ContentType{ID: existing.ContentTypeID}, nil)
+		s.mockRepository.EXPECT().UpdateContent(context.Background(), existing).
+			RunAndReturn(func(_ context.Context, c *entity.Content) error {
+				assert.Len(s.T(), c.Blocks, 2)
+				assert.Equal(s.T(), entity.BlockTypeImage, c.Blocks[0].BlockType)
+				assert.Equal(s.T(), 1, c.Blocks[0].BlockOrder)
+				assert.Equal(s.T(), 2, c.Blocks[1].BlockOrder)
+				assert.Equal(s.T(), existing.ID, c.Blocks[1].ContentID)
+				return nil
+			})
+
+		_, err := s.usecase.PatchContent(context.Background(), existing.ID, PatchContentInput{Version: &existing.Version, Blocks: &blocks})
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("正常系：ゼロ値のカテゴリIDでカテゴリを解除し、タグを置き換える場合", func() {
+		existing := randomContent(rand.Int64N(1 << 32))
+		categoryID := uuid.New()
+		existing.CategoryID = &categoryID
+		existing.TagIDs = []uuid.UUID{uuid.New()}
+		tagIDs := []uuid.UUID{uuid.New(), uuid.New()}
+		clear := uuid.Nil
+
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), existing.ContentTypeID).
+			Return(&entity.ContentType{ID: existing.ContentTypeID}, nil)
+		s.mockRepository.EXPECT().UpdateContent(context.Background(), existing).
+			RunAndReturn(func(_ context.Context, c *entity.Content) error {
+				assert.Nil(s.T(), c.CategoryID)
+				assert.Equal(s.T(), tagIDs, c.TagIDs)
+				return nil
+			})
+
+		_, err := s.usecase.PatchContent(context.Background(), existing.ID,
+			PatchContentInput{Version: &existing.Version, CategoryID: &clear, TagIDs: &tagIDs})
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("異常系：アーカイブする場合は参照元を確認する操作を使う必要がある場合", func() {
+		existing := randomContent(rand.Int64N(1 << 32))
+		publishedAt := s.now.Add(-time.Hour)
+		existing.Status = entity.ContentStatusPublished
+		existing.PublishedAt = &publishedAt
+		status := entity.ContentStatusArchived
+
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+
+		result, err := s.usecase.PatchContent(context.Background(), existing.ID, PatchContentInput{Version: &existing.Version, Status: &status})
+
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidStatusTransition)
+		assert.Nil(s.T(), result)
+	})
+
+	s.Run("異常系：空のタイトルで更新しようとした場合", func() {
+		existing := randomContent(rand.Int64N(1 << 32))
+		title := ""
+
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+
+		result, err := s.usecase.PatchContent(context.Background(), existing.ID, PatchContentInput{Version: &existing.Version, Title: &title})
+
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.Nil(s.T(), result)
+	})
+
+	s.Run("異常系：バージョンが指定されていない場合", func() {
+		title := "部分更新タイトル"
+
+		result, err := s.usecase.PatchContent(context.Background(), uuid.New(), PatchContentInput{Title: &title})
+
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.Nil(s.T(), result)
+	})
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// GetContentTypes はコンテンツタイプ一覧を表示名順に取得します（includeInactiveがtrueの場合は無効なものも含む）
+func (u *contentUsecase) GetContentTypes(ctx context.Context, includeInactive bool) ([]*entity.ContentType, error) {
+	return u.contentRepository.GetContentTypes(ctx, includeInactive)
+}
+
+// GetContentTypeByID はIDで有効なコンテンツタイプを取得します
+func (u *contentUsecase) GetContentTypeByID(ctx context.Context, id uuid.UUID) (*entity.ContentType, error) {
+	return u.contentRepository.GetContentTypeByID(ctx, id)
+}
+
+// CreateContentType はコンテンツタイプを有効な状態で作成し、保存後の状態を返します（送信されたIDは無視する）
+func (u *contentUsecase) CreateContentType(ctx context.Context, contentType *entity.ContentType) (*entity.ContentType, error) {
+	contentType.ID = uuid.Nil
+	contentType.IsActive = true
+	if err := contentType.ValidateName(); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+	if err := contentType.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+
+	if err := u.contentRepository.CreateContentType(ctx, contentType); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentTypeByID(ctx, contentType.ID)
+}
+
+// UpdateContentType はコンテンツタイプの表示名・説明・アイコン・フィールドの定義を更新し、保存後の状態を返します
+// 名前・作成者は変更できないため、送信された値は無視します
+// 既存のコンテンツが沿わなくなるフィールドの定義の変更はできません（MigrateContentTypeで移行する）
+func (u *contentUsecase) UpdateContentType(ctx context.Context, id uuid.UUID, contentType *entity.ContentType) (*entity.ContentType, error) {
+	existing, err := u.contentRepository.GetContentTypeByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType.ID = id
+	contentType.Name = existing.Name
+	contentType.CreatedBy = existing.CreatedBy
+	contentType.IsActive = existing.IsActive
+	if err := contentType.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+	if err := u.checkFieldSchemaChange(ctx, existing, contentType.FieldSchema); err != nil {
+		return nil, err
+	}
+
+	if err := u.contentRepository.UpdateContentType(ctx, contentType); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentTypeByID(ctx, id)
+}
+
+// DeactivateContentType はコンテンツタイプを無効化します（コンテンツは削除しない）
+func (u *contentUsecase) DeactivateContentType(ctx context.Context, id uuid.UUID) error {
+	return u.contentRepository.SetContentTypeActive(ctx, id, false)
+}
+
+// ActivateContentType は無効化したコンテンツタイプを再び有効化し、有効化後の状態を返します
+func (u *contentUsecase) ActivateContentType(ctx context.Context, id uuid.UUID) (*entity.ContentType, error) {
+	if err := u.contentRepository.SetContentTypeActive(ctx, id, true); err != nil {
+		return nil, err
+	}
+	return u.contentRepository.GetContentTypeByID(ctx, id)
+}
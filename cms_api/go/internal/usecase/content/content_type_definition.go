@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ContentTypeImporter は定義ファイルから作成したコンテンツタイプの作成者
+const ContentTypeImporter = "import"
+
+// ContentTypeImportReport は定義ファイルの取り込みの結果（ドライランの場合は取り込んだ場合の見込み）
+// 各項目は定義ファイルの記載順のコンテンツタイプ名
+type ContentTypeImportReport struct {
+	DryRun    bool     `json:"dry_run"`
+	Created   []string `json:"created"`
+	Updated   []string `json:"updated"`
+	Unchanged []string `json:"unchanged"`
+}
+
+// ExportContentTypes は無効なものを含むすべてのコンテンツタイプの定義を名前順に取得します
+func (u *contentUsecase) ExportContentTypes(ctx context.Context) (*entity.ContentTypeDefinitions, error) {
+	contentTypes, err := u.contentRepository.GetContentTypes(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// 差分を追いやすいよう、表示名ではなく変わらない名前の順にする
+	sort.Slice(contentTypes, func(i, j int) bool {
+		return contentTypes[i].Name < contentTypes[j].Name
+	})
+
+	definitions := &entity.ContentTypeDefinitions{ContentTypes: make([]entity.ContentTypeDefinition, len(contentTypes))}
+	for i, contentType := range contentTypes {
+		definitions.ContentTypes[i] = entity.NewContentTypeDefinition(contentType)
+	}
+	return definitions, nil
+}
+
+// ImportContentTypes は定義ファイルのコンテンツタイプを名前で対応付けて作成・更新します
+// 定義と一致するコンテンツタイプは変更しないため、同じ定義を何度取り込んでも結果は変わりません
+// 定義ファイルにないコンテンツタイプは変更しません。dryRunがtrueの場合は何も変更せず、結果の見込みのみを返します
+// 既存のコンテンツが沿わなくなるフィールドの定義の変更を含む場合は、何も取り込みません
+func (u *contentUsecase) ImportContentTypes(ctx context.Context, definitions *entity.ContentTypeDefinitions, dryRun bool) (*ContentTypeImportReport, error) {
+	// 途中で失敗して一部だけ取り込まれないよう、変更する前にすべての定義を検証する
+	if err := definitions.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+
+	contentTypes, err := u.contentRepository.GetContentTypes(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*entity.ContentType, len(contentTypes))
+	for _, contentType := range contentTypes {
+		existing[contentType.Name] = contentType
+	}
+	for i := range definitions.ContentTypes {
+		definition := &definitions.ContentTypes[i]
+		current, ok := existing[definition.Name]
+		if !ok {
+			continue
+		}
+		if err := u.checkFieldSchemaChange(ctx, current, definition.FieldSchema); err != nil {
+			return nil, fmt.Errorf("コンテンツタイプ%sの取り込みに失敗しました: %w", definition.Name, err)
+		}
+	}
+
+	report := &ContentTypeImportReport{DryRun: dryRun, Created: []string{}, Updated: []string{}, Unchanged: []string{}}
+	for i := range definitions.ContentTypes {
+		definition := &definitions.ContentTypes[i]
+		current, ok := existing[definition.Name]
+		switch {
+		case !ok:
+			report.Created = append(report.Created, definition.Name)
+			if !dryRun {
+				err = u.createFromDefinition(ctx, definition)
+			}
+		case !definition.SameAttributes(current) || definition.Active() != current.IsActive:
+			report.Updated = append(report.Updated, definition.Name)
+			if !dryRun {
+				err = u.updateFromDefinition(ctx, current, definition)
+			}
+		default:
+			report.Unchanged = append(report.Unchanged, definition.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("コンテンツタイプ%sの取り込みに失敗しました: %w", definition.Name, err)
+		}
+	}
+	return report, nil
+}
+
+// createFromDefinition は定義からコンテンツタイプを作成します（無効な定義の場合は作成後に無効化する）
+func (u *contentUsecase) createFromDefinition(ctx context.Context, definition *entity.ContentTypeDefinition) error {
+	contentType := definition.ContentType()
+	contentType.IsActive = true
+	contentType.CreatedBy = ContentTypeImporter
+	if err := u.contentRepository.CreateContentType(ctx, contentType); err != nil {
+		return err
+	}
+	if definition.Active() {
+		return nil
+	}
+	return u.contentRepository.SetContentTypeActive(ctx, contentType.ID, false)
+}
+
+// updateFromDefinition は既存のコンテンツタイプを定義に合わせて更新します
+// 無効なコンテンツタイプは更新できないため、更新する間だけ有効化します
+func (u *contentUsecase) updateFromDefinition(ctx context.Context, current *entity.ContentType, definition *entity.ContentTypeDefinition) error {
+	active := current.IsActive
+	if !definition.SameAttributes(current) {
+		if !active {
+			if err := u.contentRepository.SetContentTypeActive(ctx, current.ID, true); err != nil {
+				return err
+			}
+			active = true
+		}
+
+		contentType := definition.ContentType()
+		contentType.ID = current.ID
+		contentType.CreatedBy = current.CreatedBy
+		if err := u.contentRepository.UpdateContentType(ctx, contentType); err != nil {
+			return err
+		}
+	}
+
+	if active == definition.Active() {
+		return nil
+	}
+	return u.contentRepository.SetContentTypeActive(ctx, current.ID, definition.Active())
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ExportContentTypesのテスト
+func (s *contentsUsecaseTestSuite) TestExportContentTypes() {
+	s.Run("正常系：無効なものを含めて名前順に出力する場合", func() {
+		s.mockRepository.EXPECT().GetContentTypes(context.Background(), true).Return([]*entity.ContentType{
+			{ID: uuid.New(), Name: "page", DisplayName: "固定ページ", IsActive: true, CreatedBy: "admin"},
+			{ID: uuid.New(), Name: "blog_post", DisplayName: "ブログ記事", IsActive: false, CreatedBy: "admin"},
+		}, nil)
+
+		result, err := s.usecase.ExportContentTypes(context.Background())
+		assert.NoError(s.T(), err)
+		if assert.Len(s.T(), result.ContentTypes, 2) {
+			assert.Equal(s.T(), "blog_post", result.ContentTypes[0].Name)
+			assert.False(s.T(), result.ContentTypes[0].Active())
+			assert.Equal(s.T(), "page", result.ContentTypes[1].Name)
+			assert.True(s.T(), result.ContentTypes[1].Active())
+		}
+	})
+}
+
+// ImportContentTypesのテスト
+func (s *contentsUsecaseTestSuite) TestImportContentTypes() {
+	inactive := false
+	active := true
+	blogID, pageID, eventID := uuid.New(), uuid.New(), uuid.New()
+	existing := func() []*entity.ContentType {
+		return []*entity.ContentType{
+			{ID: blogID, Name: "blog_post", DisplayName: "ブログ記事", IsActive: true, CreatedBy: "admin"},
+			{ID: pageID, Name: "page", DisplayName: "固定ページ", IsActive: true, CreatedBy: "admin"},
+			{ID: eventID, Name: "event", DisplayName: "イベント", IsActive: false, CreatedBy: "admin"},
+		}
+	}
+	definitions := func() *entity.ContentTypeDefinitions {
+		return &entity.ContentTypeDefinitions{ContentTypes: []entity.ContentTypeDefinition{
+			{Name: "news", DisplayName: "お知らせ"},
+			{Name: "blog_post", DisplayName: "ブログ記事", FieldSchema: entity.FieldSchema{
+				Fields: []entity.FieldDefinition{{BlockType: entity.BlockTypeRichText, Required: true}},
+			}},
+			{Name: "page", DisplayName: "固定ページ", IsActive: &active},
+			{Name: "event", DisplayName: "イベント", IsActive: &inactive},
+		}}
+	}
+
+	s.Run("正常系：ない定義は作成し、異なる定義は更新し、一致する定義は変更しない場合", func() {
+		s.mockRepository.EXPECT().GetContentTypes(context.Background(), true).Return(existing(), nil)
+		s.mockRepository.EXPECT().GetContentsByContentType(context.Background(), blogID).Return([]*entity.Content{}, nil)
+		s.mockRepository.EXPECT().CreateContentType(context.Background(), mock.MatchedBy(func(ct *entity.ContentType) bool {
+			return ct.Name == "news" && ct.IsActive && ct.CreatedBy == ContentTypeImporter
+		})).Return(nil)
+		s.mockRepository.EXPECT().UpdateContentType(context.Background(), mock.MatchedBy(func(ct *entity.ContentType) bool {
+			return ct.ID == blogID && ct.CreatedBy == "admin" && len(ct.FieldSchema.Fields) == 1
+		})).Return(nil)
+
+		result, err := s.usecase.ImportContentTypes(context.Background(), definitions(), false)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), &ContentTypeImportReport{
+			Created:   []string{"news"},
+			Updated:   []string{"blog_post"},
+			Unchanged: []string{"page", "event"},
+		}, result)
+	})
+
+	s.Run("正常系：ドライランの場合は変更しない場合", func() {
+		s.mockRepository.EXPECT().GetContentTypes(context.Background(), true).Return(existing(), nil)
+		s.mockRepository.EXPECT().GetContentsByContentType(context.Background(), blogID).Return([]*entity.Content{}, nil)
+
+		result, err := s.usecase.ImportContentTypes(context.Background(), definitions(), true)
+		assert.NoError(s.T(), err)
+		assert.True(s.T(), result.DryRun)
+		assert.Equal(s.T(), []string{"news"}, result.Created)
+		assert.Equal(s.T(), []string{"blog_post"}, result.Updated)
+	})
+
+	s.Run("正常系：無効な定義を作成する場合は作成後に無効化する場合", func() {
+		createdID := uuid.New()
+		s.mockRepository.EXPECT().GetContentTypes(context.Background(), true).Return(existing(), nil)
+		s.mockRepository.EXPECT().CreateContentType(context.Background(), mock.Anything).
+			RunAndReturn(func(_ context.Context, ct *entity.ContentType) error {
+				ct.ID = createdID
+				return nil
+			})
+		s.mockRepository.EXPECT().SetContentTypeActive(context.Background(), createdID, false).Return(nil)
+
+		input := &entity.ContentTypeDefinitions{ContentTypes: []entity.ContentTypeDefinition{
+			{Name: "news", DisplayName: "お知らせ", IsActive: &inactive},
+		}}
+		result, err := s.usecase.ImportContentTypes(context.Background(), input, false)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{"news"}, result.Created)
+	})
+
+	s.Run("正常系：無効なコンテンツタイプは更新する間だけ有効化する場合", func() {
+		s.mockRepository.EXPECT().GetContentTypes(context.Background(), true).Return(existing(), nil)
+		s.mockRepository.EXPECT().SetContentTypeActive(context.Background(), eventID, true).Return(nil).Once()
+		s.mockRepository.EXPECT().UpdateContentType(context.Background(), mock.MatchedBy(func(ct *entity.ContentType) bool {
+			return ct.ID == eventID && ct.DisplayName == "イベント情報"
+		})).Return(nil)
+		s.mockRepository.EXPECT().SetContentTypeActive(context.Background(), eventID, false).Return(nil).Once()
+
+		input := &entity.ContentTypeDefinitions{ContentTypes: []entity.ContentTypeDefinition{
+			{Name: "event", DisplayName: "イベント情報", IsActive: &inactive},
+		}}
+		result, err := s.usecase.ImportContentTypes(context.Background(), input, false)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{"event"}, result.Updated)
+	})
+
+	s.Run("正常系：有効かどうかのみが異なる場合は有効・無効のみを変更する場合", func() {
+		s.mockRepository.EXPECT().GetContentTypes(context.Background(), true).Return(existing(), nil)
+		s.mockRepository.EXPECT().SetContentTypeActive(context.Background(), eventID, true).Return(nil)
+
+		input := &entity.ContentTypeDefinitions{ContentTypes: []entity.ContentTypeDefinition{
+			{Name: "event", DisplayName: "イベント"},
+		}}
+		result, err := s.usecase.ImportContentTypes(context.Background(), input, false)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{"event"}, result.Updated)
+	})
+
+	s.Run("異常系：定義が不正な場合は何も変更しない場合", func() {
+		input := definitions()
+		input.ContentTypes = append(input.ContentTypes, entity.ContentTypeDefinition{Name: "news", DisplayName: "ニュース"})
+
+		result, err := s.usecase.ImportContentTypes(context.Background(), input, false)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.Nil(s.T(), result)
+	})
+
+	s.Run("異常系：既存のコンテンツが変更後のフィールドの定義に沿わない場合は何も変更しない場合", func() {
+		s.mockRepository.EXPECT().GetContentTypes(context.Background(), true).Return(existing(), nil)
+		s.mockRepository.EXPECT().GetContentsByContentType(context.Background(), blogID).Return([]*entity.Content{
+			{ID: uuid.New(), Title: "本文のない記事"},
+		}, nil)
+
+		result, err := s.usecase.ImportContentTypes(context.Background(), definitions(), false)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.ErrorContains(s.T(), err, "blog_post")
+		assert.Nil(s.T(), result)
+	})
+
+	s.Run("異常系：更新に失敗した場合はコンテンツタイプ名を含めて返す場合", func() {
+		updateErr := errors.New("db error")
+		s.mockRepository.EXPECT().GetContentTypes(context.Background(), true).Return(existing(), nil)
+		s.mockRepository.EXPECT().UpdateContentType(context.Background(), mock.Anything).Return(updateErr)
+
+		input := &entity.ContentTypeDefinitions{ContentTypes: []entity.ContentTypeDefinition{
+			{Name: "page", DisplayName: "ページ"},
+		}}
+		result, err := s.usecase.ImportContentTypes(context.Background(), input, false)
+		assert.ErrorIs(s.T(), err, updateErr)
+		assert.ErrorContains(s.T(), err, "page")
+		assert.Nil(s.T(), result)
+	})
+}
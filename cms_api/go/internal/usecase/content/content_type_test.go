@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// CreateContentTypeのテスト
+func (s *contentsUsecaseTestSuite) TestCreateContentType() {
+	newInput := func() *entity.ContentType {
+		return &entity.ContentType{
+			ID:          uuid.New(),
+			Name:        "event",
+			DisplayName: "イベント",
+			CreatedBy:   "admin",
+			FieldSchema: entity.FieldSchema{Fields: []entity.FieldDefinition{
+				{BlockType: entity.BlockTypeText, Label: "概要", Required: true},
+			}},
+		}
+	}
+
+	s.Run("正常系：送信されたIDを無視して有効な状態で作成する場合", func() {
+		input := newInput()
+		createdID := uuid.New()
+		s.mockRepository.EXPECT().CreateContentType(context.Background(), mock.MatchedBy(func(ct *entity.ContentType) bool {
+			return ct.ID == uuid.Nil && ct.IsActive
+		})).RunAndReturn(func(_ context.Context, ct *entity.ContentType) error {
+			ct.ID = createdID
+			return nil
+		})
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), createdID).
+			Return(&entity.ContentType{ID: createdID, Name: "event"}, nil)
+
+		result, err := s.usecase.CreateContentType(context.Background(), input)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), createdID, result.ID)
+	})
+
+	s.Run("異常系：名前の形式が不正な場合", func() {
+		input := newInput()
+		input.Name = "Event Page"
+
+		result, err := s.usecase.CreateContentType(context.Background(), input)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.Nil(s.T(), result)
+	})
+
+	s.Run("異常系：フィールドの定義が不正な場合", func() {
+		input := newInput()
+		input.FieldSchema.Fields = append(input.FieldSchema.Fields, entity.FieldDefinition{BlockType: entity.BlockTypeText})
+
+		result, err := s.usecase.CreateContentType(context.Background(), input)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.Nil(s.T(), result)
+	})
+}
+
+// UpdateContentTypeのテスト
+func (s *contentsUsecaseTestSuite) TestUpdateContentType() {
+	contentTypeID := uuid.New()
+	existing := &entity.ContentType{ID: contentTypeID, Name: "blog_post", DisplayName: "ブログ記事", CreatedBy: "admin", IsActive: true}
+
+	s.Run("正常系：名前と作成者は変更せずに更新する場合", func() {
+		input := &entity.ContentType{
+			Name:        "renamed",
+			DisplayName: "記事",
+			CreatedBy:   "someone",
+			FieldSchema: entity.FieldSchema{Fields: []entity.FieldDefinition{{BlockType: entity.BlockTypeImage, MaxCount: 3}}},
+		}
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).Return(existing, nil).Once()
+		s.mockRepository.EXPECT().GetContentsByContentType(context.Background(), contentTypeID).Return([]*entity.Content{
+			{ID: uuid.New(), Blocks: []entity.ContentBlock{{BlockType: entity.BlockTypeImage, IsVisible: true}}},
+		}, nil)
+		s.mockRepository.EXPECT().UpdateContentType(context.Background(), mock.MatchedBy(func(ct *entity.ContentType) bool {
+			return ct.ID == contentTypeID && ct.Name == "blog_post" && ct.CreatedBy == "admin" && ct.DisplayName == "記事"
+		})).Return(nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).
+			Return(&entity.ContentType{ID: contentTypeID, DisplayName: "記事"}, nil).Once()
+
+		result, err := s.usecase.UpdateContentType(context.Background(), contentTypeID, input)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), "記事", result.DisplayName)
+	})
+
+	s.Run("異常系：既存のコンテンツが変更後のフィールドの定義に沿わない場合は更新しない場合", func() {
+		input := &entity.ContentType{
+			DisplayName: "ブログ記事",
+			FieldSchema: entity.FieldSchema{Fields: []entity.FieldDefinition{{BlockType: entity.BlockTypeImage, MaxCount: 3}}},
+		}
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).Return(existing, nil)
+		s.mockRepository.EXPECT().GetContentsByContentType(context.Background(), contentTypeID).Return([]*entity.Content{
+			{ID: uuid.New(), Blocks: []entity.ContentBlock{{BlockType: entity.BlockTypeText, IsVisible: true}}},
+		}, nil)
+
+		result, err := s.usecase.UpdateContentType(context.Background(), contentTypeID, input)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.ErrorContains(s.T(), err, "migrations")
+		assert.Nil(s.T(), result)
+	})
+
+	s.Run("異常系：無効化されたか存在しない場合", func() {
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).
+			Return(nil, fmt.Errorf("%w: %s", entity.ErrContentTypeNotFound, contentTypeID))
+
+		result, err := s.usecase.UpdateContentType(context.Background(), contentTypeID, &entity.ContentType{DisplayName: "記事"})
+		assert.ErrorIs(s.T(), err, entity.ErrContentTypeNotFound)
+		assert.Nil(s.T(), result)
+	})
+}
+
+// ActivateContentTypeのテスト
+func (s *contentsUsecaseTestSuite) TestActivateContentType() {
+	s.Run("正常系：有効化後の状態を返す場合", func() {
+		contentTypeID := uuid.New()
+		s.mockRepository.EXPECT().SetContentTypeActive(context.Background(), contentTypeID, true).Return(nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).
+			Return(&entity.ContentType{ID: contentTypeID, IsActive: true}, nil)
+
+		result, err := s.usecase.ActivateContentType(context.Background(), contentTypeID)
+		assert.NoError(s.T(), err)
+		assert.True(s.T(), result.IsActive)
+	})
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/repository"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// contentCursor はキーセットページネーションのカーソルの内容
+// クライアントにはJSONをbase64url（パディングなし）でエンコードした不透明な文字列として渡す
+type contentCursor struct {
+	// Sortはカーソルを作成したときの並び順（異なる並び順では使えない）
+	Sort string `json:"s"`
+	// Valuesは基準となるコンテンツのソート対象の値（日時はRFC3339、NULLはnull）
+	Values   []*string `json:"v"`
+	ID       uuid.UUID `json:"id"`
+	Backward bool      `json:"b,omitempty"`
+}
+
+// encodeCursor はコンテンツの位置を表すカーソルを作成します
+// backwardがtrueの場合はそのコンテンツより前のページを取得するカーソルになります
+func encodeCursor(content *entity.Content, keys []repository.SortKey, backward bool) *string {
+	cursor := contentCursor{
+		Sort:     sortSignature(keys),
+		Values:   make([]*string, len(keys)),
+		ID:       content.ID,
+		Backward: backward,
+	}
+	for i, key := range keys {
+		cursor.Values[i] = sortValue(content, key.Field)
+	}
+
+	raw, _ := json.Marshal(cursor)
+	encoded := base64.RawURLEncoding.EncodeToString(raw)
+	return &encoded
+}
+
+// decodeCursor はカーソルを検証してリポジトリの基準位置に変換します
+// 形式が不正な場合や並び順が異なる場合はErrInvalidParameterを返します
+func decodeCursor(raw string, keys []repository.SortKey) (repository.Keyset, error) {
+	invalid := fmt.Errorf("%w: cursor=%s", entity.ErrInvalidParameter, raw)
+
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return repository.Keyset{}, invalid
+	}
+	var cursor contentCursor
+	if err := json.Unmarshal(decoded, &cursor); err != nil {
+		return repository.Keyset{}, invalid
+	}
+	if cursor.Sort != sortSignature(keys) || len(cursor.Values) != len(keys) || cursor.ID == uuid.Nil {
+		return repository.Keyset{}, fmt.Errorf("%w: カーソルが並び順と一致しません", entity.ErrInvalidParameter)
+	}
+
+	keyset := repository.Keyset{
+		Values:   make([]interface{}, len(keys)),
+		ID:       cursor.ID,
+		Backward: cursor.Backward,
+	}
+	for i, key := range keys {
+		value := cursor.Values[i]
+		switch {
+		case value == nil:
+		case key.Field == repository.SortFieldTitle:
+			keyset.Values[i] = *value
+		default:
+			t, err := time.Parse(time.RFC3339Nano, *value)
+			if err != nil {
+				return repository.Keyset{}, invalid
+			}
+			keyset.Values[i] = t
+		}
+	}
+	return keyset, nil
+}
+
+// sortSignature は並び順を文字列で表します（例: published_at:desc,id）
+func sortSignature(keys []repository.SortKey) string {
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = string(key.Field) + ":asc"
+		if key.Desc {
+			parts[i] = string(key.Field) + ":desc"
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// sortValue はコンテンツのソート対象の値を文字列で返します（NULLの場合はnil）
+func sortValue(content *entity.Content, field repository.SortField) *string {
+	var t *time.Time
+	switch field {
+	case repository.SortFieldTitle:
+		return &content.Title
+	case repository.SortFieldCreatedAt:
+		t = &content.CreatedAt
+	case repository.SortFieldUpdatedAt:
+		t = &content.UpdatedAt
+	case repository.SortFieldPublishedAt:
+		t = content.PublishedAt
+	case repository.SortFieldDeletedAt:
+		t = content.DeletedAt
+	}
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339Nano)
+	return &s
+}
+
+// setCursors は取得したページの先頭・末尾のコンテンツから前後のページのカーソルを設定します
+func setCursors(p *Pagination, contents []*entity.Content, keys []repository.SortKey) {
+	if len(contents) == 0 {
+		return
+	}
+	if p.HasPrev {
+		p.PrevCursor = encodeCursor(contents[0], keys, true)
+	}
+	if p.HasNext {
+		p.NextCursor = encodeCursor(contents[len(contents)-1], keys, false)
+	}
+}
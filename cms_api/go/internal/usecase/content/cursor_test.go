@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/repository"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// GetContentsのカーソル指定のテスト
+func (s *contentsUsecaseTestSuite) TestGetContentsByCursor() {
+	keys := []repository.SortKey{{Field: repository.SortFieldPublishedAt, Desc: true}, {Field: repository.SortFieldTitle}}
+	publishedAt := time.Date(2025, 1, 10, 9, 30, 0, 123456000, time.UTC)
+	base := &entity.Content{ID: uuid.New(), Title: "基準", PublishedAt: &publishedAt}
+	first := &entity.Content{ID: uuid.New(), Title: "先頭", CreatedAt: s.now}
+	last := &entity.Content{ID: uuid.New(), Title: "末尾", CreatedAt: s.now}
+
+	s.Run("正常系：次のページのカーソルで続きを取得する場合", func() {
+		cursor := encodeCursor(base, keys, false)
+		s.mockRepository.EXPECT().GetContentsByKeyset(context.Background(), 2,
+			repository.Keyset{Values: []interface{}{publishedAt, "基準"}, ID: base.ID},
+			mock.MatchedBy(func(f repository.ContentFilters) bool { return assert.ObjectsAreEqual(keys, f.Sort) })).
+			Return([]*entity.Content{first, last}, true, nil)
+
+		list, err := s.usecase.GetContents(context.Background(),
+			GetContentsInput{Limit: 2, Offset: 40, Sort: "publishedAt:desc,title:asc", Cursor: *cursor})
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []*entity.Content{first, last}, list.Contents)
+
+		// カーソル指定時は総件数を数えず、前後のページのカーソルを返す
+		p := list.Pagination
+		assert.Equal(s.T(), 0, p.CurrentPage)
+		assert.Equal(s.T(), int64(0), p.TotalCount)
+		assert.True(s.T(), p.HasPrev)
+		assert.True(s.T(), p.HasNext)
+		assert.Equal(s.T(), encodeCursor(first, keys, true), p.PrevCursor)
+		assert.Equal(s.T(), encodeCursor(last, keys, false), p.NextCursor)
+	})
+
+	s.Run("正常系：前のページのカーソルで先頭まで戻った場合は前のページがない", func() {
+		cursor := encodeCursor(first, keys, true)
+		s.mockRepository.EXPECT().GetContentsByKeyset(context.Background(), DefaultLimit,
+			repository.Keyset{Values: []interface{}{nil, "先頭"}, ID: first.ID, Backward: true}, mock.Anything).
+			Return([]*entity.Content{base}, false, nil)
+
+		list, err := s.usecase.GetContents(context.Background(),
+			GetContentsInput{Sort: "publishedAt:desc,title:asc", Cursor: *cursor})
+		assert.NoError(s.T(), err)
+		assert.False(s.T(), list.Pagination.HasPrev)
+		assert.Nil(s.T(), list.Pagination.PrevCursor)
+		assert.True(s.T(), list.Pagination.HasNext)
+	})
+
+	s.Run("正常系：カーソルの先にコンテンツがない場合は前後のページがない", func() {
+		cursor := encodeCursor(last, keys, false)
+		s.mockRepository.EXPECT().GetContentsByKeyset(context.Background(), DefaultLimit, mock.Anything, mock.Anything).
+			Return([]*entity.Content{}, false, nil)
+
+		list, err := s.usecase.GetContents(context.Background(),
+			GetContentsInput{Sort: "publishedAt:desc,title:asc", Cursor: *cursor})
+		assert.NoError(s.T(), err)
+		assert.False(s.T(), list.Pagination.HasPrev)
+		assert.False(s.T(), list.Pagination.HasNext)
+		assert.Nil(s.T(), list.Pagination.PrevCursor)
+		assert.Nil(s.T(), list.Pagination.NextCursor)
+	})
+
+	s.Run("異常系：カーソルの形式が不正な場合", func() {
+		_, err := s.usecase.GetContents(context.Background(), GetContentsInput{Cursor: "not-a-cursor"})
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+
+	s.Run("異常系：カーソルと並び順が一致しない場合", func() {
+		cursor := encodeCursor(base, keys, false)
+		_, err := s.usecase.GetContents(context.Background(), GetContentsInput{Sort: "title", Cursor: *cursor})
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+}
@@ -0,0 +1,486 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// maxTextEdits はテキスト差分で追跡する最大編集数（超えた場合は全体の置き換えとして扱う）
+const maxTextEdits = 2000
+
+// BlockChangeType はブロック差分の種類
+type BlockChangeType string
+
+const (
+	BlockChangeAdded    BlockChangeType = "added"
+	BlockChangeRemoved  BlockChangeType = "removed"
+	BlockChangeMoved    BlockChangeType = "moved"
+	BlockChangeModified BlockChangeType = "modified"
+)
+
+// TextOp はテキスト差分の操作の種類
+type TextOp string
+
+const (
+	TextOpEqual  TextOp = "equal"
+	TextOpInsert TextOp = "insert"
+	TextOpDelete TextOp = "delete"
+)
+
+// JSONOp はJSON差分の操作の種類
+type JSONOp string
+
+const (
+	JSONOpAdded   JSONOp = "added"
+	JSONOpRemoved JSONOp = "removed"
+	JSONOpChanged JSONOp = "changed"
+)
+
+// ContentDiff は2つの版の間の差分
+type ContentDiff struct {
+	ContentID uuid.UUID     `json:"contentId"`
+	From      int           `json:"from"`
+	To        int           `json:"to"`
+	Fields    []FieldChange `json:"fields"`
+	Blocks    []BlockChange `json:"blocks"`
+}
+
+// FieldChange は値が変更されたフィールド
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+// BlockChange はブロック単位の差分
+// 移動と内容の変更が同時に行われたブロックはmovedとmodifiedの2件として表す
+type BlockChange struct {
+	Type      BlockChangeType  `json:"type"`
+	BlockID   uuid.UUID        `json:"blockId"`
+	BlockType entity.BlockType `json:"blockType"`
+	FromOrder *int             `json:"fromOrder,omitempty"`
+	ToOrder   *int             `json:"toOrder,omitempty"`
+	Fields    []FieldChange    `json:"fields,omitempty"`
+	Text      []TextChange     `json:"text,omitempty"`
+	JSON      []JSONChange     `json:"json,omitempty"`
+}
+
+// TextChange はContentTextの差分の1区間
+type TextChange struct {
+	Op   TextOp `json:"op"`
+	Text string `json:"text"`
+}
+
+// JSONChange はContentRichtext・ContentJSON・SettingsのJSONパス単位の差分
+type JSONChange struct {
+	Field string      `json:"field"`
+	Path  string      `json:"path"`
+	Op    JSONOp      `json:"op"`
+	From  interface{} `json:"from,omitempty"`
+	To    interface{} `json:"to,omitempty"`
+}
+
+// DiffContentVersions はコンテンツの2つの版の差分を取得します
+func (u *contentUsecase) DiffContentVersions(ctx context.Context, contentID uuid.UUID, from, to int) (*ContentDiff, error) {
+	fromVersion, err := u.GetContentVersion(ctx, contentID, from)
+	if err != nil {
+		return nil, err
+	}
+	toVersion, err := u.GetContentVersion(ctx, contentID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContentDiff{
+		ContentID: contentID,
+		From:      from,
+		To:        to,
+		Fields:    diffContentFields(fromVersion.Snapshot, toVersion.Snapshot),
+		Blocks:    diffBlocks(fromVersion.Snapshot.Blocks, toVersion.Snapshot.Blocks),
+	}, nil
+}
+
+// diffContentFields はコンテンツのメタデータの差分を返します
+func diffContentFields(from, to *entity.Content) []FieldChange {
+	changes := []FieldChange{}
+	add := func(field string, a, b interface{}) {
+		if !reflect.DeepEqual(a, b) {
+			changes = append(changes, FieldChange{Field: field, From: a, To: b})
+		}
+	}
+
+	add("content_type_id", from.ContentTypeID, to.ContentTypeID)
+	add("title", from.Title, to.Title)
+	add("slug", from.Slug, to.Slug)
+	add("status", from.Status, to.Status)
+	add("published_at", timeValue(from), timeValue(to))
+	add("author_id", from.AuthorID, to.AuthorID)
+	add("category_id", from.CategoryID, to.CategoryID)
+	add("tag_ids", tagIDsValue(from), tagIDsValue(to))
+	return changes
+}
+
+// tagIDsValue はタグIDを比較可能な値に変換します（タグがない場合はnil）
+func tagIDsValue(content *entity.Content) interface{} {
+	if len(content.TagIDs) == 0 {
+		return nil
+	}
+	return content.TagIDs
+}
+
+// timeValue は公開日時を比較可能な値に変換します
+func timeValue(content *entity.Content) interface{} {
+	if content.PublishedAt == nil {
+		return nil
+	}
+	return content.PublishedAt.UTC()
+}
+
+// diffBlocks はブロックIDで突き合わせてブロックの追加・削除・移動・変更を返します
+func diffBlocks(from, to []entity.ContentBlock) []BlockChange {
+	changes := []BlockChange{}
+
+	fromByID := make(map[uuid.UUID]*entity.ContentBlock, len(from))
+	for i := range from {
+		fromByID[from[i].ID] = &from[i]
+	}
+	toIDs := make(map[uuid.UUID]bool, len(to))
+	for i := range to {
+		toIDs[to[i].ID] = true
+	}
+
+	for i := range from {
+		if !toIDs[from[i].ID] {
+			changes = append(changes, BlockChange{
+				Type:      BlockChangeRemoved,
+				BlockID:   from[i].ID,
+				BlockType: from[i].BlockType,
+				FromOrder: intPtr(from[i].BlockOrder),
+			})
+		}
+	}
+
+	// 両方の版に存在するブロックのうち、相対的な並びが維持されていないものを移動とみなす
+	var common []*entity.ContentBlock
+	for i := range to {
+		if _, ok := fromByID[to[i].ID]; ok {
+			common = append(common, &to[i])
+		}
+	}
+	stayed := stableBlocks(common, fromByID)
+
+	for i := range to {
+		block := &to[i]
+		previous, ok := fromByID[block.ID]
+		if !ok {
+			changes = append(changes, BlockChange{
+				Type:      BlockChangeAdded,
+				BlockID:   block.ID,
+				BlockType: block.BlockType,
+				ToOrder:   intPtr(block.BlockOrder),
+			})
+			continue
+		}
+
+		if !stayed[block.ID] {
+			changes = append(changes, BlockChange{
+				Type:      BlockChangeMoved,
+				BlockID:   block.ID,
+				BlockType: block.BlockType,
+				FromOrder: intPtr(previous.BlockOrder),
+				ToOrder:   intPtr(block.BlockOrder),
+			})
+		}
+
+		if modified, ok := diffBlock(previous, block); ok {
+			changes = append(changes, modified)
+		}
+	}
+
+	return changes
+}
+
+// stableBlocks は新しい版の並びのうち、古い版の並びと同じ順序を保つ最長のブロック集合を返します
+func stableBlocks(common []*entity.ContentBlock, fromByID map[uuid.UUID]*entity.ContentBlock) map[uuid.UUID]bool {
+	// 古い版での並び順の最長増加部分列を求める
+	n := len(common)
+	length := make([]int, n)
+	prev := make([]int, n)
+	best := -1
+	for i := 0; i < n; i++ {
+		length[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if fromByID[common[j].ID].BlockOrder < fromByID[common[i].ID].BlockOrder && length[j]+1 > length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+		if best < 0 || length[i] > length[best] {
+			best = i
+		}
+	}
+
+	stayed := make(map[uuid.UUID]bool, n)
+	for i := best; i >= 0; i = prev[i] {
+		stayed[common[i].ID] = true
+	}
+	return stayed
+}
+
+// diffBlock は同じIDのブロックの内容を比較し、変更がある場合はmodifiedの差分を返します
+func diffBlock(from, to *entity.ContentBlock) (BlockChange, bool) {
+	change := BlockChange{
+		Type:      BlockChangeModified,
+		BlockID:   to.ID,
+		BlockType: to.BlockType,
+		ToOrder:   intPtr(to.BlockOrder),
+	}
+	addField := func(field string, a, b interface{}) {
+		if !reflect.DeepEqual(a, b) {
+			change.Fields = append(change.Fields, FieldChange{Field: field, From: a, To: b})
+		}
+	}
+
+	addField("block_type", from.BlockType, to.BlockType)
+	addField("is_visible", from.IsVisible, to.IsVisible)
+
+	fromData, toData := from.Data, to.Data
+	if fromData == nil {
+		fromData = &entity.ContentBlockData{}
+	}
+	if toData == nil {
+		toData = &entity.ContentBlockData{}
+	}
+
+	addField("data_type", fromData.DataType, toData.DataType)
+	addField("content_number", numberValue(fromData), numberValue(toData))
+	addField("content_url", fromData.ContentURL, toData.ContentURL)
+	addField("referenced_content_id", fromData.ReferencedContentID, toData.ReferencedContentID)
+
+	if fromData.ContentText != toData.ContentText {
+		change.Text = diffText(fromData.ContentText, toData.ContentText)
+	}
+	change.JSON = append(change.JSON, diffRawJSON("content_richtext", fromData.ContentRichtext, toData.ContentRichtext)...)
+	change.JSON = append(change.JSON, diffRawJSON("content_json", fromData.ContentJSON, toData.ContentJSON)...)
+	change.JSON = append(change.JSON, diffRawJSON("settings", fromData.Settings, toData.Settings)...)
+
+	if len(change.Fields) == 0 && len(change.Text) == 0 && len(change.JSON) == 0 {
+		return BlockChange{}, false
+	}
+	return change, true
+}
+
+// numberValue は数値データを比較可能な文字列に変換します
+func numberValue(data *entity.ContentBlockData) interface{} {
+	if data.ContentNumber == nil {
+		return nil
+	}
+	return data.ContentNumber.String()
+}
+
+// diffText は文字単位の差分を、同じ操作が連続する区間にまとめて返します
+func diffText(from, to string) []TextChange {
+	a, b := []rune(from), []rune(to)
+	ops, ok := editScript(a, b, maxTextEdits)
+	if !ok {
+		// 差分が大きすぎる場合は全体の置き換えとして扱う
+		var changes []TextChange
+		if len(a) > 0 {
+			changes = append(changes, TextChange{Op: TextOpDelete, Text: from})
+		}
+		if len(b) > 0 {
+			changes = append(changes, TextChange{Op: TextOpInsert, Text: to})
+		}
+		return changes
+	}
+
+	var changes []TextChange
+	x, y := 0, 0
+	for _, op := range ops {
+		var r rune
+		switch op {
+		case TextOpEqual:
+			r = a[x]
+			x++
+			y++
+		case TextOpDelete:
+			r = a[x]
+			x++
+		case TextOpInsert:
+			r = b[y]
+			y++
+		}
+		if last := len(changes) - 1; last >= 0 && changes[last].Op == op {
+			changes[last].Text += string(r)
+			continue
+		}
+		changes = append(changes, TextChange{Op: op, Text: string(r)})
+	}
+	return changes
+}
+
+// editScript はMyersのアルゴリズムでaをbに変換する最短の編集操作列を求めます
+// 編集数がmaxEditsを超える場合はfalseを返します
+func editScript(a, b []rune, maxEdits int) ([]TextOp, bool) {
+	n, m := len(a), len(b)
+	limit := n + m
+	if limit > maxEdits {
+		limit = maxEdits
+	}
+
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	// trace[d]にはd回目の探索開始時点のvのうち、k = -d-1 .. d+1 の範囲を保存する
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackEdits(trace, n, m), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// backtrackEdits は探索の履歴から編集操作列を復元します
+func backtrackEdits(trace [][]int, n, m int) []TextOp {
+	var ops []TextOp
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			ops = append(ops, TextOpEqual)
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, TextOpInsert)
+			} else {
+				ops = append(ops, TextOpDelete)
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// diffRawJSON は2つのJSON値をJSONパス単位で比較します
+func diffRawJSON(field string, from, to json.RawMessage) []JSONChange {
+	a, errA := decodeJSON(from)
+	b, errB := decodeJSON(to)
+	if errA != nil || errB != nil {
+		// 解析できない値は文字列として比較する
+		if string(from) == string(to) {
+			return nil
+		}
+		return []JSONChange{{Field: field, Path: "$", Op: JSONOpChanged, From: string(from), To: string(to)}}
+	}
+
+	var changes []JSONChange
+	diffJSONValue(field, "$", a, b, &changes)
+	return changes
+}
+
+// decodeJSON はJSON値を解析します（空の場合はnull）
+func decodeJSON(raw json.RawMessage) (interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// diffJSONValue はJSON値を再帰的に比較し、差分をchangesに追加します
+func diffJSONValue(field, path string, from, to interface{}, changes *[]JSONChange) {
+	switch {
+	case from == nil && to == nil:
+		return
+	case from == nil:
+		*changes = append(*changes, JSONChange{Field: field, Path: path, Op: JSONOpAdded, To: to})
+		return
+	case to == nil:
+		*changes = append(*changes, JSONChange{Field: field, Path: path, Op: JSONOpRemoved, From: from})
+		return
+	}
+
+	switch a := from.(type) {
+	case map[string]interface{}:
+		if b, ok := to.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(a)+len(b))
+			for key := range a {
+				keys = append(keys, key)
+			}
+			for key := range b {
+				if _, ok := a[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				diffJSONValue(field, path+"."+key, a[key], b[key], changes)
+			}
+			return
+		}
+	case []interface{}:
+		if b, ok := to.([]interface{}); ok {
+			for i := 0; i < len(a) || i < len(b); i++ {
+				var av, bv interface{}
+				if i < len(a) {
+					av = a[i]
+				}
+				if i < len(b) {
+					bv = b[i]
+				}
+				diffJSONValue(field, path+"["+strconv.Itoa(i)+"]", av, bv, changes)
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, JSONChange{Field: field, Path: path, Op: JSONOpChanged, From: from, To: to})
+	}
+}
+
+// intPtr は整数値のポインタを返します
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected []TextChange
+	}{
+		{
+			name:     "正常系：末尾に追記された場合",
+			from:     "本日は晴天",
+			to:       "本日は晴天なり",
+			expected: []TextChange{{Op: TextOpEqual, Text: "本日は晴天"}, {Op: TextOpInsert, Text: "なり"}},
+		},
+		{
+			name: "正常系：途中が置き換えられた場合",
+			from: "明日は雨です",
+			to:   "明日は雪です",
+			expected: []TextChange{
+				{Op: TextOpEqual, Text: "明日は"},
+				{Op: TextOpDelete, Text: "雨"},
+				{Op: TextOpInsert, Text: "雪"},
+				{Op: TextOpEqual, Text: "です"},
+			},
+		},
+		{
+			name:     "正常系：空文字から作成された場合",
+			from:     "",
+			to:       "abc",
+			expected: []TextChange{{Op: TextOpInsert, Text: "abc"}},
+		},
+		{
+			name:     "正常系：すべて削除された場合",
+			from:     "abc",
+			to:       "",
+			expected: []TextChange{{Op: TextOpDelete, Text: "abc"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, diffText(tc.from, tc.to))
+		})
+	}
+}
+
+func TestEditScriptLimit(t *testing.T) {
+	_, ok := editScript([]rune("abcdef"), []rune("uvwxyz"), 4)
+	assert.False(t, ok)
+
+	ops, ok := editScript([]rune("abcdef"), []rune("abXdef"), 4)
+	assert.True(t, ok)
+	assert.Equal(t, []TextOp{TextOpEqual, TextOpEqual, TextOpDelete, TextOpInsert, TextOpEqual, TextOpEqual, TextOpEqual}, ops)
+}
+
+func TestDiffRawJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected []JSONChange
+	}{
+		{
+			name:     "正常系：同じ内容の場合",
+			from:     `{"a":1,"b":[1,2]}`,
+			to:       `{"b":[1,2],"a":1}`,
+			expected: nil,
+		},
+		{
+			name: "正常系：入れ子の値が変更・追加・削除された場合",
+			from: `{"type":"doc","content":[{"text":"旧"}],"old":true}`,
+			to:   `{"type":"doc","content":[{"text":"新"},{"text":"追加"}]}`,
+			expected: []JSONChange{
+				{Field: "content_richtext", Path: "$.content[0].text", Op: JSONOpChanged, From: "旧", To: "新"},
+				{Field: "content_richtext", Path: "$.content[1]", Op: JSONOpAdded, To: map[string]interface{}{"text": "追加"}},
+				{Field: "content_richtext", Path: "$.old", Op: JSONOpRemoved, From: true},
+			},
+		},
+		{
+			name: "正常系：値が設定された場合",
+			from: ``,
+			to:   `{"a":1}`,
+			expected: []JSONChange{
+				{Field: "content_richtext", Path: "$", Op: JSONOpAdded, To: map[string]interface{}{"a": float64(1)}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, diffRawJSON("content_richtext", json.RawMessage(tc.from), json.RawMessage(tc.to)))
+		})
+	}
+}
+
+func TestDiffBlocks(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	block := func(id uuid.UUID, order int, text string) entity.ContentBlock {
+		return entity.ContentBlock{
+			ID:         id,
+			BlockType:  entity.BlockTypeText,
+			BlockOrder: order,
+			IsVisible:  true,
+			Data:       &entity.ContentBlockData{DataType: entity.DataTypeText, ContentText: text},
+		}
+	}
+
+	from := []entity.ContentBlock{block(ids[0], 1, "a"), block(ids[1], 2, "b"), block(ids[2], 3, "c")}
+
+	t.Run("正常系：ブロックの挿入で後続の並び順がずれても移動とみなさない場合", func(t *testing.T) {
+		s := assert.New(t)
+		to := []entity.ContentBlock{block(ids[3], 1, "new"), block(ids[0], 2, "a"), block(ids[1], 3, "b"), block(ids[2], 4, "c")}
+		changes := diffBlocks(from, to)
+		s.Len(changes, 1)
+		s.Equal(BlockChangeAdded, changes[0].Type)
+		s.Equal(ids[3], changes[0].BlockID)
+	})
+
+	t.Run("正常系：削除・移動・変更が混在する場合", func(t *testing.T) {
+		s := assert.New(t)
+		withRemoved := append(append([]entity.ContentBlock{}, from...), block(ids[3], 4, "d"))
+		to := []entity.ContentBlock{block(ids[1], 1, "b"), block(ids[2], 2, "c"), block(ids[0], 3, "a!")}
+		changes := diffBlocks(withRemoved, to)
+		s.Len(changes, 3)
+		s.Equal(BlockChangeRemoved, changes[0].Type)
+		s.Equal(ids[3], changes[0].BlockID)
+		s.Equal(BlockChangeMoved, changes[1].Type)
+		s.Equal(ids[0], changes[1].BlockID)
+		s.Equal(1, *changes[1].FromOrder)
+		s.Equal(3, *changes[1].ToOrder)
+		s.Equal(BlockChangeModified, changes[2].Type)
+		s.Equal([]TextChange{{Op: TextOpEqual, Text: "a"}, {Op: TextOpInsert, Text: "!"}}, changes[2].Text)
+	})
+
+	t.Run("正常系：表示状態が変更された場合", func(t *testing.T) {
+		s := assert.New(t)
+		hidden := block(ids[0], 1, "a")
+		hidden.IsVisible = false
+		changes := diffBlocks(from[:1], []entity.ContentBlock{hidden})
+		s.Len(changes, 1)
+		s.Equal([]FieldChange{{Field: "is_visible", From: true, To: false}}, changes[0].Fields)
+	})
+}
+
+// DiffContentVersionsのテスト
+func (s *contentsUsecaseTestSuite) TestDiffContentVersions() {
+	contentID := uuid.New()
+	typeID := uuid.New()
+
+	s.Run("正常系：メタデータの差分を取得できる場合", func() {
+		s.mockRepository.EXPECT().GetContentVersion(context.Background(), contentID, 1).Return(&entity.ContentVersion{
+			Version:  1,
+			Snapshot: &entity.Content{ID: contentID, ContentTypeID: typeID, Title: "旧タイトル", Slug: "slug", AuthorID: "admin"},
+		}, nil)
+		s.mockRepository.EXPECT().GetContentVersion(context.Background(), contentID, 2).Return(&entity.ContentVersion{
+			Version:  2,
+			Snapshot: &entity.Content{ID: contentID, ContentTypeID: typeID, Title: "新タイトル", Slug: "slug", AuthorID: "admin"},
+		}, nil)
+
+		diff, err := s.usecase.DiffContentVersions(context.Background(), contentID, 1, 2)
+		s.NoError(err)
+		s.Equal([]FieldChange{{Field: "title", From: "旧タイトル", To: "新タイトル"}}, diff.Fields)
+		s.Empty(diff.Blocks)
+	})
+
+	s.Run("異常系：比較対象の版が存在しない場合", func() {
+		s.mockRepository.EXPECT().GetContentVersion(context.Background(), contentID, 1).
+			Return(nil, fmt.Errorf("%w: %s", entity.ErrContentVersionNotFound, contentID))
+
+		_, err := s.usecase.DiffContentVersions(context.Background(), contentID, 1, 2)
+		s.True(errors.Is(err, entity.ErrContentVersionNotFound))
+	})
+}
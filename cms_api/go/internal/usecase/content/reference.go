@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// MaxReferenceDepth は参照を展開できる最大の深さ
+const MaxReferenceDepth = 3
+
+// referenceNode は参照の展開中のコンテンツと、展開元（祖先）のコンテンツIDの経路
+type referenceNode struct {
+	content *entity.Content
+	path    []uuid.UUID
+}
+
+// ExpandReferences はコンテンツの参照ブロックの参照先をdepthの深さまで再帰的に展開し、ブロックデータのReferencedContentに設定します
+// 参照先は深さごとにまとめて取得します。展開元（祖先）のコンテンツへの参照は循環として展開せず、ReferenceCycleを設定します
+// visibleOnlyがtrue（公開向けの取得）の場合は、表示中のブロックの公開中の参照先のみを展開します
+// 参照先が存在しない・ゴミ箱にある・公開中でない場合は展開しません
+func (u *contentUsecase) ExpandReferences(ctx context.Context, content *entity.Content, depth int, visibleOnly bool) error {
+	if depth < 1 || depth > MaxReferenceDepth {
+		return fmt.Errorf("%w: depthは1から%dの範囲で指定してください: %d", entity.ErrInvalidParameter, MaxReferenceDepth, depth)
+	}
+
+	now := u.now()
+	fetched := make(map[uuid.UUID]*entity.Content)
+	level := []referenceNode{{content: content, path: []uuid.UUID{content.ID}}}
+	for d := 0; d < depth && len(level) > 0; d++ {
+		if err := u.fetchReferences(ctx, level, fetched, visibleOnly); err != nil {
+			return err
+		}
+
+		var next []referenceNode
+		for _, node := range level {
+			for _, data := range referenceData(node.content, visibleOnly) {
+				id := *data.ReferencedContentID
+				if slices.Contains(node.path, id) {
+					data.ReferenceCycle = true
+					continue
+				}
+				target, ok := fetched[id]
+				if !ok || (visibleOnly && !target.IsVisibleAt(now)) {
+					continue
+				}
+
+				// 同じコンテンツを複数の箇所から参照する場合も、展開は参照箇所ごとに独立させる
+				data.ReferencedContent = cloneForExpansion(target)
+				next = append(next, referenceNode{content: data.ReferencedContent, path: append(slices.Clone(node.path), id)})
+			}
+		}
+		level = next
+	}
+	return nil
+}
+
+// fetchReferences は展開中のコンテンツの参照先のうち、まだ取得していないものをまとめて取得します
+func (u *contentUsecase) fetchReferences(ctx context.Context, level []referenceNode, fetched map[uuid.UUID]*entity.Content, visibleOnly bool) error {
+	var ids []uuid.UUID
+	for _, node := range level {
+		for _, data := range referenceData(node.content, visibleOnly) {
+			id := *data.ReferencedContentID
+			if _, ok := fetched[id]; !ok && !slices.Contains(ids, id) && !slices.Contains(node.path, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	contents, err := u.contentRepository.GetContentsByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, content := range contents {
+		fetched[content.ID] = content
+	}
+	return nil
+}
+
+// referenceData は参照先が設定された参照ブロックのブロックデータを返します（visibleOnlyがtrueの場合は表示中のブロックのみ）
+func referenceData(content *entity.Content, visibleOnly bool) []*entity.ContentBlockData {
+	var result []*entity.ContentBlockData
+	for i := range content.Blocks {
+		block := &content.Blocks[i]
+		if block.BlockType != entity.BlockTypeReference || block.Data == nil || block.Data.ReferencedContentID == nil {
+			continue
+		}
+		if visibleOnly && !block.IsVisible {
+			continue
+		}
+		result = append(result, block.Data)
+	}
+	return result
+}
+
+// cloneForExpansion は参照先を展開するためにコンテンツとブロック・ブロックデータを複製します
+func cloneForExpansion(content *entity.Content) *entity.Content {
+	clone := *content
+	clone.Blocks = make([]entity.ContentBlock, len(content.Blocks))
+	for i, block := range content.Blocks {
+		if block.Data != nil {
+			data := *block.Data
+			block.Data = &data
+		}
+		clone.Blocks[i] = block
+	}
+	return &clone
+}
+
+// GetReferrers は参照ブロックでコンテンツを参照しているコンテンツ（ゴミ箱のものを含む）を返します
+func (u *contentUsecase) GetReferrers(ctx context.Context, id uuid.UUID) ([]entity.Referrer, error) {
+	if _, err := u.contentRepository.GetContentByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.contentRepository.GetReferrers(ctx, id)
+}
+
+// requireReferrerPolicy は参照元の扱いが定義済みの値であることを確認します
+func requireReferrerPolicy(policy entity.ReferrerPolicy) error {
+	if !policy.IsValid() {
+		return fmt.Errorf("%w: forceはdetachまたはremoveを指定してください: %s", entity.ErrInvalidParameter, policy)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// referenceBlock は参照ブロックを作成します
+func referenceBlock(target uuid.UUID, visible bool) entity.ContentBlock {
+	return entity.ContentBlock{
+		ID:        uuid.New(),
+		BlockType: entity.BlockTypeReference,
+		IsVisible: visible,
+		Data:      &entity.ContentBlockData{DataType: entity.DataTypeReference, ReferencedContentID: &target},
+	}
+}
+
+// ExpandReferencesのテスト
+func (s *contentsUsecaseTestSuite) TestExpandReferences() {
+	published := func(id uuid.UUID, blocks ...entity.ContentBlock) *entity.Content {
+		publishedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		return &entity.Content{ID: id, Status: entity.ContentStatusPublished, PublishedAt: &publishedAt, Blocks: blocks}
+	}
+	idsMatch := func(expected ...uuid.UUID) interface{} {
+		return mock.MatchedBy(func(ids []uuid.UUID) bool {
+			return len(ids) == len(expected) && !slices.ContainsFunc(expected, func(id uuid.UUID) bool {
+				return !slices.Contains(ids, id)
+			})
+		})
+	}
+	aID, bID, cID, dID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	s.Run("正常系：深さごとに参照先をまとめて取得して展開する場合", func() {
+		root := published(aID, referenceBlock(bID, true), referenceBlock(cID, true), referenceBlock(bID, true))
+		s.mockRepository.EXPECT().GetContentsByIDs(context.Background(), idsMatch(bID, cID)).Return([]*entity.Content{
+			published(bID, referenceBlock(dID, true)),
+			published(cID, referenceBlock(dID, true)),
+		}, nil).Once()
+		s.mockRepository.EXPECT().GetContentsByIDs(context.Background(), idsMatch(dID)).
+			Return([]*entity.Content{published(dID)}, nil).Once()
+
+		err := s.usecase.ExpandReferences(context.Background(), root, 2, false)
+		assert.NoError(s.T(), err)
+
+		b := root.Blocks[0].Data.ReferencedContent
+		if assert.NotNil(s.T(), b) {
+			assert.Equal(s.T(), bID, b.ID)
+			assert.Equal(s.T(), dID, b.Blocks[0].Data.ReferencedContent.ID)
+		}
+		assert.Equal(s.T(), dID, root.Blocks[1].Data.ReferencedContent.Blocks[0].Data.ReferencedContent.ID)
+
+		// 同じ参照先でも参照箇所ごとに別のコンテンツとして展開する
+		assert.NotSame(s.T(), b, root.Blocks[2].Data.ReferencedContent)
+		assert.NotSame(s.T(), b.Blocks[0].Data, root.Blocks[2].Data.ReferencedContent.Blocks[0].Data)
+	})
+
+	s.Run("正常系：指定した深さより先は展開しない場合", func() {
+		root := published(aID, referenceBlock(bID, true))
+		s.mockRepository.EXPECT().GetContentsByIDs(context.Background(), idsMatch(bID)).
+			Return([]*entity.Content{published(bID, referenceBlock(cID, true))}, nil).Once()
+
+		err := s.usecase.ExpandReferences(context.Background(), root, 1, false)
+		assert.NoError(s.T(), err)
+		b := root.Blocks[0].Data.ReferencedContent
+		assert.Equal(s.T(), bID, b.ID)
+		assert.Nil(s.T(), b.Blocks[0].Data.ReferencedContent)
+	})
+
+	s.Run("正常系：展開元のコンテンツへの参照は循環として展開しない場合", func() {
+		root := published(aID, referenceBlock(bID, true), referenceBlock(aID, true))
+		s.mockRepository.EXPECT().GetContentsByIDs(context.Background(), idsMatch(bID)).
+			Return([]*entity.Content{published(bID, referenceBlock(aID, true), referenceBlock(bID, true))}, nil).Once()
+
+		err := s.usecase.ExpandReferences(context.Background(), root, MaxReferenceDepth, false)
+		assert.NoError(s.T(), err)
+		assert.True(s.T(), root.Blocks[1].Data.ReferenceCycle)
+		assert.Nil(s.T(), root.Blocks[1].Data.ReferencedContent)
+
+		b := root.Blocks[0].Data.ReferencedContent
+		assert.False(s.T(), root.Blocks[0].Data.ReferenceCycle)
+		for _, block := range b.Blocks {
+			assert.True(s.T(), block.Data.ReferenceCycle)
+			assert.Nil(s.T(), block.Data.ReferencedContent)
+		}
+	})
+
+	s.Run("正常系：公開向けの場合は表示中のブロックの公開中の参照先のみを展開する場合", func() {
+		draft := &entity.Content{ID: cID, Status: entity.ContentStatusDraft}
+		expiresAt := s.now.Add(-time.Hour)
+		expired := published(dID)
+		expired.ExpiresAt = &expiresAt
+		root := published(aID, referenceBlock(bID, true), referenceBlock(cID, true), referenceBlock(dID, true), referenceBlock(uuid.New(), false))
+		s.mockRepository.EXPECT().GetContentsByIDs(context.Background(), idsMatch(bID, cID, dID)).
+			Return([]*entity.Content{published(bID), draft, expired}, nil).Once()
+
+		err := s.usecase.ExpandReferences(context.Background(), root, 1, true)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), bID, root.Blocks[0].Data.ReferencedContent.ID)
+		assert.Nil(s.T(), root.Blocks[1].Data.ReferencedContent)
+		assert.Nil(s.T(), root.Blocks[2].Data.ReferencedContent)
+		assert.Nil(s.T(), root.Blocks[3].Data.ReferencedContent)
+	})
+
+	s.Run("正常系：管理向けの場合は下書きの参照先も展開し、存在しない参照先は展開しない場合", func() {
+		root := published(aID, referenceBlock(cID, true), referenceBlock(dID, false))
+		s.mockRepository.EXPECT().GetContentsByIDs(context.Background(), idsMatch(cID, dID)).
+			Return([]*entity.Content{{ID: cID, Status: entity.ContentStatusDraft}}, nil).Once()
+
+		err := s.usecase.ExpandReferences(context.Background(), root, 1, false)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), cID, root.Blocks[0].Data.ReferencedContent.ID)
+		assert.Nil(s.T(), root.Blocks[1].Data.ReferencedContent)
+	})
+
+	s.Run("正常系：参照ブロックがない場合は取得しない場合", func() {
+		root := published(aID, entity.ContentBlock{BlockType: entity.BlockTypeText, Data: &entity.ContentBlockData{ContentText: "本文"}})
+
+		err := s.usecase.ExpandReferences(context.Background(), root, 1, false)
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("異常系：深さが範囲外の場合", func() {
+		for _, depth := range []int{0, MaxReferenceDepth + 1} {
+			err := s.usecase.ExpandReferences(context.Background(), published(aID), depth, false)
+			assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		}
+	})
+
+	s.Run("異常系：参照先の取得に失敗した場合", func() {
+		fetchErr := errors.New("db error")
+		s.mockRepository.EXPECT().GetContentsByIDs(context.Background(), mock.Anything).Return(nil, fetchErr)
+
+		err := s.usecase.ExpandReferences(context.Background(), published(aID, referenceBlock(bID, true)), 1, false)
+		assert.ErrorIs(s.T(), err, fetchErr)
+	})
+}
+
+// GetReferrersのテスト
+func (s *contentsUsecaseTestSuite) TestGetReferrers() {
+	contentID := uuid.New()
+
+	s.Run("正常系：参照元の一覧を取得する場合", func() {
+		referrers := []entity.Referrer{{ContentID: uuid.New(), Title: "参照元", BlockIDs: []uuid.UUID{uuid.New()}}}
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(&entity.Content{ID: contentID}, nil)
+		s.mockRepository.EXPECT().GetReferrers(context.Background(), contentID).Return(referrers, nil)
+
+		result, err := s.usecase.GetReferrers(context.Background(), contentID)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), referrers, result)
+	})
+
+	s.Run("異常系：コンテンツが存在しない場合", func() {
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(nil, entity.ErrContentNotFound)
+
+		result, err := s.usecase.GetReferrers(context.Background(), contentID)
+		assert.ErrorIs(s.T(), err, entity.ErrContentNotFound)
+		assert.Nil(s.T(), result)
+	})
+}
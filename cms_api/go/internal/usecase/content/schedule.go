@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// scheduleBatchSize は1回の予約実行で処理する最大件数
+const scheduleBatchSize = 100
+
+// ScheduleContentInput は公開予約の入力パラメータ
+type ScheduleContentInput struct {
+	Version int `json:"version"`
+	// PublishAtは公開予約日時（下書きのみ）、ExpiresAtは公開終了日時。nilの項目は変更しない
+	PublishAt *time.Time `json:"publish_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// ScheduleResult は予約実行の結果
+type ScheduleResult struct {
+	Published []uuid.UUID `json:"published"`
+	Archived  []uuid.UUID `json:"archived"`
+	// Skippedは実行中に他の更新と競合したため次回に持ち越したコンテンツ
+	Skipped []uuid.UUID `json:"skipped"`
+	// Blockedは他のコンテンツから参照されているためアーカイブしなかったコンテンツ（参照元がなくなるまで毎回対象になる）
+	Blocked []uuid.UUID `json:"blocked"`
+}
+
+// ScheduleContent はコンテンツの公開予約日時と公開終了日時を設定し、保存後の状態を返します
+func (u *contentUsecase) ScheduleContent(ctx context.Context, id uuid.UUID, input ScheduleContentInput) (*entity.Content, error) {
+	if err := requireVersion(input.Version); err != nil {
+		return nil, err
+	}
+
+	content, err := u.contentRepository.GetContentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	content.Version = input.Version
+	if err := content.Schedule(input.PublishAt, input.ExpiresAt, u.now()); err != nil {
+		return nil, err
+	}
+
+	if err := u.contentRepository.UpdateContentStatus(ctx, content); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, id)
+}
+
+// UnscheduleContent はコンテンツの公開予約日時と公開終了日時を解除し、保存後の状態を返します
+func (u *contentUsecase) UnscheduleContent(ctx context.Context, id uuid.UUID, expectedVersion int) (*entity.Content, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	content, err := u.contentRepository.GetContentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	content.Version = expectedVersion
+	content.Unschedule()
+
+	if err := u.contentRepository.UpdateContentStatus(ctx, content); err != nil {
+		return nil, err
+	}
+
+	return u.contentRepository.GetContentByID(ctx, id)
+}
+
+// RunSchedule はnowの時点で予約日時を過ぎたコンテンツを公開・アーカイブします
+// 他の更新と競合したコンテンツは次回の実行に持ち越し、その他のエラーはまとめて返します
+// 公開終了によるアーカイブは、他のコンテンツから参照されている場合は行いません
+func (u *contentUsecase) RunSchedule(ctx context.Context, now time.Time) (*ScheduleResult, error) {
+	contents, err := u.contentRepository.GetDueContents(ctx, now, scheduleBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ScheduleResult{
+		Published: []uuid.UUID{},
+		Archived:  []uuid.UUID{},
+		Skipped:   []uuid.UUID{},
+		Blocked:   []uuid.UUID{},
+	}
+	var errs []error
+	for _, content := range contents {
+		action, ok := content.DueAction(now)
+		if !ok {
+			continue
+		}
+		if err := content.Apply(action, now); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if err := u.applyDueAction(ctx, content, action); err != nil {
+			if errors.Is(err, entity.ErrVersionConflict) {
+				result.Skipped = append(result.Skipped, content.ID)
+				continue
+			}
+			if errors.Is(err, entity.ErrContentReferenced) {
+				log.Printf("参照元があるため公開終了によるアーカイブを行いませんでした: id=%s: %v", content.ID, err)
+				result.Blocked = append(result.Blocked, content.ID)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", content.ID, err))
+			continue
+		}
+
+		switch action {
+		case entity.ContentActionPublish:
+			result.Published = append(result.Published, content.ID)
+		case entity.ContentActionArchive:
+			result.Archived = append(result.Archived, content.ID)
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// applyDueAction は予約の操作を適用したコンテンツを保存します
+// アーカイブは手動の操作と同様に参照元を確認し、参照元がある場合はErrContentReferencedを返します
+func (u *contentUsecase) applyDueAction(ctx context.Context, content *entity.Content, action entity.ContentAction) error {
+	if action == entity.ContentActionArchive {
+		return u.contentRepository.UpdateReferencedContentStatus(ctx, content, entity.ReferrerPolicyRestrict)
+	}
+	return u.contentRepository.UpdateContentStatus(ctx, content)
+}
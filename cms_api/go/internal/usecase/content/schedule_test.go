@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ScheduleContentのテスト
+func (s *contentsUsecaseTestSuite) TestScheduleContent() {
+	contentID := uuid.New()
+
+	s.Run("正常系：下書きに公開予約日時を設定できる場合", func() {
+		publishAt := s.now.Add(time.Hour)
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(&entity.Content{ID: contentID, Status: entity.ContentStatusDraft, Version: 9}, nil).Once()
+		s.mockRepository.EXPECT().UpdateContentStatus(context.Background(), mock.MatchedBy(func(c *entity.Content) bool {
+			return c.Version == 3 && c.ScheduledAt != nil && c.ScheduledAt.Equal(publishAt)
+		})).Return(nil)
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(&entity.Content{ID: contentID, Version: 4, ScheduledAt: &publishAt}, nil).Once()
+
+		result, err := s.usecase.ScheduleContent(context.Background(), contentID, ScheduleContentInput{Version: 3, PublishAt: &publishAt})
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), 4, result.Version)
+	})
+
+	s.Run("異常系：公開中のコンテンツを公開予約する場合", func() {
+		publishAt := s.now.Add(time.Hour)
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(&entity.Content{ID: contentID, Status: entity.ContentStatusPublished, Version: 3}, nil).Once()
+
+		_, err := s.usecase.ScheduleContent(context.Background(), contentID, ScheduleContentInput{Version: 3, PublishAt: &publishAt})
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidStatusTransition))
+	})
+
+	s.Run("異常系：バージョンが指定されていない場合", func() {
+		publishAt := s.now.Add(time.Hour)
+		_, err := s.usecase.ScheduleContent(context.Background(), contentID, ScheduleContentInput{PublishAt: &publishAt})
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+}
+
+// RunScheduleのテスト
+func (s *contentsUsecaseTestSuite) TestRunSchedule() {
+	s.Run("正常系：予約日時を過ぎたコンテンツを公開・アーカイブし、競合したものは持ち越す場合", func() {
+		past := s.now.Add(-time.Minute)
+		toPublish := &entity.Content{ID: uuid.New(), Status: entity.ContentStatusDraft, ScheduledAt: &past, Version: 1}
+		toArchive := &entity.Content{ID: uuid.New(), Status: entity.ContentStatusPublished, PublishedAt: &past, ExpiresAt: &past, Version: 2}
+		conflicted := &entity.Content{ID: uuid.New(), Status: entity.ContentStatusDraft, ScheduledAt: &past, Version: 3}
+
+		s.mockRepository.EXPECT().GetDueContents(context.Background(), s.now, scheduleBatchSize).
+			Return([]*entity.Content{toPublish, toArchive, conflicted}, nil)
+		s.mockRepository.EXPECT().UpdateContentStatus(context.Background(), toPublish).Return(nil)
+		s.mockRepository.EXPECT().UpdateReferencedContentStatus(context.Background(), toArchive, entity.ReferrerPolicyRestrict).Return(nil)
+		s.mockRepository.EXPECT().UpdateContentStatus(context.Background(), conflicted).Return(entity.ErrVersionConflict)
+
+		result, err := s.usecase.RunSchedule(context.Background(), s.now)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []uuid.UUID{toPublish.ID}, result.Published)
+		assert.Equal(s.T(), []uuid.UUID{toArchive.ID}, result.Archived)
+		assert.Equal(s.T(), []uuid.UUID{conflicted.ID}, result.Skipped)
+		assert.Equal(s.T(), entity.ContentStatusPublished, toPublish.Status)
+		assert.Equal(s.T(), &past, toPublish.PublishedAt)
+		assert.Equal(s.T(), entity.ContentStatusArchived, toArchive.Status)
+		assert.Empty(s.T(), result.Blocked)
+	})
+
+	s.Run("正常系：公開終了日時を過ぎても参照元がある場合はアーカイブしない場合", func() {
+		past := s.now.Add(-time.Minute)
+		referenced := &entity.Content{ID: uuid.New(), Status: entity.ContentStatusPublished, PublishedAt: &past, ExpiresAt: &past, Version: 2}
+
+		s.mockRepository.EXPECT().GetDueContents(context.Background(), s.now, scheduleBatchSize).
+			Return([]*entity.Content{referenced}, nil)
+		s.mockRepository.EXPECT().UpdateReferencedContentStatus(context.Background(), referenced, entity.ReferrerPolicyRestrict).
+			Return(&entity.ReferencedError{Referrers: []entity.Referrer{{ContentID: uuid.New(), Status: entity.ContentStatusPublished}}})
+
+		result, err := s.usecase.RunSchedule(context.Background(), s.now)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []uuid.UUID{referenced.ID}, result.Blocked)
+		assert.Empty(s.T(), result.Archived)
+	})
+
+	s.Run("異常系：更新に失敗した場合は残りを処理してエラーを返す場合", func() {
+		past := s.now.Add(-time.Minute)
+		failed := &entity.Content{ID: uuid.New(), Status: entity.ContentStatusDraft, ScheduledAt: &past, Version: 1}
+		succeeded := &entity.Content{ID: uuid.New(), Status: entity.ContentStatusDraft, ScheduledAt: &past, Version: 1}
+		dbErr := errors.New("db error")
+
+		s.mockRepository.EXPECT().GetDueContents(context.Background(), s.now, scheduleBatchSize).
+			Return([]*entity.Content{failed, succeeded}, nil)
+		s.mockRepository.EXPECT().UpdateContentStatus(context.Background(), failed).Return(dbErr)
+		s.mockRepository.EXPECT().UpdateContentStatus(context.Background(), succeeded).Return(nil)
+
+		result, err := s.usecase.RunSchedule(context.Background(), s.now)
+		assert.True(s.T(), errors.Is(err, dbErr))
+		assert.Equal(s.T(), []uuid.UUID{succeeded.ID}, result.Published)
+	})
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// SchemaMigrationReport はフィールドの定義の移行の結果（ドライランの場合は移行した場合の見込み）
+type SchemaMigrationReport struct {
+	DryRun        bool      `json:"dry_run"`
+	ContentTypeID uuid.UUID `json:"content_type_id"`
+	// FromVersionは移行元、ToVersionは移行後のフィールドの定義の版
+	FromVersion int `json:"from_version"`
+	ToVersion   int `json:"to_version"`
+	// TotalContentsはコンテンツタイプのコンテンツ数（ゴミ箱を含む）
+	TotalContents int `json:"total_contents"`
+	// AffectedCountはブロックを書き換えるコンテンツ数、InvalidCountは移行後もフィールドの定義に沿わないコンテンツ数
+	AffectedCount int `json:"affected_count"`
+	InvalidCount  int `json:"invalid_count"`
+	// Contentsはブロックを書き換えるか、移行後もフィールドの定義に沿わないコンテンツ
+	Contents []ContentMigration `json:"contents"`
+}
+
+// ContentMigration はコンテンツ1件の移行の内容
+type ContentMigration struct {
+	ContentID     uuid.UUID            `json:"content_id"`
+	Title         string               `json:"title"`
+	Status        entity.ContentStatus `json:"status"`
+	ChangedBlocks int                  `json:"changed_blocks"`
+	RemovedBlocks int                  `json:"removed_blocks"`
+	// Violationsは移行後もフィールドの定義に沿わない内容（1件でもある場合は移行しない）
+	Violations entity.ValidationErrors `json:"violations,omitempty"`
+}
+
+// GetContentTypeSchemaVersions はコンテンツタイプのフィールドの定義の版を新しい順に取得します
+func (u *contentUsecase) GetContentTypeSchemaVersions(ctx context.Context, contentTypeID uuid.UUID) ([]*entity.ContentTypeSchemaVersion, error) {
+	if _, err := u.contentRepository.GetContentTypeByID(ctx, contentTypeID); err != nil {
+		return nil, err
+	}
+	return u.contentRepository.GetContentTypeSchemaVersions(ctx, contentTypeID)
+}
+
+// MigrateContentType はフィールドの定義を移行し、既存のコンテンツのブロックを書き換え手順に従って書き換えます
+// dryRunがtrueの場合は何も変更せず、影響を受けるコンテンツの一覧のみを返します
+// 書き換えた後もフィールドの定義に沿わないコンテンツがある場合は移行せず、違反の一覧をエラーとして返します
+func (u *contentUsecase) MigrateContentType(ctx context.Context, contentTypeID uuid.UUID, migration entity.SchemaMigration, dryRun bool) (*SchemaMigrationReport, error) {
+	if err := migration.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+	}
+
+	contentType, err := u.contentRepository.GetContentTypeByID(ctx, contentTypeID)
+	if err != nil {
+		return nil, err
+	}
+	if contentType.SchemaVersion != migration.SchemaVersion {
+		return nil, fmt.Errorf("%w: フィールドの定義の版が最新ではありません (schema_version=%d, current=%d)",
+			entity.ErrVersionConflict, migration.SchemaVersion, contentType.SchemaVersion)
+	}
+
+	contents, err := u.contentRepository.GetContentsByContentType(ctx, contentTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, migrated, err := u.planSchemaMigration(ctx, contentType, migration, contents)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		report.DryRun = true
+		return report, nil
+	}
+	if report.InvalidCount > 0 {
+		return nil, report.violations()
+	}
+
+	contentType.FieldSchema = migration.FieldSchema
+	if err := u.contentRepository.MigrateContentType(ctx, contentType, migrated); err != nil {
+		return nil, err
+	}
+	report.ToVersion = contentType.SchemaVersion
+	return report, nil
+}
+
+// checkFieldSchemaChange はブロックを書き換えずにフィールドの定義を変更する場合に、既存のコンテンツ（ゴミ箱を含む）が変更後の定義に沿うかを確認します
+// 沿わないコンテンツがある場合は、書き換え手順を指定して移行するようErrInvalidParameterを返します
+func (u *contentUsecase) checkFieldSchemaChange(ctx context.Context, contentType *entity.ContentType, schema entity.FieldSchema) error {
+	if contentType.FieldSchema.Equal(schema) {
+		return nil
+	}
+
+	contents, err := u.contentRepository.GetContentsByContentType(ctx, contentType.ID)
+	if err != nil {
+		return err
+	}
+	report, _, err := u.planSchemaMigration(ctx, contentType, entity.SchemaMigration{FieldSchema: schema}, contents)
+	if err != nil {
+		return err
+	}
+	if report.InvalidCount > 0 {
+		return fmt.Errorf("%w: 変更後のフィールドの定義に沿わないコンテンツが%d件あります（POST /content-types/%s/migrations で書き換え手順を指定して移行してください）",
+			entity.ErrInvalidParameter, report.InvalidCount, contentType.ID)
+	}
+	return nil
+}
+
+// violations は移行後もフィールドの定義に沿わないコンテンツの違反を、項目にコンテンツIDを付けて返します
+func (r *SchemaMigrationReport) violations() entity.ValidationErrors {
+	var errs entity.ValidationErrors
+	for _, content := range r.Contents {
+		for _, violation := range content.Violations {
+			violation.Field = fmt.Sprintf("contents[%s].%s", content.ContentID, violation.Field)
+			errs = append(errs, violation)
+		}
+	}
+	return errs
+}
+
+// planSchemaMigration は各コンテンツのブロックを書き換え、移行の内容と書き換えたコンテンツを返します
+// 書き換え後のブロックは移行後のフィールドの定義で検証し、違反は内容に含めます
+func (u *contentUsecase) planSchemaMigration(ctx context.Context, contentType *entity.ContentType, migration entity.SchemaMigration, contents []*entity.Content) (*SchemaMigrationReport, []*entity.Content, error) {
+	report := &SchemaMigrationReport{
+		ContentTypeID: contentType.ID,
+		FromVersion:   contentType.SchemaVersion,
+		ToVersion:     contentType.SchemaVersion + 1,
+		TotalContents: len(contents),
+		Contents:      []ContentMigration{},
+	}
+
+	results := make([]ContentMigration, len(contents))
+	var migrated []*entity.Content
+	var referencedIDs []uuid.UUID
+	for i, content := range contents {
+		blocks, changed, removed := migration.MigrateBlocks(content.Blocks)
+		results[i] = ContentMigration{
+			ContentID:     content.ID,
+			Title:         content.Title,
+			Status:        content.Status,
+			ChangedBlocks: changed,
+			RemovedBlocks: removed,
+		}
+		content.Blocks = blocks
+		if changed+removed > 0 {
+			migrated = append(migrated, content)
+		}
+		referencedIDs = append(referencedIDs, referencedContentIDs(blocks)...)
+	}
+
+	var referencedTypes map[uuid.UUID]string
+	if len(referencedIDs) > 0 {
+		var err error
+		if referencedTypes, err = u.contentRepository.GetContentTypeNames(ctx, referencedIDs); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for i, content := range contents {
+		result := results[i]
+		result.Violations = migration.FieldSchema.ValidateBlocks(content.Blocks, referencedTypes)
+		affected := result.ChangedBlocks+result.RemovedBlocks > 0
+		if affected {
+			report.AffectedCount++
+		}
+		if len(result.Violations) > 0 {
+			report.InvalidCount++
+		}
+		if affected || len(result.Violations) > 0 {
+			report.Contents = append(report.Contents, result)
+		}
+	}
+	return report, migrated, nil
+}
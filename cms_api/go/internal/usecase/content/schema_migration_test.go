@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MigrateContentTypeのテスト
+func (s *contentsUsecaseTestSuite) TestMigrateContentType() {
+	contentTypeID := uuid.New()
+	affectedID, invalidID, untouchedID := uuid.New(), uuid.New(), uuid.New()
+	migration := entity.SchemaMigration{
+		SchemaVersion: 3,
+		FieldSchema: entity.FieldSchema{Fields: []entity.FieldDefinition{
+			{BlockType: entity.BlockTypeRichText, Label: "本文", Required: true},
+		}},
+		Steps: []entity.SchemaMigrationStep{
+			{Op: entity.SchemaMigrationChangeType, BlockType: entity.BlockTypeText, To: entity.BlockTypeRichText},
+			{Op: entity.SchemaMigrationRemove, BlockType: entity.BlockTypeVideo},
+		},
+	}
+	// withInvalidがtrueの場合は、移行後もフィールドの定義に沿わないコンテンツを含める
+	setupContents := func(withInvalid bool) {
+		contents := []*entity.Content{
+			{ID: affectedID, Version: 2, Blocks: []entity.ContentBlock{
+				{BlockType: entity.BlockTypeText, IsVisible: true, Data: &entity.ContentBlockData{DataType: entity.DataTypeText, ContentText: "本文"}},
+				{BlockType: entity.BlockTypeVideo, IsVisible: true},
+			}},
+			{ID: untouchedID, Version: 5, Blocks: []entity.ContentBlock{
+				{BlockType: entity.BlockTypeRichText, IsVisible: true},
+			}},
+		}
+		if withInvalid {
+			contents = append(contents, &entity.Content{ID: invalidID, Version: 1, Blocks: []entity.ContentBlock{
+				{BlockType: entity.BlockTypeImage, IsVisible: true},
+			}})
+		}
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).
+			Return(&entity.ContentType{ID: contentTypeID, SchemaVersion: 3}, nil)
+		s.mockRepository.EXPECT().GetContentsByContentType(context.Background(), contentTypeID).Return(contents, nil)
+	}
+
+	s.Run("正常系：ドライランの場合は変更せずに影響を受けるコンテンツを返す", func() {
+		setupContents(true)
+
+		report, err := s.usecase.MigrateContentType(context.Background(), contentTypeID, migration, true)
+		assert.NoError(s.T(), err)
+		assert.True(s.T(), report.DryRun)
+		assert.Equal(s.T(), 3, report.FromVersion)
+		assert.Equal(s.T(), 4, report.ToVersion)
+		assert.Equal(s.T(), 3, report.TotalContents)
+		assert.Equal(s.T(), 1, report.AffectedCount)
+		assert.Equal(s.T(), 1, report.InvalidCount)
+		assert.Len(s.T(), report.Contents, 2)
+
+		assert.Equal(s.T(), affectedID, report.Contents[0].ContentID)
+		assert.Equal(s.T(), 1, report.Contents[0].ChangedBlocks)
+		assert.Equal(s.T(), 1, report.Contents[0].RemovedBlocks)
+		assert.Empty(s.T(), report.Contents[0].Violations)
+
+		// 画像は移行後の定義で使えず、必須の本文もない
+		assert.Equal(s.T(), invalidID, report.Contents[1].ContentID)
+		assert.Len(s.T(), report.Contents[1].Violations, 2)
+	})
+
+	s.Run("正常系：書き換えたコンテンツのみを保存する", func() {
+		setupContents(false)
+		s.mockRepository.EXPECT().MigrateContentType(context.Background(), mock.MatchedBy(func(ct *entity.ContentType) bool {
+			return ct.SchemaVersion == 3 && len(ct.FieldSchema.Fields) == 1
+		}), mock.MatchedBy(func(contents []*entity.Content) bool {
+			return len(contents) == 1 && contents[0].ID == affectedID &&
+				len(contents[0].Blocks) == 1 && contents[0].Blocks[0].BlockType == entity.BlockTypeRichText
+		})).RunAndReturn(func(_ context.Context, ct *entity.ContentType, _ []*entity.Content) error {
+			ct.SchemaVersion = 4
+			return nil
+		})
+
+		report, err := s.usecase.MigrateContentType(context.Background(), contentTypeID, migration, false)
+		assert.NoError(s.T(), err)
+		assert.False(s.T(), report.DryRun)
+		assert.Equal(s.T(), 4, report.ToVersion)
+	})
+
+	s.Run("異常系：移行後もフィールドの定義に沿わないコンテンツがある場合は移行しない", func() {
+		setupContents(true)
+
+		report, err := s.usecase.MigrateContentType(context.Background(), contentTypeID, migration, false)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.Nil(s.T(), report)
+		var violations entity.ValidationErrors
+		if assert.ErrorAs(s.T(), err, &violations) {
+			assert.Len(s.T(), violations, 2)
+			assert.Contains(s.T(), violations[0].Field, invalidID.String())
+		}
+	})
+
+	s.Run("異常系：移行元の版が最新ではない場合", func() {
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).
+			Return(&entity.ContentType{ID: contentTypeID, SchemaVersion: 4}, nil)
+
+		report, err := s.usecase.MigrateContentType(context.Background(), contentTypeID, migration, true)
+		assert.ErrorIs(s.T(), err, entity.ErrVersionConflict)
+		assert.Nil(s.T(), report)
+	})
+
+	s.Run("異常系：書き換え手順が不正な場合", func() {
+		invalid := migration
+		invalid.Steps = []entity.SchemaMigrationStep{{Op: entity.SchemaMigrationChangeType, BlockType: entity.BlockTypeText, To: entity.BlockTypeImage}}
+
+		report, err := s.usecase.MigrateContentType(context.Background(), contentTypeID, invalid, true)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+		assert.Nil(s.T(), report)
+	})
+}
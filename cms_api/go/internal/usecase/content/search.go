@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+)
+
+// snippetLength は検索結果の抜粋の文字数
+const snippetLength = 120
+
+// SearchList は検索結果とページネーション情報
+type SearchList struct {
+	Hits       []*entity.SearchHit `json:"hits"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+// SearchContents はinput.Searchの語をタイトル・スラッグ・表示中のブロック本文から検索し、関連度の高い順に返します
+// 絞り込み条件は一覧取得と同じで、ソート指定とカーソルは無視します
+// 各結果には一致箇所を強調したタイトルと本文の抜粋を含め、ブロックは表示形式がViewFullの場合のみ含めます
+func (u *contentUsecase) SearchContents(ctx context.Context, input GetContentsInput) (*SearchList, error) {
+	limit, offset := pageRange(input)
+	input.Sort, input.Order = "", ""
+	filters, err := buildContentFilters(input, u.now())
+	if err != nil {
+		return nil, err
+	}
+	if len(filters.Terms) == 0 {
+		return nil, fmt.Errorf("%w: 検索キーワードは必須です", entity.ErrInvalidParameter)
+	}
+
+	hits, total, err := u.contentRepository.SearchContents(ctx, limit, offset, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hit := range hits {
+		hit.TitleHighlight = entity.Highlight(hit.Content.Title, filters.Terms, 0)
+		hit.Snippet = entity.Highlight(hit.Content.PlainText(), filters.Terms, snippetLength)
+		if !filters.WithBlocks {
+			hit.Content.Blocks = nil
+		}
+	}
+
+	return &SearchList{
+		Hits:       hits,
+		Pagination: newPagination(limit, offset, total),
+	}, nil
+}
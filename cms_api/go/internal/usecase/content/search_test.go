@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// SearchContentsのテスト
+func (s *contentsUsecaseTestSuite) TestSearchContents() {
+	s.Run("正常系：正規化した語で検索し、一致箇所を強調した抜粋を付ける場合", func() {
+		content := &entity.Content{
+			ID:    uuid.New(),
+			Title: "AWS Lambda入門",
+			Blocks: []entity.ContentBlock{
+				{IsVisible: true, Data: &entity.ContentBlockData{DataType: entity.DataTypeText, ContentText: "サーバーレスでLambdaを使う"}},
+			},
+		}
+		published := entity.ContentStatusPublished
+		s.mockRepository.EXPECT().SearchContents(context.Background(), DefaultLimit, 0, mock.MatchedBy(func(f repository.ContentFilters) bool {
+			return assert.ObjectsAreEqual([]string{"lambda", "サーバーレス"}, f.Terms) &&
+				*f.Status == published && f.VisibleAt.Equal(s.now)
+		})).Return([]*entity.SearchHit{{Content: content, Score: 2.5}}, 1, nil)
+
+		list, err := s.usecase.SearchContents(context.Background(),
+			GetContentsInput{Search: "Ｌａｍｂｄａ ｻｰﾊﾞｰﾚｽ", Status: "published", Sort: "title"})
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), int64(1), list.Pagination.TotalCount)
+		assert.Equal(s.T(), "AWS <mark>Lambda</mark>入門", list.Hits[0].TitleHighlight)
+		assert.Equal(s.T(), "<mark>サーバーレス</mark>で<mark>Lambda</mark>を使う", list.Hits[0].Snippet)
+		// 表示形式を指定しない場合は抜粋の作成後にブロックを除く
+		assert.Nil(s.T(), list.Hits[0].Content.Blocks)
+	})
+
+	s.Run("異常系：検索キーワードが空の場合", func() {
+		_, err := s.usecase.SearchContents(context.Background(), GetContentsInput{Search: "　"})
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+
+	s.Run("異常系：検索でエラーが発生する場合", func() {
+		s.mockRepository.EXPECT().SearchContents(context.Background(), DefaultLimit, 0, mock.Anything).
+			Return(nil, 0, errors.New("検索エラー"))
+
+		_, err := s.usecase.SearchContents(context.Background(), GetContentsInput{Search: "aws"})
+		assert.EqualError(s.T(), err, "検索エラー")
+	})
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// fallbackSlug はタイトルにスラッグに使える文字がない場合のスラッグ
+	fallbackSlug = "untitled"
+	// maxSlugAttempts は作成したスラッグが重複する場合に連番を付けて試す最大回数
+	maxSlugAttempts = 100
+)
+
+// GetContentBySlug はコンテンツタイプ名とスラッグで公開中のコンテンツを取得します
+// 変更前のスラッグが指定された場合は、リダイレクト先の現在のコンテンツを返します
+// （返したコンテンツのスラッグまたはコンテンツタイプ名が指定と異なるかで判別する）
+// 公開中でない（下書き・公開予約中・公開終了など）の場合はErrContentNotFoundを返します
+func (u *contentUsecase) GetContentBySlug(ctx context.Context, contentTypeName, slug string) (*entity.Content, error) {
+	content, err := u.contentRepository.GetContentBySlug(ctx, contentTypeName, slug)
+	if errors.Is(err, entity.ErrContentNotFound) {
+		content, err = u.redirectedContent(ctx, contentTypeName, slug, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !content.IsVisibleAt(u.now()) {
+		return nil, fmt.Errorf("%w: %s/%s", entity.ErrContentNotFound, contentTypeName, slug)
+	}
+	return content, nil
+}
+
+// redirectedContent は変更前のスラッグのリダイレクト先のコンテンツを取得します
+// リダイレクトがない場合はnotFound（スラッグでの取得時のエラー）を返します
+func (u *contentUsecase) redirectedContent(ctx context.Context, contentTypeName, slug string, notFound error) (*entity.Content, error) {
+	redirect, err := u.contentRepository.GetSlugRedirect(ctx, contentTypeName, slug)
+	if err != nil {
+		if errors.Is(err, entity.ErrSlugRedirectNotFound) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+	return u.contentRepository.GetContentByID(ctx, redirect.ContentID)
+}
+
+// GetSlugRedirects はコンテンツへのリダイレクト（変更前のスラッグ）を新しい順に取得します
+func (u *contentUsecase) GetSlugRedirects(ctx context.Context, contentID uuid.UUID) ([]*entity.SlugRedirect, error) {
+	if _, err := u.contentRepository.GetContentByID(ctx, contentID); err != nil {
+		return nil, err
+	}
+	return u.contentRepository.GetSlugRedirects(ctx, contentID)
+}
+
+// DeleteSlugRedirect はコンテンツへのリダイレクトを削除します（変更前のスラッグは転送されなくなる）
+func (u *contentUsecase) DeleteSlugRedirect(ctx context.Context, contentID, redirectID uuid.UUID) error {
+	return u.contentRepository.DeleteSlugRedirect(ctx, contentID, redirectID)
+}
+
+// generateSlug はスラッグが空の場合にタイトルから作成し、作成したかを返します
+func generateSlug(content *entity.Content) bool {
+	if content.Slug != "" {
+		return false
+	}
+	content.Slug = entity.GenerateSlug(content.Title)
+	if content.Slug == "" {
+		content.Slug = fallbackSlug
+	}
+	return true
+}
+
+// resolveSlug はコンテンツタイプ内でスラッグが重複しないことを確認します
+// タイトルから作成したスラッグ（generated）が重複する場合は「-2」「-3」…の連番を付け、
+// 指定されたスラッグが重複する場合はErrAlreadyExistsを返します
+// currentと同じコンテンツタイプ・スラッグのままの場合は確認しません
+func (u *contentUsecase) resolveSlug(ctx context.Context, content, current *entity.Content, generated bool) error {
+	if current != nil && content.Slug == current.Slug && content.ContentTypeID == current.ContentTypeID {
+		return nil
+	}
+
+	if !generated {
+		if err := entity.ValidateSlug(content.Slug); err != nil {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidParameter, err)
+		}
+		exists, err := u.contentRepository.ContentSlugExists(ctx, content.ContentTypeID, content.Slug, content.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("スラッグが%w: %s", entity.ErrAlreadyExists, content.Slug)
+		}
+		return nil
+	}
+
+	base := content.Slug
+	for i := 1; i <= maxSlugAttempts; i++ {
+		candidate := base
+		if i > 1 {
+			suffix := fmt.Sprintf("-%d", i)
+			candidate = entity.TruncateSlug(base, entity.MaxSlugLength-len(suffix)) + suffix
+		}
+		exists, err := u.contentRepository.ContentSlugExists(ctx, content.ContentTypeID, candidate, content.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			content.Slug = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("スラッグが%w: %s（連番を%d件まで試しました）", entity.ErrAlreadyExists, base, maxSlugAttempts)
+}
@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// GetContentBySlugのテスト
+func (s *contentsUsecaseTestSuite) TestGetContentBySlug() {
+	past := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	notFound := fmt.Errorf("%w: blog/aws-lambda入門", entity.ErrContentNotFound)
+	redirectedID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		setup         func()
+		expected      *entity.Content
+		expectedError error
+	}{
+		{
+			name: "正常系：公開中のコンテンツを取得できる場合",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentBySlug(context.Background(), "blog", "aws-lambda入門").
+					Return(&entity.Content{Slug: "aws-lambda入門", Status: entity.ContentStatusPublished, PublishedAt: &past}, nil)
+			},
+			expected: &entity.Content{Slug: "aws-lambda入門", Status: entity.ContentStatusPublished, PublishedAt: &past},
+		},
+		{
+			name: "異常系：下書きの場合は存在しない扱い",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentBySlug(context.Background(), "blog", "aws-lambda入門").
+					Return(&entity.Content{Slug: "aws-lambda入門", Status: entity.ContentStatusDraft}, nil)
+			},
+			expectedError: entity.ErrContentNotFound,
+		},
+		{
+			name: "異常系：公開日時が未来の場合は存在しない扱い",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentBySlug(context.Background(), "blog", "aws-lambda入門").
+					Return(&entity.Content{Slug: "aws-lambda入門", Status: entity.ContentStatusPublished, PublishedAt: &future}, nil)
+			},
+			expectedError: entity.ErrContentNotFound,
+		},
+		{
+			name: "異常系：公開終了日時を過ぎた場合は存在しない扱い",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentBySlug(context.Background(), "blog", "aws-lambda入門").
+					Return(&entity.Content{Slug: "aws-lambda入門", Status: entity.ContentStatusPublished, PublishedAt: &past, ExpiresAt: &past}, nil)
+			},
+			expectedError: entity.ErrContentNotFound,
+		},
+		{
+			name: "異常系：コンテンツタイプが存在しない場合",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentBySlug(context.Background(), "blog", "aws-lambda入門").
+					Return(nil, fmt.Errorf("%w: blog", entity.ErrContentTypeNotFound))
+			},
+			expectedError: entity.ErrContentTypeNotFound,
+		},
+		{
+			name: "正常系：変更前のスラッグの場合はリダイレクト先の現在のコンテンツを返す場合",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentBySlug(context.Background(), "blog", "aws-lambda入門").Return(nil, notFound)
+				s.mockRepository.EXPECT().GetSlugRedirect(context.Background(), "blog", "aws-lambda入門").
+					Return(&entity.SlugRedirect{ContentID: redirectedID, Slug: "aws-lambda入門"}, nil)
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), redirectedID).
+					Return(&entity.Content{ID: redirectedID, Slug: "lambda-guide", Status: entity.ContentStatusPublished, PublishedAt: &past}, nil)
+			},
+			expected: &entity.Content{ID: redirectedID, Slug: "lambda-guide", Status: entity.ContentStatusPublished, PublishedAt: &past},
+		},
+		{
+			name: "異常系：リダイレクト先のコンテンツが公開中でない場合",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentBySlug(context.Background(), "blog", "aws-lambda入門").Return(nil, notFound)
+				s.mockRepository.EXPECT().GetSlugRedirect(context.Background(), "blog", "aws-lambda入門").
+					Return(&entity.SlugRedirect{ContentID: redirectedID, Slug: "aws-lambda入門"}, nil)
+				s.mockRepository.EXPECT().GetContentByID(context.Background(), redirectedID).
+					Return(&entity.Content{ID: redirectedID, Slug: "lambda-guide", Status: entity.ContentStatusArchived}, nil)
+			},
+			expectedError: entity.ErrContentNotFound,
+		},
+		{
+			name: "異常系：コンテンツもリダイレクトもない場合",
+			setup: func() {
+				s.mockRepository.EXPECT().GetContentBySlug(context.Background(), "blog", "aws-lambda入門").Return(nil, notFound)
+				s.mockRepository.EXPECT().GetSlugRedirect(context.Background(), "blog", "aws-lambda入門").
+					Return(nil, fmt.Errorf("%w: blog/aws-lambda入門", entity.ErrSlugRedirectNotFound))
+			},
+			expectedError: entity.ErrContentNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := s.usecase.GetContentBySlug(context.Background(), "blog", "aws-lambda入門")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(s.T(), err, tc.expectedError)
+				assert.Nil(s.T(), result)
+				return
+			}
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), tc.expected, result)
+		})
+	}
+}
+
+// GetSlugRedirectsのテスト
+func (s *contentsUsecaseTestSuite) TestGetSlugRedirects() {
+	contentID := uuid.New()
+
+	s.Run("正常系：コンテンツへのリダイレクト一覧を取得できる場合", func() {
+		redirects := []*entity.SlugRedirect{{ID: uuid.New(), ContentID: contentID, Slug: "old-slug"}}
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(&entity.Content{ID: contentID}, nil)
+		s.mockRepository.EXPECT().GetSlugRedirects(context.Background(), contentID).Return(redirects, nil)
+
+		result, err := s.usecase.GetSlugRedirects(context.Background(), contentID)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), redirects, result)
+	})
+
+	s.Run("異常系：コンテンツが存在しない場合", func() {
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(nil, fmt.Errorf("%w: %s", entity.ErrContentNotFound, contentID))
+
+		result, err := s.usecase.GetSlugRedirects(context.Background(), contentID)
+		assert.ErrorIs(s.T(), err, entity.ErrContentNotFound)
+		assert.Nil(s.T(), result)
+	})
+}
+
+// スラッグ変更時の重複確認のテスト
+func (s *contentsUsecaseTestSuite) TestPatchContentSlug() {
+	s.Run("正常系：空のスラッグを指定した場合はタイトルから作り直す場合", func() {
+		existing := randomContent(rand.Int64N(1 << 32))
+		existing.Title = "サーバーレス入門"
+		slug := ""
+
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), existing.ContentTypeID).
+			Return(&entity.ContentType{ID: existing.ContentTypeID}, nil)
+		s.mockRepository.EXPECT().ContentSlugExists(context.Background(), existing.ContentTypeID, "サーバーレス入門", existing.ID).
+			Return(false, nil)
+		s.mockRepository.EXPECT().UpdateContent(context.Background(), existing).Return(nil)
+
+		result, err := s.usecase.PatchContent(context.Background(), existing.ID, PatchContentInput{Version: &existing.Version, Slug: &slug})
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), "サーバーレス入門", result.Slug)
+	})
+
+	s.Run("異常系：他のコンテンツが使っているスラッグに変更しようとした場合", func() {
+		existing := randomContent(rand.Int64N(1 << 32))
+		slug := "taken-slug"
+
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), existing.ContentTypeID).
+			Return(&entity.ContentType{ID: existing.ContentTypeID}, nil)
+		s.mockRepository.EXPECT().ContentSlugExists(context.Background(), existing.ContentTypeID, slug, existing.ID).
+			Return(true, nil)
+
+		result, err := s.usecase.PatchContent(context.Background(), existing.ID, PatchContentInput{Version: &existing.Version, Slug: &slug})
+
+		assert.ErrorIs(s.T(), err, entity.ErrAlreadyExists)
+		assert.Nil(s.T(), result)
+	})
+
+	s.Run("正常系：コンテンツタイプを変更した場合は移動先で重複を確認する場合", func() {
+		existing := randomContent(rand.Int64N(1 << 32))
+		existing.Slug = "same-slug"
+		contentTypeID := uuid.New()
+
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), existing.ID).Return(existing, nil)
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), contentTypeID).
+			Return(&entity.ContentType{ID: contentTypeID}, nil)
+		s.mockRepository.EXPECT().ContentSlugExists(context.Background(), contentTypeID, "same-slug", existing.ID).
+			Return(false, nil)
+		s.mockRepository.EXPECT().UpdateContent(context.Background(), existing).Return(nil)
+
+		_, err := s.usecase.PatchContent(context.Background(), existing.ID,
+			PatchContentInput{Version: &existing.Version, ContentTypeID: &contentTypeID})
+
+		assert.NoError(s.T(), err)
+	})
+}
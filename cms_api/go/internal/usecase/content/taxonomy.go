@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetCategories はカテゴリ一覧を取得します
+func (u *contentUsecase) GetCategories(ctx context.Context) ([]*entity.Category, error) {
+	return u.contentRepository.GetCategories(ctx)
+}
+
+// GetCategoryByID はIDでカテゴリを取得します
+func (u *contentUsecase) GetCategoryByID(ctx context.Context, id uuid.UUID) (*entity.Category, error) {
+	return u.contentRepository.GetCategoryByID(ctx, id)
+}
+
+// CreateCategory はカテゴリを作成し、作成後の状態を返します
+func (u *contentUsecase) CreateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	category.ID = uuid.Nil
+	if err := u.contentRepository.CreateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// UpdateCategory はカテゴリを更新し、更新後の状態を返します
+func (u *contentUsecase) UpdateCategory(ctx context.Context, id uuid.UUID, category *entity.Category) (*entity.Category, error) {
+	category.ID = id
+	if err := u.contentRepository.UpdateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+	return u.contentRepository.GetCategoryByID(ctx, id)
+}
+
+// DeleteCategory はカテゴリを削除します（属していたコンテンツはカテゴリなしになります）
+func (u *contentUsecase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	return u.contentRepository.DeleteCategory(ctx, id)
+}
+
+// GetTags はタグ一覧を取得します
+func (u *contentUsecase) GetTags(ctx context.Context) ([]*entity.Tag, error) {
+	return u.contentRepository.GetTags(ctx)
+}
+
+// GetTagByID はIDでタグを取得します
+func (u *contentUsecase) GetTagByID(ctx context.Context, id uuid.UUID) (*entity.Tag, error) {
+	return u.contentRepository.GetTagByID(ctx, id)
+}
+
+// CreateTag はタグを作成し、作成後の状態を返します
+func (u *contentUsecase) CreateTag(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+	tag.ID = uuid.Nil
+	if err := u.contentRepository.CreateTag(ctx, tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// UpdateTag はタグを更新し、更新後の状態を返します
+func (u *contentUsecase) UpdateTag(ctx context.Context, id uuid.UUID, tag *entity.Tag) (*entity.Tag, error) {
+	tag.ID = id
+	if err := u.contentRepository.UpdateTag(ctx, tag); err != nil {
+		return nil, err
+	}
+	return u.contentRepository.GetTagByID(ctx, id)
+}
+
+// DeleteTag はタグを削除します（コンテンツとの関連付けも解除されます）
+func (u *contentUsecase) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	return u.contentRepository.DeleteTag(ctx, id)
+}
+
+// archiveTimeZone は年月別アーカイブの年月や日付のみの範囲指定を解釈するタイムゾーン
+const archiveTimeZone = "Asia/Tokyo"
+
+// archiveLocation はarchiveTimeZoneのロケーション（夏時間がないため固定オフセットで扱う）
+var archiveLocation = time.FixedZone(archiveTimeZone, 9*60*60)
+
+// GetCategoryCounts はすべてのカテゴリと公開中のコンテンツ数を取得します
+func (u *contentUsecase) GetCategoryCounts(ctx context.Context) ([]*entity.CategoryCount, error) {
+	return u.contentRepository.GetCategoryCounts(ctx, u.now())
+}
+
+// GetTagCounts はすべてのタグと公開中のコンテンツ数を取得します
+func (u *contentUsecase) GetTagCounts(ctx context.Context) ([]*entity.TagCount, error) {
+	return u.contentRepository.GetTagCounts(ctx, u.now())
+}
+
+// GetArchiveCounts は公開中のコンテンツを公開年月（日本時間）ごとに集計して新しい順に返します
+func (u *contentUsecase) GetArchiveCounts(ctx context.Context) ([]*entity.ArchiveCount, error) {
+	return u.contentRepository.GetArchiveCounts(ctx, u.now(), archiveTimeZone)
+}
+
+// GetArchiveContents は指定された年月（日本時間）に公開された、公開中のコンテンツ一覧を取得します
+func (u *contentUsecase) GetArchiveContents(ctx context.Context, year, month int, input GetContentsInput) (*ContentList, error) {
+	if year < 1 || year > 9999 || month < 1 || month > 12 {
+		return nil, fmt.Errorf("%w: year=%d, month=%d", entity.ErrInvalidParameter, year, month)
+	}
+
+	from := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, archiveLocation)
+	input.Status = string(entity.ContentStatusPublished)
+	input.PublishedFrom = from.Format(time.RFC3339)
+	input.PublishedTo = from.AddDate(0, 1, 0).Format(time.RFC3339)
+	return u.GetContents(ctx, input)
+}
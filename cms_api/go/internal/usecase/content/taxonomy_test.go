@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// CreateCategoryのテスト
+func (s *contentsUsecaseTestSuite) TestCreateCategory() {
+	s.Run("正常系：送信されたIDを無視して作成する場合", func() {
+		category := &entity.Category{ID: uuid.New(), Name: "AWS", Slug: "aws"}
+		s.mockRepository.EXPECT().CreateCategory(context.Background(), mock.MatchedBy(func(c *entity.Category) bool {
+			return c.ID == uuid.Nil && c.Slug == "aws"
+		})).RunAndReturn(func(_ context.Context, c *entity.Category) error {
+			c.ID = uuid.New()
+			return nil
+		})
+
+		result, err := s.usecase.CreateCategory(context.Background(), category)
+		assert.NoError(s.T(), err)
+		assert.NotEqual(s.T(), uuid.Nil, result.ID)
+	})
+
+	s.Run("異常系：スラッグが重複する場合", func() {
+		category := &entity.Category{Name: "AWS", Slug: "aws"}
+		s.mockRepository.EXPECT().CreateCategory(context.Background(), category).
+			Return(fmt.Errorf("スラッグが%w: aws", entity.ErrAlreadyExists))
+
+		_, err := s.usecase.CreateCategory(context.Background(), category)
+		assert.True(s.T(), errors.Is(err, entity.ErrAlreadyExists))
+	})
+}
+
+// UpdateTagのテスト
+func (s *contentsUsecaseTestSuite) TestUpdateTag() {
+	tagID := uuid.New()
+
+	s.Run("正常系：パスのIDで更新し、更新後のタグを返す場合", func() {
+		tag := &entity.Tag{Name: "Go", Slug: "go"}
+		s.mockRepository.EXPECT().UpdateTag(context.Background(), mock.MatchedBy(func(t *entity.Tag) bool {
+			return t.ID == tagID
+		})).Return(nil)
+		s.mockRepository.EXPECT().GetTagByID(context.Background(), tagID).
+			Return(&entity.Tag{ID: tagID, Name: "Go", Slug: "go"}, nil)
+
+		result, err := s.usecase.UpdateTag(context.Background(), tagID, tag)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), tagID, result.ID)
+	})
+
+	s.Run("異常系：タグが存在しない場合", func() {
+		s.mockRepository.EXPECT().UpdateTag(context.Background(), mock.Anything).
+			Return(fmt.Errorf("%w: %s", entity.ErrTagNotFound, tagID))
+
+		_, err := s.usecase.UpdateTag(context.Background(), tagID, &entity.Tag{Name: "Go", Slug: "go"})
+		assert.True(s.T(), errors.Is(err, entity.ErrTagNotFound))
+	})
+}
+
+// GetArchiveCountsのテスト
+func (s *contentsUsecaseTestSuite) TestGetArchiveCounts() {
+	s.Run("正常系：現在時刻と日本時間で集計する場合", func() {
+		expected := []*entity.ArchiveCount{{Year: 2025, Month: 1, Count: 3}, {Year: 2024, Month: 12, Count: 1}}
+		s.mockRepository.EXPECT().GetArchiveCounts(context.Background(), s.now, "Asia/Tokyo").Return(expected, nil)
+
+		result, err := s.usecase.GetArchiveCounts(context.Background())
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), expected, result)
+	})
+}
+
+// GetTagCountsのテスト
+func (s *contentsUsecaseTestSuite) TestGetTagCounts() {
+	s.Run("正常系：現在時刻で公開中のコンテンツ数を集計する場合", func() {
+		expected := []*entity.TagCount{{Tag: entity.Tag{ID: uuid.New(), Name: "Go", Slug: "go"}, Count: 2}}
+		s.mockRepository.EXPECT().GetTagCounts(context.Background(), s.now).Return(expected, nil)
+
+		result, err := s.usecase.GetTagCounts(context.Background())
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), expected, result)
+	})
+}
+
+// GetArchiveContentsのテスト
+func (s *contentsUsecaseTestSuite) TestGetArchiveContents() {
+	s.Run("正常系：指定された年月に公開された公開中のコンテンツに絞り込む場合", func() {
+		published := entity.ContentStatusPublished
+		from := time.Date(2024, 12, 1, 0, 0, 0, 0, archiveLocation)
+		to := time.Date(2025, 1, 1, 0, 0, 0, 0, archiveLocation)
+		s.mockRepository.EXPECT().GetContents(context.Background(), 10, 0, mock.MatchedBy(func(f repository.ContentFilters) bool {
+			return *f.Status == published && f.VisibleAt.Equal(s.now) &&
+				f.PublishedFrom.Equal(from) && f.PublishedTo.Equal(to) && f.Category == "aws"
+		})).Return([]*entity.Content{}, 0, nil)
+
+		list, err := s.usecase.GetArchiveContents(context.Background(), 2024, 12, GetContentsInput{Limit: 10, Category: "aws"})
+		assert.NoError(s.T(), err)
+		assert.Empty(s.T(), list.Contents)
+	})
+
+	s.Run("異常系：不正な月が指定された場合", func() {
+		_, err := s.usecase.GetArchiveContents(context.Background(), 2025, 13, GetContentsInput{})
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+}
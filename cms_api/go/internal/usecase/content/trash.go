@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// purgeBatchSize は1回の期限切れ削除で完全に削除する最大件数
+const purgeBatchSize = 100
+
+// PurgeResult はゴミ箱の完全削除の結果
+type PurgeResult struct {
+	Purged []uuid.UUID `json:"purged"`
+}
+
+// DeleteContent は更新元のバージョン（expectedVersion）を確認した上でコンテンツをゴミ箱へ移動します
+// 他のコンテンツから参照されている場合はpolicyに従って参照元を処理します（ReferrerPolicyRestrictの場合は移動しない）
+func (u *contentUsecase) DeleteContent(ctx context.Context, id uuid.UUID, expectedVersion int, policy entity.ReferrerPolicy) error {
+	if err := requireVersion(expectedVersion); err != nil {
+		return err
+	}
+	if err := requireReferrerPolicy(policy); err != nil {
+		return err
+	}
+
+	content, err := u.contentRepository.GetContentByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	content.Version = expectedVersion
+	if err := content.Apply(entity.ContentActionTrash, u.now()); err != nil {
+		return err
+	}
+
+	return u.contentRepository.UpdateReferencedContentStatus(ctx, content, policy)
+}
+
+// GetTrashedContents はゴミ箱のコンテンツ一覧を取得します
+func (u *contentUsecase) GetTrashedContents(ctx context.Context, input GetContentsInput) (*ContentList, error) {
+	input.Status = string(entity.ContentStatusTrash)
+	return u.GetContents(ctx, input)
+}
+
+// PurgeContent はゴミ箱のコンテンツを完全に削除します
+// ゴミ箱にないコンテンツから参照されている場合はpolicyに従って参照元を処理します（ReferrerPolicyRestrictの場合は削除しない）
+func (u *contentUsecase) PurgeContent(ctx context.Context, id uuid.UUID, policy entity.ReferrerPolicy) error {
+	if err := requireReferrerPolicy(policy); err != nil {
+		return err
+	}
+	return u.contentRepository.PurgeContent(ctx, id, policy)
+}
+
+// PurgeTrash はゴミ箱へ移動してからolderThan以上経過したコンテンツを完全に削除します
+// olderThanが0の場合はゴミ箱を空にします
+func (u *contentUsecase) PurgeTrash(ctx context.Context, olderThan time.Duration) (*PurgeResult, error) {
+	if olderThan < 0 {
+		return nil, fmt.Errorf("%w: olderThan=%s", entity.ErrInvalidParameter, olderThan)
+	}
+
+	before := u.now().Add(-olderThan)
+	result := &PurgeResult{Purged: []uuid.UUID{}}
+	for {
+		ids, err := u.contentRepository.PurgeTrashedContents(ctx, before, purgeBatchSize)
+		if err != nil {
+			return result, err
+		}
+		result.Purged = append(result.Purged, ids...)
+		if len(ids) < purgeBatchSize {
+			return result, nil
+		}
+	}
+}
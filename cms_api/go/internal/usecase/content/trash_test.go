@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"cms_api/internal/infrastructure/repository"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// DeleteContentのテスト
+func (s *contentsUsecaseTestSuite) TestDeleteContent() {
+	contentID := uuid.New()
+
+	s.Run("正常系：指定したバージョンでゴミ箱へ移動できる場合", func() {
+		publishedAt := s.now.Add(-time.Hour)
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(&entity.Content{ID: contentID, Status: entity.ContentStatusPublished, PublishedAt: &publishedAt, Version: 5}, nil)
+		s.mockRepository.EXPECT().UpdateReferencedContentStatus(context.Background(), mock.MatchedBy(func(c *entity.Content) bool {
+			return c.Version == 3 &&
+				c.Status == entity.ContentStatusTrash &&
+				c.DeletedAt != nil && c.DeletedAt.Equal(s.now)
+		}), entity.ReferrerPolicyRestrict).Return(nil)
+
+		assert.NoError(s.T(), s.usecase.DeleteContent(context.Background(), contentID, 3, entity.ReferrerPolicyRestrict))
+	})
+
+	s.Run("正常系：参照元の参照ブロックから参照先を外してゴミ箱へ移動する場合", func() {
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(&entity.Content{ID: contentID, Status: entity.ContentStatusDraft, Version: 5}, nil)
+		s.mockRepository.EXPECT().UpdateReferencedContentStatus(context.Background(), mock.Anything, entity.ReferrerPolicyDetach).Return(nil)
+
+		assert.NoError(s.T(), s.usecase.DeleteContent(context.Background(), contentID, 5, entity.ReferrerPolicyDetach))
+	})
+
+	s.Run("異常系：参照元がある場合は参照元の一覧を返す場合", func() {
+		referrers := []entity.Referrer{{ContentID: uuid.New(), Title: "参照元"}}
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(&entity.Content{ID: contentID, Status: entity.ContentStatusDraft, Version: 5}, nil)
+		s.mockRepository.EXPECT().UpdateReferencedContentStatus(context.Background(), mock.Anything, entity.ReferrerPolicyRestrict).
+			Return(&entity.ReferencedError{Referrers: referrers})
+
+		err := s.usecase.DeleteContent(context.Background(), contentID, 5, entity.ReferrerPolicyRestrict)
+		var referenced *entity.ReferencedError
+		if assert.ErrorAs(s.T(), err, &referenced) {
+			assert.Equal(s.T(), referrers, referenced.Referrers)
+		}
+	})
+
+	s.Run("異常系：バージョンを省略した場合", func() {
+		err := s.usecase.DeleteContent(context.Background(), contentID, 0, entity.ReferrerPolicyRestrict)
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+	})
+
+	s.Run("異常系：参照元の扱いが不正な場合", func() {
+		err := s.usecase.DeleteContent(context.Background(), contentID, 5, "cascade")
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+	})
+
+	s.Run("異常系：既にゴミ箱にある場合", func() {
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(&entity.Content{ID: contentID, Status: entity.ContentStatusTrash, Version: 5}, nil)
+
+		err := s.usecase.DeleteContent(context.Background(), contentID, 5, entity.ReferrerPolicyRestrict)
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidStatusTransition))
+	})
+}
+
+// GetTrashedContentsのテスト
+func (s *contentsUsecaseTestSuite) TestGetTrashedContents() {
+	s.Run("正常系：ゴミ箱へ移動した日時の新しい順に取得する場合", func() {
+		status := entity.ContentStatusTrash
+		filters := repository.ContentFilters{Status: &status, Sort: []repository.SortKey{{Field: repository.SortFieldDeletedAt, Desc: true}}}
+		contents := []*entity.Content{{ID: uuid.New(), Status: entity.ContentStatusTrash}}
+		s.mockRepository.EXPECT().GetContents(context.Background(), DefaultLimit, 0, filters).Return(contents, 1, nil)
+
+		result, err := s.usecase.GetTrashedContents(context.Background(), GetContentsInput{Status: "published"})
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), contents, result.Contents)
+	})
+}
+
+// PurgeContentのテスト
+func (s *contentsUsecaseTestSuite) TestPurgeContent() {
+	contentID := uuid.New()
+
+	s.Run("正常系：参照元の扱いを指定して完全に削除する場合", func() {
+		s.mockRepository.EXPECT().PurgeContent(context.Background(), contentID, entity.ReferrerPolicyRemove).Return(nil)
+
+		assert.NoError(s.T(), s.usecase.PurgeContent(context.Background(), contentID, entity.ReferrerPolicyRemove))
+	})
+
+	s.Run("異常系：参照元の扱いが不正な場合", func() {
+		err := s.usecase.PurgeContent(context.Background(), contentID, "cascade")
+		assert.ErrorIs(s.T(), err, entity.ErrInvalidParameter)
+	})
+}
+
+// PurgeTrashのテスト
+func (s *contentsUsecaseTestSuite) TestPurgeTrash() {
+	s.Run("正常系：保持期間を過ぎたコンテンツをすべて削除するまで繰り返す場合", func() {
+		before := s.now.Add(-30 * 24 * time.Hour)
+		first := make([]uuid.UUID, purgeBatchSize)
+		for i := range first {
+			first[i] = uuid.New()
+		}
+		last := []uuid.UUID{uuid.New()}
+		s.mockRepository.EXPECT().PurgeTrashedContents(context.Background(), before, purgeBatchSize).Return(first, nil).Once()
+		s.mockRepository.EXPECT().PurgeTrashedContents(context.Background(), before, purgeBatchSize).Return(last, nil).Once()
+
+		result, err := s.usecase.PurgeTrash(context.Background(), 30*24*time.Hour)
+		assert.NoError(s.T(), err)
+		assert.Len(s.T(), result.Purged, purgeBatchSize+1)
+	})
+
+	s.Run("正常系：期間を省略した場合はゴミ箱を空にする場合", func() {
+		s.mockRepository.EXPECT().PurgeTrashedContents(context.Background(), s.now, purgeBatchSize).Return([]uuid.UUID{}, nil)
+
+		result, err := s.usecase.PurgeTrash(context.Background(), 0)
+		assert.NoError(s.T(), err)
+		assert.Empty(s.T(), result.Purged)
+	})
+
+	s.Run("異常系：期間が負の場合", func() {
+		_, err := s.usecase.PurgeTrash(context.Background(), -time.Hour)
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// GetContentVersions はコンテンツの版一覧を新しい順に取得します
+func (u *contentUsecase) GetContentVersions(ctx context.Context, contentID uuid.UUID) ([]*entity.ContentVersion, error) {
+	return u.contentRepository.GetContentVersions(ctx, contentID)
+}
+
+// GetContentVersion はコンテンツの指定された版をスナップショット込みで取得します
+func (u *contentUsecase) GetContentVersion(ctx context.Context, contentID uuid.UUID, version int) (*entity.ContentVersion, error) {
+	if version <= 0 {
+		return nil, fmt.Errorf("%w: version=%d", entity.ErrInvalidParameter, version)
+	}
+	return u.contentRepository.GetContentVersion(ctx, contentID, version)
+}
+
+// RestoreContentVersion は指定された版の内容でコンテンツを更新し、新しい版として保存します
+// 公開状態（ステータス・公開日時・公開予約）は現在のものを維持します
+// expectedVersionには復元元ではなく、現在のコンテンツのバージョンを指定する必要があります
+func (u *contentUsecase) RestoreContentVersion(ctx context.Context, contentID uuid.UUID, version, expectedVersion int) (*entity.Content, error) {
+	if err := requireVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	target, err := u.GetContentVersion(ctx, contentID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := u.contentRepository.GetContentByID(ctx, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	content := target.Snapshot
+	content.ID = contentID
+	content.Version = expectedVersion
+	content.CreatedAt = current.CreatedAt
+	content.Status = current.Status
+	content.PublishedAt = current.PublishedAt
+	content.ScheduledAt = current.ScheduledAt
+	content.ExpiresAt = current.ExpiresAt
+	content.DeletedAt = current.DeletedAt
+	content.ContentType = nil
+	normalizeBlocks(content)
+
+	return u.saveContent(ctx, content, current)
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"cms_api/internal/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// GetContentVersionのテスト
+func (s *contentsUsecaseTestSuite) TestGetContentVersion() {
+	contentID := uuid.New()
+
+	s.Run("正常系：版を取得できる場合", func() {
+		expected := &entity.ContentVersion{ContentID: contentID, Version: 2, Snapshot: &entity.Content{ID: contentID, Version: 2}}
+		s.mockRepository.EXPECT().GetContentVersion(context.Background(), contentID, 2).Return(expected, nil)
+
+		result, err := s.usecase.GetContentVersion(context.Background(), contentID, 2)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), expected, result)
+	})
+
+	s.Run("異常系：バージョンが0以下の場合", func() {
+		_, err := s.usecase.GetContentVersion(context.Background(), contentID, 0)
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+}
+
+// RestoreContentVersionのテスト
+func (s *contentsUsecaseTestSuite) TestRestoreContentVersion() {
+	contentID := uuid.New()
+	publishedAt := time.Unix(1700000000, 0)
+	blockID := uuid.New()
+	snapshot := func() *entity.Content {
+		return &entity.Content{
+			ID:            contentID,
+			ContentTypeID: uuid.New(),
+			Title:         "old title",
+			Slug:          "old-slug",
+			Status:        entity.ContentStatusDraft,
+			AuthorID:      "admin",
+			Version:       2,
+			Blocks:        []entity.ContentBlock{{ID: blockID, BlockType: entity.BlockTypeText, BlockOrder: 5}},
+		}
+	}
+	current := &entity.Content{
+		ID:          contentID,
+		Title:       "new title",
+		Status:      entity.ContentStatusPublished,
+		PublishedAt: &publishedAt,
+		Version:     5,
+		CreatedAt:   time.Unix(1600000000, 0),
+	}
+
+	s.Run("正常系：公開状態を維持したまま過去の版の内容で更新される場合", func() {
+		restored := snapshot()
+		s.mockRepository.EXPECT().GetContentVersion(context.Background(), contentID, 2).
+			Return(&entity.ContentVersion{ContentID: contentID, Version: 2, Snapshot: restored}, nil)
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).Return(current, nil).Once()
+		s.mockRepository.EXPECT().GetContentTypeByID(context.Background(), restored.ContentTypeID).Return(&entity.ContentType{}, nil)
+		s.mockRepository.EXPECT().ContentSlugExists(context.Background(), restored.ContentTypeID, "old-slug", contentID).Return(false, nil)
+		s.mockRepository.EXPECT().UpdateContent(context.Background(), mock.MatchedBy(func(c *entity.Content) bool {
+			return c.Version == 5 &&
+				c.Title == "old title" &&
+				c.Status == entity.ContentStatusPublished &&
+				c.PublishedAt == &publishedAt &&
+				c.CreatedAt.Equal(current.CreatedAt) &&
+				c.Blocks[0].BlockOrder == 1
+		})).Return(nil)
+		s.mockRepository.EXPECT().GetContentByID(context.Background(), contentID).
+			Return(&entity.Content{ID: contentID, Version: 6}, nil).Once()
+
+		result, err := s.usecase.RestoreContentVersion(context.Background(), contentID, 2, 5)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), 6, result.Version)
+	})
+
+	s.Run("異常系：現在のバージョンが指定されていない場合", func() {
+		_, err := s.usecase.RestoreContentVersion(context.Background(), contentID, 2, 0)
+		assert.True(s.T(), errors.Is(err, entity.ErrInvalidParameter))
+	})
+
+	s.Run("異常系：復元する版が存在しない場合", func() {
+		s.mockRepository.EXPECT().GetContentVersion(context.Background(), contentID, 9).
+			Return(nil, fmt.Errorf("%w: %s", entity.ErrContentVersionNotFound, contentID))
+
+		_, err := s.usecase.RestoreContentVersion(context.Background(), contentID, 9, 5)
+		assert.True(s.T(), errors.Is(err, entity.ErrContentVersionNotFound))
+	})
+}